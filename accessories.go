@@ -0,0 +1,359 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// AccessoriesService handles communication with the accessory-related endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessories
+type AccessoriesService struct {
+	client *Client
+}
+
+// AccessoryResponse represents the API response for a single accessory.
+// The single accessory endpoint returns the accessory data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Accessory.
+type AccessoryResponse struct {
+	Response
+	// Payload contains the accessory as returned in the payload field, if any
+	Payload *Accessory `json:"payload,omitempty"`
+	Accessory
+}
+
+// UnmarshalJSON implements json.Unmarshaler for AccessoryResponse.
+func (r *AccessoryResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Accessory)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Accessory
+	}
+	return nil
+}
+
+// AccessoriesResponse represents the API response for multiple accessories.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Accessories.
+type AccessoriesResponse struct {
+	Response
+	// Rows contains the list of Accessory objects
+	Rows []Accessory `json:"rows"`
+}
+
+// AccessoryCheckoutsResponse represents the API response listing the users
+// an accessory is checked out to.
+type AccessoryCheckoutsResponse struct {
+	Response
+	// Rows contains the list of AccessoryCheckout objects
+	Rows []AccessoryCheckout `json:"rows"`
+}
+
+// List returns a list of accessories with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessories
+func (s *AccessoriesService) List(opts *ListOptions) (*AccessoriesResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of accessories with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessories
+func (s *AccessoriesService) ListContext(ctx context.Context, opts *ListOptions) (*AccessoriesResponse, *http.Response, error) {
+	u := "api/v1/accessories"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accessories AccessoriesResponse
+	resp, err := s.client.Do(req, &accessories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &accessories, resp, nil
+}
+
+// Iterate returns an iterator over every accessory, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Accessory.
+func (s *AccessoriesService) Iterate(opts *ListOptions) iter.Seq2[Accessory, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every accessory with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Accessory.
+func (s *AccessoriesService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Accessory, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]Accessory, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single accessory by its ID.
+//
+// id is the unique identifier of the accessory to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessoriesid
+func (s *AccessoriesService) Get(id int) (*AccessoryResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single accessory by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the accessory to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessoriesid
+func (s *AccessoriesService) GetContext(ctx context.Context, id int) (*AccessoryResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/accessories/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accessory AccessoryResponse
+	resp, err := s.client.Do(req, &accessory)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &accessory, resp, nil
+}
+
+// Create creates a new accessory in Snipe-IT.
+//
+// accessory must contain the required fields:
+// - Name: The name of the accessory
+// - CategoryID: The ID of an accessory category
+// - Qty: The quantity of the accessory
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessories-1
+func (s *AccessoriesService) Create(accessory Accessory) (*AccessoryResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), accessory)
+}
+
+// CreateContext creates a new accessory in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// accessory must contain the required fields:
+// - Name: The name of the accessory
+// - CategoryID: The ID of an accessory category
+// - Qty: The quantity of the accessory
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessories-1
+func (s *AccessoriesService) CreateContext(ctx context.Context, accessory Accessory) (*AccessoryResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/accessories", accessory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response AccessoryResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing accessory in Snipe-IT.
+//
+// id is the unique identifier of the accessory to update.
+// accessory contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessoriesid-1
+func (s *AccessoriesService) Update(id int, accessory Accessory) (*AccessoryResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, accessory)
+}
+
+// UpdateContext updates an existing accessory in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the accessory to update.
+// accessory contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessoriesid-1
+func (s *AccessoriesService) UpdateContext(ctx context.Context, id int, accessory Accessory) (*AccessoryResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/accessories/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, accessory)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response AccessoryResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes an accessory from Snipe-IT.
+//
+// id is the unique identifier of the accessory to delete.
+// Snipe-IT refuses to delete accessories that are still checked out.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessoriesid-2
+func (s *AccessoriesService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes an accessory from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the accessory to delete.
+// Snipe-IT refuses to delete accessories that are still checked out.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessoriesid-2
+func (s *AccessoriesService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/accessories/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Checkout checks out one unit of an accessory to a user.
+//
+// id is the unique identifier of the accessory to check out.
+// userID is the unique identifier of the user to check the accessory out to.
+// note is an optional note about the checkout.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessoriesidcheckout
+func (s *AccessoriesService) Checkout(id, userID int, note string) (*AccessoryResponse, *http.Response, error) {
+	return s.CheckoutContext(context.Background(), id, userID, note)
+}
+
+// CheckoutContext checks out one unit of an accessory to a user with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the accessory to check out.
+// userID is the unique identifier of the user to check the accessory out to.
+// note is an optional note about the checkout.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessoriesidcheckout
+func (s *AccessoriesService) CheckoutContext(ctx context.Context, id, userID int, note string) (*AccessoryResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/accessories/%d/checkout", id)
+	body := map[string]interface{}{
+		"assigned_to": userID,
+	}
+	if note != "" {
+		body["note"] = note
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response AccessoryResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Checkin checks a single checked-out unit of an accessory back in.
+//
+// pivotID is the AssignedPivotID of the checkout record, as returned by CheckedOut,
+// not the ID of the accessory itself.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessoriesidcheckin
+func (s *AccessoriesService) Checkin(pivotID int) (*http.Response, error) {
+	return s.CheckinContext(context.Background(), pivotID)
+}
+
+// CheckinContext checks a single checked-out unit of an accessory back in
+// with the provided context.
+//
+// ctx is the context for the request.
+// pivotID is the AssignedPivotID of the checkout record, as returned by CheckedOut,
+// not the ID of the accessory itself.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessoriesidcheckin
+func (s *AccessoriesService) CheckinContext(ctx context.Context, pivotID int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/accessories/%d/checkin", pivotID)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// CheckedOut returns the users an accessory is currently checked out to.
+//
+// id is the unique identifier of the accessory.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessoriesidcheckedout
+func (s *AccessoriesService) CheckedOut(id int) (*AccessoryCheckoutsResponse, *http.Response, error) {
+	return s.CheckedOutContext(context.Background(), id)
+}
+
+// CheckedOutContext returns the users an accessory is currently checked out to
+// with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the accessory.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessoriesidcheckedout
+func (s *AccessoriesService) CheckedOutContext(ctx context.Context, id int) (*AccessoryCheckoutsResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/accessories/%d/checkedout", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var checkouts AccessoryCheckoutsResponse
+	resp, err := s.client.Do(req, &checkouts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &checkouts, resp, nil
+}
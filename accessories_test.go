@@ -0,0 +1,221 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAccessoriesList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/accessories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 1, "name": "USB-C Charger", "qty": 20, "remaining_qty": 12, "min_qty": 5, "category": {"id": 3, "name": "Chargers"}},
+				{"id": 2, "name": "Keyboard", "qty": 10, "remaining_qty": 10}
+			]
+		}`)
+	})
+
+	accessories, _, err := client.Accessories.List(nil)
+	if err != nil {
+		t.Fatalf("Accessories.List returned error: %v", err)
+	}
+
+	if len(accessories.Rows) != 2 {
+		t.Fatalf("Accessories.List returned %d accessories, expected %d", len(accessories.Rows), 2)
+	}
+
+	charger := accessories.Rows[0]
+	if charger.Name != "USB-C Charger" || charger.RemainingQty != 12 || charger.MinQty != 5 || charger.Category.ID != 3 {
+		t.Errorf("Accessories.List returned %+v, expected the charger with 12 of 20 remaining", charger)
+	}
+}
+
+func TestAccessoriesGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/accessories/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "name": "USB-C Charger", "qty": 20}`)
+	})
+
+	accessory, _, err := client.Accessories.Get(1)
+	if err != nil {
+		t.Fatalf("Accessories.Get returned error: %v", err)
+	}
+
+	if accessory.ID != 1 || accessory.Qty != 20 {
+		t.Errorf("Accessories.Get returned %+v, expected ID 1 with a quantity of 20", accessory.Accessory)
+	}
+}
+
+func TestAccessoriesCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/accessories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["name"] != "Mouse" || requestBody["category_id"] != 3.0 || requestBody["qty"] != 15.0 {
+			t.Errorf("Request body = %v, expected name Mouse, category_id 3 and qty 15", requestBody)
+		}
+
+		fmt.Fprint(w, `{
+			"status": "success",
+			"messages": "Accessory created successfully.",
+			"payload": {"id": 4, "name": "Mouse", "qty": 15}
+		}`)
+	})
+
+	accessory := Accessory{CategoryID: 3, Qty: 15}
+	accessory.Name = "Mouse"
+	created, _, err := client.Accessories.Create(accessory)
+	if err != nil {
+		t.Fatalf("Accessories.Create returned error: %v", err)
+	}
+
+	if created.Payload == nil || created.Payload.ID != 4 {
+		t.Errorf("Accessories.Create returned Payload = %+v, expected ID %d", created.Payload, 4)
+	}
+}
+
+func TestAccessoriesUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/accessories/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 4, "name": "Mouse", "qty": 25}}`)
+	})
+
+	updated, _, err := client.Accessories.Update(4, Accessory{Qty: 25})
+	if err != nil {
+		t.Fatalf("Accessories.Update returned error: %v", err)
+	}
+
+	if updated.Qty != 25 {
+		t.Errorf("Accessories.Update returned Qty = %d, expected %d", updated.Qty, 25)
+	}
+}
+
+func TestAccessoriesDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/accessories/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success", "messages": "Accessory deleted"}`)
+	})
+
+	if _, err := client.Accessories.Delete(4); err != nil {
+		t.Fatalf("Accessories.Delete returned error: %v", err)
+	}
+}
+
+func TestAccessoriesCheckout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/accessories/3/checkout", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["assigned_to"] != float64(2) {
+			t.Errorf("Request body assigned_to = %v, expected %v", requestBody["assigned_to"], float64(2))
+		}
+		if requestBody["note"] != "New hire kit" {
+			t.Errorf("Request body note = %v, expected %v", requestBody["note"], "New hire kit")
+		}
+
+		fmt.Fprint(w, `{
+			"status": "success",
+			"messages": "Accessory checked out successfully.",
+			"payload": {"id": 3, "name": "USB-C Dock", "qty": 10, "remaining_qty": 3}
+		}`)
+	})
+
+	accessory, _, err := client.Accessories.Checkout(3, 2, "New hire kit")
+	if err != nil {
+		t.Fatalf("Accessories.Checkout returned error: %v", err)
+	}
+
+	if accessory.Status != "success" {
+		t.Errorf("Accessories.Checkout returned Status = %s, expected %s", accessory.Status, "success")
+	}
+
+	if accessory.RemainingQty != 3 {
+		t.Errorf("Accessories.Checkout returned RemainingQty = %d, expected %d", accessory.RemainingQty, 3)
+	}
+}
+
+func TestAccessoriesCheckin(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/accessories/41/checkin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"status": "success", "messages": "Accessory checked in successfully."}`)
+	})
+
+	resp, err := client.Accessories.Checkin(41)
+	if err != nil {
+		t.Fatalf("Accessories.Checkin returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Accessories.Checkin returned status code = %d, expected %d", resp.StatusCode, http.StatusOK)
+	}
+}
+
+func TestAccessoriesCheckedOut(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/accessories/3/checkedout", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{
+					"assigned_pivot_id": 41,
+					"id": 2,
+					"username": "jdoe",
+					"name": "Jane Doe",
+					"checkout_notes": "New hire kit",
+					"last_checkout": "2024-01-15 09:30:00",
+					"type": "user"
+				}
+			]
+		}`)
+	})
+
+	checkouts, _, err := client.Accessories.CheckedOut(3)
+	if err != nil {
+		t.Fatalf("Accessories.CheckedOut returned error: %v", err)
+	}
+
+	if len(checkouts.Rows) != 1 {
+		t.Fatalf("Accessories.CheckedOut returned %d rows, expected %d", len(checkouts.Rows), 1)
+	}
+
+	checkout := checkouts.Rows[0]
+	if checkout.AssignedPivotID != 41 || checkout.ID != 2 || checkout.Username != "jdoe" {
+		t.Errorf("Accessories.CheckedOut returned %+v, expected pivot 41 for user 2 (jdoe)", checkout)
+	}
+
+	if checkout.LastCheckout == nil || checkout.LastCheckout.IsZero() {
+		t.Error("Accessories.CheckedOut returned empty LastCheckout")
+	}
+}
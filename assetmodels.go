@@ -0,0 +1,249 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// ModelsService handles communication with the model-related endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/models
+type ModelsService struct {
+	client *Client
+}
+
+// ModelResponse represents the API response for a single model.
+// The single model endpoint returns the model data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Model.
+type ModelResponse struct {
+	Response
+	// Payload contains the model as returned in the payload field, if any
+	Payload *Model `json:"payload,omitempty"`
+	Model
+}
+
+// UnmarshalJSON implements json.Unmarshaler for ModelResponse.
+func (r *ModelResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Model)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Model
+	}
+	return nil
+}
+
+// ModelsResponse represents the API response for multiple models.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Models.
+type ModelsResponse struct {
+	Response
+	// Rows contains the list of Model objects
+	Rows []Model `json:"rows"`
+}
+
+// List returns a list of models with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/models
+func (s *ModelsService) List(opts *ListOptions) (*ModelsResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of models with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/models
+func (s *ModelsService) ListContext(ctx context.Context, opts *ListOptions) (*ModelsResponse, *http.Response, error) {
+	u := "api/v1/models"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var models ModelsResponse
+	resp, err := s.client.Do(req, &models)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &models, resp, nil
+}
+
+// Iterate returns an iterator over every model, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Model.
+func (s *ModelsService) Iterate(opts *ListOptions) iter.Seq2[Model, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every model with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Model.
+func (s *ModelsService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Model, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]Model, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single model by its ID.
+//
+// id is the unique identifier of the model to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/modelsid
+func (s *ModelsService) Get(id int) (*ModelResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single model by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the model to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/modelsid
+func (s *ModelsService) GetContext(ctx context.Context, id int) (*ModelResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/models/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var model ModelResponse
+	resp, err := s.client.Do(req, &model)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &model, resp, nil
+}
+
+// Create creates a new model in Snipe-IT.
+//
+// model must contain the required fields:
+// - Name: The name of the model
+// - CategoryID: The ID of the model's category
+// - ManufacturerID: The ID of the model's manufacturer
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/models-1
+func (s *ModelsService) Create(model Model) (*ModelResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), model)
+}
+
+// CreateContext creates a new model in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// model must contain the required fields:
+// - Name: The name of the model
+// - CategoryID: The ID of the model's category
+// - ManufacturerID: The ID of the model's manufacturer
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/models-1
+func (s *ModelsService) CreateContext(ctx context.Context, model Model) (*ModelResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/models", model)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response ModelResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing model in Snipe-IT.
+//
+// id is the unique identifier of the model to update.
+// model contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/modelsid-1
+func (s *ModelsService) Update(id int, model Model) (*ModelResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, model)
+}
+
+// UpdateContext updates an existing model in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the model to update.
+// model contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/modelsid-1
+func (s *ModelsService) UpdateContext(ctx context.Context, id int, model Model) (*ModelResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/models/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, model)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response ModelResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a model from Snipe-IT.
+//
+// id is the unique identifier of the model to delete.
+// Snipe-IT refuses to delete models that still have assets.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/modelsid-2
+func (s *ModelsService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a model from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the model to delete.
+// Snipe-IT refuses to delete models that still have assets.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/modelsid-2
+func (s *ModelsService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/models/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,90 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestModelsList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/models", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "Authorization", "Bearer test-token")
+		if search := r.URL.Query().Get("search"); search != "Latitude" {
+			t.Errorf("Request search = %q, expected %q", search, "Latitude")
+		}
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 1, "name": "Latitude 5520", "model_number": "LAT-5520", "category": {"id": 2, "name": "Laptops"}, "eol": 36, "assets_count": 12},
+				{"id": 2, "name": "Latitude 7420", "assets_count": 3}
+			]
+		}`)
+	})
+
+	models, _, err := client.Models.List(&ListOptions{Search: "Latitude"})
+	if err != nil {
+		t.Fatalf("Models.List returned error: %v", err)
+	}
+
+	if len(models.Rows) != 2 {
+		t.Fatalf("Models.List returned %d models, expected %d", len(models.Rows), 2)
+	}
+
+	model := models.Rows[0]
+	if model.ModelNumber != "LAT-5520" || model.Category.Name != "Laptops" || model.EOL != 36 || model.AssetsCount != 12 {
+		t.Errorf("Models.List returned %+v, expected Latitude 5520 in Laptops", model)
+	}
+}
+
+func TestModelsCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/models", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["name"] != "OptiPlex 7090" || requestBody["category_id"] != 2.0 || requestBody["manufacturer_id"] != 5.0 {
+			t.Errorf("Request body = %v, expected name, category_id and manufacturer_id", requestBody)
+		}
+
+		fmt.Fprint(w, `{
+			"status": "success",
+			"messages": "Model created successfully.",
+			"payload": {"id": 3, "name": "OptiPlex 7090"}
+		}`)
+	})
+
+	model := Model{CategoryID: 2, ManufacturerID: 5}
+	model.Name = "OptiPlex 7090"
+
+	created, _, err := client.Models.Create(model)
+	if err != nil {
+		t.Fatalf("Models.Create returned error: %v", err)
+	}
+
+	if created.Status != "success" || created.Payload == nil || created.Payload.ID != 3 {
+		t.Errorf("Models.Create returned %+v, expected a success payload with ID 3", created)
+	}
+}
+
+func TestModelsDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/models/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success", "messages": "Model deleted."}`)
+	})
+
+	if _, err := client.Models.Delete(3); err != nil {
+		t.Errorf("Models.Delete returned error: %v", err)
+	}
+}
@@ -3,8 +3,15 @@ package snipeit
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
 )
 
 // AssetsService handles communication with the asset-related endpoints
@@ -16,12 +23,28 @@ type AssetsService struct {
 }
 
 // AssetResponse represents the API response for a single asset.
-// The single asset endpoint returns the asset data directly,
-// not wrapped in a payload field.
+// The single asset endpoint returns the asset data directly, while the
+// create, update, checkout and checkin endpoints wrap it in a payload
+// field alongside a status. Both shapes are decoded into the embedded Asset.
 type AssetResponse struct {
+	Response
+	// Payload contains the asset as returned in the payload field, if any
+	Payload *Asset `json:"payload,omitempty"`
 	Asset
 }
 
+// UnmarshalJSON implements json.Unmarshaler for AssetResponse.
+func (r *AssetResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Asset)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Asset
+	}
+	return nil
+}
+
 // AssetsResponse represents the API response for multiple assets.
 // It embeds the standard Response struct and adds a Rows field
 // that contains a slice of Assets.
@@ -72,6 +95,38 @@ func (s *AssetsService) ListContext(ctx context.Context, opts *ListOptions) (*As
 	return &assets, resp, nil
 }
 
+// Iterate returns an iterator over every asset, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Asset.
+func (s *AssetsService) Iterate(opts *ListOptions) iter.Seq2[Asset, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every asset with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Asset.
+func (s *AssetsService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Asset, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]Asset, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
 // Get fetches a single asset by its ID.
 //
 // id is the unique identifier of the asset to retrieve.
@@ -103,36 +158,215 @@ func (s *AssetsService) GetContext(ctx context.Context, id int) (*AssetResponse,
 	return &asset, resp, nil
 }
 
-// Create creates a new asset in Snipe-IT.
+// AssetCreateRequest holds the fields of an asset to create, in the flat
+// shape the API expects: related resources are referenced by ID.
+type AssetCreateRequest struct {
+	// ModelID is the ID of the asset's model (required)
+	ModelID int `json:"model_id"`
+
+	// StatusID is the ID of the asset's status label (required)
+	StatusID int `json:"status_id"`
+
+	// AssetTag is a unique asset tag. It is required unless Snipe-IT is
+	// set to generate asset tags automatically.
+	AssetTag string `json:"asset_tag,omitempty"`
+
+	// Name of the asset
+	Name string `json:"name,omitempty"`
+
+	// Serial is the manufacturer's serial number
+	Serial string `json:"serial,omitempty"`
+
+	// CompanyID is the ID of the company that owns the asset
+	CompanyID int `json:"company_id,omitempty"`
+
+	// LocationID is the ID of the asset's current location
+	LocationID int `json:"location_id,omitempty"`
+
+	// RTDLocationID is the ID of the location the asset returns to when
+	// checked in (its default location)
+	RTDLocationID int `json:"rtd_location_id,omitempty"`
+
+	// SupplierID is the ID of the supplier the asset was purchased from
+	SupplierID int `json:"supplier_id,omitempty"`
+
+	// OrderNumber is the purchase order number
+	OrderNumber string `json:"order_number,omitempty"`
+
+	// PurchaseDate is when the asset was purchased
+	PurchaseDate *Date `json:"purchase_date,omitempty"`
+
+	// PurchaseCost of the asset
+	PurchaseCost *Money `json:"purchase_cost,omitempty"`
+
+	// WarrantyMonths is the length of the warranty in months
+	WarrantyMonths int `json:"warranty_months,omitempty"`
+
+	// NextAuditDate is when the asset is next due for an audit
+	NextAuditDate *Date `json:"next_audit_date,omitempty"`
+
+	// Requestable indicates if users can request the asset
+	Requestable bool `json:"requestable,omitempty"`
+
+	// Notes about the asset
+	Notes string `json:"notes,omitempty"`
+
+	// CustomFieldValues maps custom field database column names
+	// (e.g., "_snipeit_mac_address_1", as reported by CustomFieldValue.Field)
+	// to their values. The "_snipeit_" prefix may be omitted.
+	CustomFieldValues map[string]string `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler for AssetCreateRequest.
+// CustomFieldValues are flattened into the top-level "_snipeit_*" keys
+// the API expects.
+func (r AssetCreateRequest) MarshalJSON() ([]byte, error) {
+	type assetCreateRequest AssetCreateRequest
+	data, err := json.Marshal(assetCreateRequest(r))
+	if err != nil {
+		return nil, err
+	}
+	return withCustomFieldValues(data, r.CustomFieldValues)
+}
+
+// Validate checks the fields Snipe-IT requires to create an asset:
+// ModelID and StatusID must be set, AssetTag must not be blank if set, and
+// IDs, the warranty and the purchase cost must not be negative. It returns
+// ValidationErrors listing every problem found, or nil.
 //
-// asset must contain the required fields:
-// - Model.ID: The ID of the model
-// - StatusLabel.ID: The ID of the status label
-// - AssetTag: A unique asset tag
+// AssetTag may be left empty for Snipe-IT to generate the tag; the server
+// rejects the request if it is not set to do so.
+func (r AssetCreateRequest) Validate() error {
+	var v validation
+	v.requireID("model_id", r.ModelID)
+	v.requireID("status_id", r.StatusID)
+	if r.AssetTag != "" && strings.TrimSpace(r.AssetTag) == "" {
+		v.add("asset_tag", "must not be blank")
+	}
+	v.optionalID("company_id", r.CompanyID)
+	v.optionalID("location_id", r.LocationID)
+	v.optionalID("rtd_location_id", r.RTDLocationID)
+	v.optionalID("supplier_id", r.SupplierID)
+	if r.PurchaseCost != nil && r.PurchaseCost.Cents < 0 {
+		v.add("purchase_cost", "must not be negative")
+	}
+	if r.WarrantyMonths < 0 {
+		v.add("warranty_months", "must not be negative")
+	}
+	return v.err()
+}
+
+// AssetUpdateRequest holds the fields of an asset to change. Only fields
+// that are set are sent, so the others are left unchanged; set a field to
+// Null to clear it.
+type AssetUpdateRequest struct {
+	// ModelID is the ID of the asset's model
+	ModelID Nullable[int] `json:"model_id,omitzero"`
+
+	// StatusID is the ID of the asset's status label
+	StatusID Nullable[int] `json:"status_id,omitzero"`
+
+	// AssetTag is a unique asset tag
+	AssetTag Nullable[string] `json:"asset_tag,omitzero"`
+
+	// Name of the asset
+	Name Nullable[string] `json:"name,omitzero"`
+
+	// Serial is the manufacturer's serial number
+	Serial Nullable[string] `json:"serial,omitzero"`
+
+	// CompanyID is the ID of the company that owns the asset
+	CompanyID Nullable[int] `json:"company_id,omitzero"`
+
+	// LocationID is the ID of the asset's current location
+	LocationID Nullable[int] `json:"location_id,omitzero"`
+
+	// RTDLocationID is the ID of the asset's default location
+	RTDLocationID Nullable[int] `json:"rtd_location_id,omitzero"`
+
+	// SupplierID is the ID of the supplier the asset was purchased from
+	SupplierID Nullable[int] `json:"supplier_id,omitzero"`
+
+	// OrderNumber is the purchase order number
+	OrderNumber Nullable[string] `json:"order_number,omitzero"`
+
+	// PurchaseDate is when the asset was purchased
+	PurchaseDate Nullable[Date] `json:"purchase_date,omitzero"`
+
+	// PurchaseCost of the asset
+	PurchaseCost Nullable[Money] `json:"purchase_cost,omitzero"`
+
+	// WarrantyMonths is the length of the warranty in months
+	WarrantyMonths Nullable[int] `json:"warranty_months,omitzero"`
+
+	// NextAuditDate is when the asset is next due for an audit
+	NextAuditDate Nullable[Date] `json:"next_audit_date,omitzero"`
+
+	// Requestable indicates if users can request the asset
+	Requestable Nullable[bool] `json:"requestable,omitzero"`
+
+	// Notes about the asset
+	Notes Nullable[string] `json:"notes,omitzero"`
+
+	// CustomFieldValues maps custom field database column names to their
+	// new values, as for AssetCreateRequest.
+	CustomFieldValues map[string]string `json:"-"`
+}
+
+// MarshalJSON implements json.Marshaler for AssetUpdateRequest.
+// CustomFieldValues are flattened into the top-level "_snipeit_*" keys
+// the API expects.
+func (r AssetUpdateRequest) MarshalJSON() ([]byte, error) {
+	type assetUpdateRequest AssetUpdateRequest
+	data, err := json.Marshal(assetUpdateRequest(r))
+	if err != nil {
+		return nil, err
+	}
+	return withCustomFieldValues(data, r.CustomFieldValues)
+}
+
+// Validate checks the fields of an update: ModelID, StatusID and AssetTag
+// cannot be cleared, and IDs, the warranty and the purchase cost must not
+// be negative. It returns ValidationErrors listing every problem found,
+// or nil.
+func (r AssetUpdateRequest) Validate() error {
+	var v validation
+	v.nullableID("model_id", r.ModelID, true)
+	v.nullableID("status_id", r.StatusID, true)
+	if tag, _ := r.AssetTag.Get(); r.AssetTag.IsSet() && strings.TrimSpace(tag) == "" {
+		v.add("asset_tag", "cannot be cleared")
+	}
+	v.nullableID("company_id", r.CompanyID, false)
+	v.nullableID("location_id", r.LocationID, false)
+	v.nullableID("rtd_location_id", r.RTDLocationID, false)
+	v.nullableID("supplier_id", r.SupplierID, false)
+	if cost, ok := r.PurchaseCost.Get(); ok && cost.Cents < 0 {
+		v.add("purchase_cost", "must not be negative")
+	}
+	if months, ok := r.WarrantyMonths.Get(); ok && months < 0 {
+		v.add("warranty_months", "must not be negative")
+	}
+	return v.err()
+}
+
+// Create creates a new asset in Snipe-IT.
 //
-// Other important fields that should be considered:
-// - Name: A name for the asset
-// - Serial: The manufacturer's serial number
+// asset must set ModelID and StatusID, and AssetTag unless Snipe-IT
+// generates asset tags.
 //
 // Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-create
-func (s *AssetsService) Create(asset Asset) (*AssetResponse, *http.Response, error) {
+func (s *AssetsService) Create(asset AssetCreateRequest) (*AssetResponse, *http.Response, error) {
 	return s.CreateContext(context.Background(), asset)
 }
 
 // CreateContext creates a new asset in Snipe-IT with the provided context.
 //
 // ctx is the context for the request.
-// asset must contain the required fields:
-// - Model.ID: The ID of the model
-// - StatusLabel.ID: The ID of the status label
-// - AssetTag: A unique asset tag
-//
-// Other important fields that should be considered:
-// - Name: A name for the asset
-// - Serial: The manufacturer's serial number
+// asset must set ModelID and StatusID, and AssetTag unless Snipe-IT
+// generates asset tags.
 //
 // Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-create
-func (s *AssetsService) CreateContext(ctx context.Context, asset Asset) (*AssetResponse, *http.Response, error) {
+func (s *AssetsService) CreateContext(ctx context.Context, asset AssetCreateRequest) (*AssetResponse, *http.Response, error) {
 	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/hardware", asset)
 	if err != nil {
 		return nil, nil, err
@@ -150,11 +384,11 @@ func (s *AssetsService) CreateContext(ctx context.Context, asset Asset) (*AssetR
 // Update updates an existing asset in Snipe-IT.
 //
 // id is the unique identifier of the asset to update.
-// asset contains the fields to update. You only need to include
-// the fields you want to modify; other fields can be omitted.
+// asset holds the fields to change; fields that are not set are left
+// unchanged.
 //
 // Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-update
-func (s *AssetsService) Update(id int, asset Asset) (*AssetResponse, *http.Response, error) {
+func (s *AssetsService) Update(id int, asset AssetUpdateRequest) (*AssetResponse, *http.Response, error) {
 	return s.UpdateContext(context.Background(), id, asset)
 }
 
@@ -162,11 +396,11 @@ func (s *AssetsService) Update(id int, asset Asset) (*AssetResponse, *http.Respo
 //
 // ctx is the context for the request.
 // id is the unique identifier of the asset to update.
-// asset contains the fields to update. You only need to include
-// the fields you want to modify; other fields can be omitted.
+// asset holds the fields to change; fields that are not set are left
+// unchanged.
 //
 // Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-update
-func (s *AssetsService) UpdateContext(ctx context.Context, id int, asset Asset) (*AssetResponse, *http.Response, error) {
+func (s *AssetsService) UpdateContext(ctx context.Context, id int, asset AssetUpdateRequest) (*AssetResponse, *http.Response, error) {
 	u := fmt.Sprintf("api/v1/hardware/%d", id)
 	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, asset)
 	if err != nil {
@@ -182,6 +416,68 @@ func (s *AssetsService) UpdateContext(ctx context.Context, id int, asset Asset)
 	return &response, resp, nil
 }
 
+// Patch partially updates an existing asset in Snipe-IT.
+//
+// id is the unique identifier of the asset to update.
+// fields maps API field names (e.g., "name", "status_id", "_snipeit_ram_2")
+// to their new values. Only these fields are sent; all others are left unchanged.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-partial-update
+func (s *AssetsService) Patch(id int, fields map[string]interface{}) (*AssetResponse, *http.Response, error) {
+	return s.PatchContext(context.Background(), id, fields)
+}
+
+// PatchContext partially updates an existing asset in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the asset to update.
+// fields maps API field names (e.g., "name", "status_id", "_snipeit_ram_2")
+// to their new values. Only these fields are sent; all others are left unchanged.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-partial-update
+func (s *AssetsService) PatchContext(ctx context.Context, id int, fields map[string]interface{}) (*AssetResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/hardware/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPatch, u, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response AssetResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// SetCustomField sets the value of a single custom field of an asset,
+// leaving its other fields unchanged.
+//
+// id is the unique identifier of the asset to update.
+// column is the database column name of the custom field
+// (e.g., "_snipeit_mac_address_1"); the "_snipeit_" prefix may be omitted.
+// value is the new value of the field.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-partial-update
+func (s *AssetsService) SetCustomField(id int, column, value string) (*AssetResponse, *http.Response, error) {
+	return s.SetCustomFieldContext(context.Background(), id, column, value)
+}
+
+// SetCustomFieldContext sets the value of a single custom field of an asset
+// with the provided context, leaving its other fields unchanged.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the asset to update.
+// column is the database column name of the custom field
+// (e.g., "_snipeit_mac_address_1"); the "_snipeit_" prefix may be omitted.
+// value is the new value of the field.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-partial-update
+func (s *AssetsService) SetCustomFieldContext(ctx context.Context, id int, column, value string) (*AssetResponse, *http.Response, error) {
+	return s.PatchContext(ctx, id, map[string]interface{}{customFieldColumn(column): value})
+}
+
 // Delete deletes an asset from Snipe-IT.
 //
 // id is the unique identifier of the asset to delete.
@@ -213,6 +509,7 @@ func (s *AssetsService) DeleteContext(ctx context.Context, id int) (*http.Respon
 //
 // id is the unique identifier of the asset to check out.
 // checkout is a map containing checkout parameters, such as:
+// - checkout_to_type: "user", "asset" or "location"
 // - assigned_user: ID of the user to assign the asset to
 // - assigned_asset: ID of the asset to assign this asset to
 // - assigned_location: ID of the location to assign the asset to
@@ -220,6 +517,8 @@ func (s *AssetsService) DeleteContext(ctx context.Context, id int) (*http.Respon
 // - expected_checkin: Expected checkin date (YYYY-MM-DD format)
 // - note: Note about the checkout
 //
+// CheckoutWithOptions offers the same call with a typed CheckoutOptions.
+//
 // Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-checkout
 func (s *AssetsService) Checkout(id int, checkout map[string]interface{}) (*AssetResponse, *http.Response, error) {
 	return s.CheckoutContext(context.Background(), id, checkout)
@@ -230,6 +529,7 @@ func (s *AssetsService) Checkout(id int, checkout map[string]interface{}) (*Asse
 // ctx is the context for the request.
 // id is the unique identifier of the asset to check out.
 // checkout is a map containing checkout parameters, such as:
+// - checkout_to_type: "user", "asset" or "location"
 // - assigned_user: ID of the user to assign the asset to
 // - assigned_asset: ID of the asset to assign this asset to
 // - assigned_location: ID of the location to assign the asset to
@@ -254,6 +554,136 @@ func (s *AssetsService) CheckoutContext(ctx context.Context, id int, checkout ma
 	return &response, resp, nil
 }
 
+// CheckoutOptions specifies how an asset is checked out.
+//
+// Exactly one of CheckoutToUser, CheckoutToAsset or CheckoutToLocation must be set.
+// The zero Date for CheckoutAt or ExpectedCheckin leaves that date unset.
+type CheckoutOptions struct {
+	// CheckoutToUser is the ID of the user to check the asset out to
+	CheckoutToUser int
+
+	// CheckoutToAsset is the ID of the asset to check the asset out to
+	CheckoutToAsset int
+
+	// CheckoutToLocation is the ID of the location to check the asset out to
+	CheckoutToLocation int
+
+	// CheckoutAt is the date of the checkout; Snipe-IT uses today if unset
+	CheckoutAt Date
+
+	// ExpectedCheckin is the date the asset is expected to be checked back in
+	ExpectedCheckin Date
+
+	// Name overrides the asset's name as part of the checkout
+	Name string
+
+	// Note about the checkout
+	Note string
+}
+
+// MarshalJSON implements json.Marshaler for CheckoutOptions.
+// It writes the checkout_to_type and assigned_* fields the API expects,
+// and formats dates as YYYY-MM-DD.
+func (o CheckoutOptions) MarshalJSON() ([]byte, error) {
+	targets := 0
+	body := map[string]interface{}{}
+	if o.CheckoutToUser != 0 {
+		targets++
+		body["checkout_to_type"] = "user"
+		body["assigned_user"] = o.CheckoutToUser
+	}
+	if o.CheckoutToAsset != 0 {
+		targets++
+		body["checkout_to_type"] = "asset"
+		body["assigned_asset"] = o.CheckoutToAsset
+	}
+	if o.CheckoutToLocation != 0 {
+		targets++
+		body["checkout_to_type"] = "location"
+		body["assigned_location"] = o.CheckoutToLocation
+	}
+	if targets != 1 {
+		return nil, errors.New("snipeit: exactly one checkout target (user, asset or location) must be set")
+	}
+
+	if !o.CheckoutAt.IsZero() {
+		body["checkout_at"] = o.CheckoutAt
+	}
+	if !o.ExpectedCheckin.IsZero() {
+		body["expected_checkin"] = o.ExpectedCheckin
+	}
+	if o.Name != "" {
+		body["name"] = o.Name
+	}
+	if o.Note != "" {
+		body["note"] = o.Note
+	}
+
+	return json.Marshal(body)
+}
+
+// Validate checks that exactly one checkout target is set, that the target
+// ID is positive, and that ExpectedCheckin is not before CheckoutAt. It
+// returns ValidationErrors listing every problem found, or nil.
+func (o CheckoutOptions) Validate() error {
+	var v validation
+	targets := 0
+	for _, target := range []struct {
+		field string
+		id    int
+	}{
+		{"assigned_user", o.CheckoutToUser},
+		{"assigned_asset", o.CheckoutToAsset},
+		{"assigned_location", o.CheckoutToLocation},
+	} {
+		if target.id != 0 {
+			targets++
+		}
+		v.optionalID(target.field, target.id)
+	}
+	if targets != 1 {
+		v.add("checkout_to_type", "must name exactly one checkout target (user, asset or location)")
+	}
+	if !o.CheckoutAt.IsZero() && !o.ExpectedCheckin.IsZero() && o.ExpectedCheckin.Before(o.CheckoutAt) {
+		v.add("expected_checkin", "must not be before checkout_at")
+	}
+	return v.err()
+}
+
+// CheckoutWithOptions assigns an asset to a user, location, or another asset.
+//
+// id is the unique identifier of the asset to check out.
+// opts specifies the checkout target and optional dates, name and note.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-checkout
+func (s *AssetsService) CheckoutWithOptions(id int, opts CheckoutOptions) (*AssetResponse, *http.Response, error) {
+	return s.CheckoutWithOptionsContext(context.Background(), id, opts)
+}
+
+// CheckoutWithOptionsContext assigns an asset to a user, location, or another asset
+// with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the asset to check out.
+// opts specifies the checkout target and optional dates, name and note.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-checkout
+func (s *AssetsService) CheckoutWithOptionsContext(ctx context.Context, id int, opts CheckoutOptions) (*AssetResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/hardware/%d/checkout", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response AssetResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
 // Checkin returns an asset from a user, location, or asset it was assigned to.
 //
 // id is the unique identifier of the asset to check in.
@@ -263,6 +693,8 @@ func (s *AssetsService) CheckoutContext(ctx context.Context, id int, checkout ma
 // - status_id: ID of the status to assign after checkin
 // - checkin_at: Date of checkin (YYYY-MM-DD format)
 //
+// CheckinWithOptions offers the same call with a typed CheckinOptions.
+//
 // Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-checkin
 func (s *AssetsService) Checkin(id int, checkin map[string]interface{}) (*AssetResponse, *http.Response, error) {
 	return s.CheckinContext(context.Background(), id, checkin)
@@ -295,6 +727,84 @@ func (s *AssetsService) CheckinContext(ctx context.Context, id int, checkin map[
 	return &response, resp, nil
 }
 
+// CheckinOptions specifies how an asset is checked in.
+// All fields are optional; zero values are omitted from the request.
+type CheckinOptions struct {
+	// Note about the checkin
+	Note string
+
+	// LocationID is the ID of the location to assign the asset to after checkin
+	LocationID int
+
+	// StatusID is the ID of the status label to assign the asset after checkin
+	StatusID int
+
+	// CheckinAt is the date of the checkin; Snipe-IT uses today if unset
+	CheckinAt Date
+}
+
+// MarshalJSON implements json.Marshaler for CheckinOptions.
+// It omits unset fields and formats CheckinAt as YYYY-MM-DD.
+func (o CheckinOptions) MarshalJSON() ([]byte, error) {
+	body := map[string]interface{}{}
+	if o.Note != "" {
+		body["note"] = o.Note
+	}
+	if o.LocationID != 0 {
+		body["location_id"] = o.LocationID
+	}
+	if o.StatusID != 0 {
+		body["status_id"] = o.StatusID
+	}
+	if !o.CheckinAt.IsZero() {
+		body["checkin_at"] = o.CheckinAt
+	}
+
+	return json.Marshal(body)
+}
+
+// Validate checks that the location and status IDs are not negative. It
+// returns ValidationErrors listing every problem found, or nil.
+func (o CheckinOptions) Validate() error {
+	var v validation
+	v.optionalID("location_id", o.LocationID)
+	v.optionalID("status_id", o.StatusID)
+	return v.err()
+}
+
+// CheckinWithOptions returns an asset from a user, location, or asset it was assigned to.
+//
+// id is the unique identifier of the asset to check in.
+// opts specifies the optional note, location, status and date of the checkin.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-checkin
+func (s *AssetsService) CheckinWithOptions(id int, opts CheckinOptions) (*AssetResponse, *http.Response, error) {
+	return s.CheckinWithOptionsContext(context.Background(), id, opts)
+}
+
+// CheckinWithOptionsContext returns an asset with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the asset to check in.
+// opts specifies the optional note, location, status and date of the checkin.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-checkin
+func (s *AssetsService) CheckinWithOptionsContext(ctx context.Context, id int, opts CheckinOptions) (*AssetResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/hardware/%d/checkin", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response AssetResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
 // GetAssetBySerial fetches assets by serial number.
 //
 // serial is the manufacturer's serial number of the asset to retrieve.
@@ -328,4 +838,435 @@ func (s *AssetsService) GetAssetBySerialContext(ctx context.Context, serial stri
 	}
 
 	return &assets, resp, nil
-}
\ No newline at end of file
+}
+// GetAssetByTag fetches a single asset by its asset tag.
+//
+// tag is the asset tag of the asset to retrieve. It is escaped before
+// being placed in the URL, so tags containing slashes or spaces are supported.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-by-asset-tag
+func (s *AssetsService) GetAssetByTag(tag string) (*AssetResponse, *http.Response, error) {
+	return s.GetAssetByTagContext(context.Background(), tag)
+}
+
+// GetAssetByTagContext fetches a single asset by its asset tag with the provided context.
+//
+// ctx is the context for the request.
+// tag is the asset tag of the asset to retrieve. It is escaped before
+// being placed in the URL, so tags containing slashes or spaces are supported.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-by-asset-tag
+func (s *AssetsService) GetAssetByTagContext(ctx context.Context, tag string) (*AssetResponse, *http.Response, error) {
+	u := "api/v1/hardware/bytag/" + url.PathEscape(tag)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var asset AssetResponse
+	resp, err := s.client.Do(req, &asset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &asset, resp, nil
+}
+
+// AssetAudit describes an audit recorded against an asset.
+type AssetAudit struct {
+	// AssetTag is the asset tag of the audited asset
+	AssetTag string `json:"asset_tag"`
+
+	// Note recorded with the audit
+	Note string `json:"note,omitempty"`
+
+	// NextAuditDate is when the asset is next due for audit (YYYY-MM-DD)
+	NextAuditDate string `json:"next_audit_date,omitempty"`
+}
+
+// AssetAuditResponse represents the API response for an asset audit.
+type AssetAuditResponse struct {
+	Response
+	// Payload contains the recorded audit
+	Payload *AssetAudit `json:"payload,omitempty"`
+}
+
+// Audit records a physical audit of an asset.
+//
+// tag is the asset tag of the audited asset.
+// locationID is the ID of the location the asset was found at; zero leaves it unchanged.
+// nextAuditDate is when the asset is next due for audit; the zero time lets
+// Snipe-IT derive it from the configured audit interval.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-audit
+func (s *AssetsService) Audit(tag string, locationID int, nextAuditDate time.Time) (*AssetAuditResponse, *http.Response, error) {
+	return s.AuditContext(context.Background(), tag, locationID, nextAuditDate)
+}
+
+// AuditContext records a physical audit of an asset with the provided context.
+//
+// ctx is the context for the request.
+// tag is the asset tag of the audited asset.
+// locationID is the ID of the location the asset was found at; zero leaves it unchanged.
+// nextAuditDate is when the asset is next due for audit; the zero time lets
+// Snipe-IT derive it from the configured audit interval.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-audit
+func (s *AssetsService) AuditContext(ctx context.Context, tag string, locationID int, nextAuditDate time.Time) (*AssetAuditResponse, *http.Response, error) {
+	body := map[string]interface{}{
+		"asset_tag": tag,
+	}
+	if locationID != 0 {
+		body["location_id"] = locationID
+	}
+	if !nextAuditDate.IsZero() {
+		body["next_audit_date"] = nextAuditDate.Format("2006-01-02")
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/hardware/audit", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response AssetAuditResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// AuditDue returns the assets that are due for audit within the configured warning period.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-audit-due
+func (s *AssetsService) AuditDue(opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	return s.AuditDueContext(context.Background(), opts)
+}
+
+// AuditDueContext returns the assets that are due for audit with the provided context.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-audit-due
+func (s *AssetsService) AuditDueContext(ctx context.Context, opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	return s.listAssets(ctx, "api/v1/hardware/audit/due", opts)
+}
+
+// AuditOverdue returns the assets whose next audit date has passed.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-audit-overdue
+func (s *AssetsService) AuditOverdue(opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	return s.AuditOverdueContext(context.Background(), opts)
+}
+
+// AuditOverdueContext returns the assets whose next audit date has passed
+// with the provided context.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-audit-overdue
+func (s *AssetsService) AuditOverdueContext(ctx context.Context, opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	return s.listAssets(ctx, "api/v1/hardware/audit/overdue", opts)
+}
+
+// listAssets fetches a page of assets from a list endpoint such as
+// "api/v1/hardware/audit/due".
+func (s *AssetsService) listAssets(ctx context.Context, u string, opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var assets AssetsResponse
+	resp, err := s.client.Do(req, &assets)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &assets, resp, nil
+}
+
+// Requestable returns the assets that users are allowed to request.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-requestable
+func (s *AssetsService) Requestable(opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	return s.RequestableContext(context.Background(), opts)
+}
+
+// RequestableContext returns the assets that users are allowed to request
+// with the provided context.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-requestable
+func (s *AssetsService) RequestableContext(ctx context.Context, opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	return s.listAssets(ctx, "api/v1/hardware/requestable", opts)
+}
+
+// Request places a checkout request for an asset on behalf of the user
+// that owns the API token.
+//
+// id is the unique identifier of the requestable asset.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-request
+func (s *AssetsService) Request(id int) (*Response, *http.Response, error) {
+	return s.RequestContext(context.Background(), id)
+}
+
+// RequestContext places a checkout request for an asset with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the requestable asset.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-request
+func (s *AssetsService) RequestContext(ctx context.Context, id int) (*Response, *http.Response, error) {
+	return s.changeRequest(ctx, http.MethodPost, id)
+}
+
+// CancelRequest cancels a pending checkout request for an asset made by
+// the user that owns the API token.
+//
+// id is the unique identifier of the requested asset.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-request-cancel
+func (s *AssetsService) CancelRequest(id int) (*Response, *http.Response, error) {
+	return s.CancelRequestContext(context.Background(), id)
+}
+
+// CancelRequestContext cancels a pending checkout request for an asset
+// with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the requested asset.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-request-cancel
+func (s *AssetsService) CancelRequestContext(ctx context.Context, id int) (*Response, *http.Response, error) {
+	return s.changeRequest(ctx, http.MethodDelete, id)
+}
+
+// changeRequest creates (POST) or cancels (DELETE) a checkout request for an asset.
+func (s *AssetsService) changeRequest(ctx context.Context, method string, id int) (*Response, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/hardware/%d/request", id)
+	req, err := s.client.newRequestWithContext(ctx, method, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response Response
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// dueForCheckinPageSize is the page size used when scanning checked-out
+// assets for DueForCheckin.
+const dueForCheckinPageSize = 500
+
+// DueForCheckin returns the checked-out assets whose expected checkin date
+// falls within the given duration from now, including assets already overdue.
+//
+// within is the length of the window, starting now.
+// opts.Search is passed to the API; opts.Offset and opts.Limit page through
+// the matching assets. If opts is nil, all matching assets are returned.
+//
+// Snipe-IT cannot filter assets by expected checkin date, so DueForCheckin
+// scans all deployed assets and filters them on the client. The results are
+// sorted by expected checkin date, earliest first, and Total is the number
+// of matching assets.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-list
+func (s *AssetsService) DueForCheckin(within time.Duration, opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	return s.DueForCheckinContext(context.Background(), within, opts)
+}
+
+// DueForCheckinContext returns the checked-out assets whose expected checkin
+// date falls within the given duration from now, with the provided context.
+//
+// ctx is the context for the requests.
+// within is the length of the window, starting now.
+// opts.Search is passed to the API; opts.Offset and opts.Limit page through
+// the matching assets. If opts is nil, all matching assets are returned.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-list
+func (s *AssetsService) DueForCheckinContext(ctx context.Context, within time.Duration, opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	deadline := time.Now().Add(within)
+
+	scan := struct {
+		ListOptions
+		Status string `url:"status"`
+	}{Status: "Deployed"}
+	scan.Limit = dueForCheckinPageSize
+	if opts != nil {
+		scan.Search = opts.Search
+	}
+
+	var due []Asset
+	var resp *http.Response
+	for {
+		u, err := s.client.AddOptions("api/v1/hardware", &scan)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var page AssetsResponse
+		resp, err = s.client.Do(req, &page)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, asset := range page.Rows {
+			if asset.ExpectedCheckin != nil && !asset.ExpectedCheckin.IsZero() && !asset.ExpectedCheckin.After(deadline) {
+				due = append(due, asset)
+			}
+		}
+
+		scan.Offset += len(page.Rows)
+		if len(page.Rows) == 0 || scan.Offset >= page.Total {
+			break
+		}
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].ExpectedCheckin.Before(due[j].ExpectedCheckin.Time)
+	})
+
+	result := &AssetsResponse{Response: Response{Total: len(due)}}
+	if opts != nil && opts.Offset > 0 {
+		if opts.Offset >= len(due) {
+			due = nil
+		} else {
+			due = due[opts.Offset:]
+		}
+	}
+	if opts != nil && opts.Limit > 0 && opts.Limit < len(due) {
+		due = due[:opts.Limit]
+	}
+	result.Rows = due
+	result.Count = len(due)
+
+	return result, resp, nil
+}
+
+// Maintenances returns the maintenance records of an asset.
+//
+// id is the unique identifier of the asset.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenances
+func (s *AssetsService) Maintenances(id int, opts *ListOptions) (*MaintenancesResponse, *http.Response, error) {
+	return s.MaintenancesContext(context.Background(), id, opts)
+}
+
+// MaintenancesContext returns the maintenance records of an asset with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the asset.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenances
+func (s *AssetsService) MaintenancesContext(ctx context.Context, id int, opts *ListOptions) (*MaintenancesResponse, *http.Response, error) {
+	maintenanceOpts := &MaintenanceListOptions{AssetID: id}
+	if opts != nil {
+		maintenanceOpts.ListOptions = *opts
+	}
+
+	return s.client.Maintenances.ListContext(ctx, maintenanceOpts)
+}
+
+// ListDeleted returns soft-deleted assets.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-list
+func (s *AssetsService) ListDeleted(opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	return s.ListDeletedContext(context.Background(), opts)
+}
+
+// ListDeletedContext returns soft-deleted assets with the provided context.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-list
+func (s *AssetsService) ListDeletedContext(ctx context.Context, opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	deletedOpts := struct {
+		ListOptions
+		Deleted bool `url:"deleted"`
+	}{Deleted: true}
+	if opts != nil {
+		deletedOpts.ListOptions = *opts
+	}
+
+	u, err := s.client.AddOptions("api/v1/hardware", &deletedOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.listAssets(ctx, u, nil)
+}
+
+// Purge permanently removes a soft-deleted asset from Snipe-IT.
+//
+// id is the unique identifier of the soft-deleted asset to purge.
+// Unlike Delete, this cannot be undone. Snipe-IT refuses to purge
+// assets that have not been deleted first.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-purge
+func (s *AssetsService) Purge(id int) (*http.Response, error) {
+	return s.PurgeContext(context.Background(), id)
+}
+
+// PurgeContext permanently removes a soft-deleted asset from Snipe-IT
+// with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the soft-deleted asset to purge.
+// Unlike Delete, this cannot be undone. Snipe-IT refuses to purge
+// assets that have not been deleted first.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-purge
+func (s *AssetsService) PurgeContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/hardware/%d/purge", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -177,6 +177,115 @@ func TestAssetsList(t *testing.T) {
 	}
 }
 
+func TestAssetsListDateObjects(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [{
+				"id": 1,
+				"asset_tag": "AT-1",
+				"purchase_date": {"date": "2022-12-01", "formatted": "Thu Dec 01, 2022"},
+				"expected_checkin": {"date": "2023-02-01", "formatted": "Wed Feb 01, 2023"},
+				"created_at": {"datetime": "2023-01-01 12:00:00", "formatted": "Sun Jan 01, 2023 12:00PM"},
+				"updated_at": {"datetime": "2023-01-02 08:30:00", "formatted": "Mon Jan 02, 2023 8:30AM"},
+				"deleted_at": null,
+				"model": {"id": 1, "name": "Model 1", "created_at": {"datetime": null, "formatted": null}}
+			}]
+		}`)
+	})
+
+	assets, _, err := client.Assets.List(nil)
+	if err != nil {
+		t.Fatalf("Assets.List returned error: %v", err)
+	}
+
+	asset := assets.Rows[0]
+	tests := []struct {
+		field    string
+		got      *SnipeTime
+		expected time.Time
+	}{
+		{"PurchaseDate", asset.PurchaseDate, time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{"ExpectedCheckin", asset.ExpectedCheckin, time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"CreatedAt", asset.CreatedAt, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{"UpdatedAt", asset.UpdatedAt, time.Date(2023, 1, 2, 8, 30, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if tt.got == nil || !tt.got.Time.Equal(tt.expected) {
+			t.Errorf("Asset.%s = %v, expected %v", tt.field, tt.got, tt.expected)
+		}
+	}
+
+	if asset.DeletedAt != nil {
+		t.Errorf("Asset.DeletedAt = %v, expected nil", asset.DeletedAt)
+	}
+	if asset.Model.CreatedAt == nil || !asset.Model.CreatedAt.IsZero() {
+		t.Errorf("Model.CreatedAt = %v, expected the zero time", asset.Model.CreatedAt)
+	}
+}
+
+func TestAssetsGetFullRecord(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"id": 1,
+			"asset_tag": "AT-1",
+			"byod": true,
+			"order_number": "PO-1234",
+			"company": {"id": 2, "name": "Acme"},
+			"rtd_location": {"id": 3, "name": "HQ"},
+			"last_checkout": {"datetime": "2023-03-01 09:00:00", "formatted": "Wed Mar 01, 2023 9:00AM"},
+			"last_audit_date": {"datetime": "2023-02-01 10:00:00", "formatted": "Wed Feb 01, 2023 10:00AM"},
+			"next_audit_date": {"date": "2024-02-01", "formatted": "Thu Feb 01, 2024"},
+			"eol": "36 months",
+			"asset_eol_date": {"date": "2025-12-01", "formatted": "Mon Dec 01, 2025"},
+			"book_value": "1,024.50",
+			"checkin_counter": 4,
+			"checkout_counter": 5,
+			"requests_counter": 1
+		}`)
+	})
+
+	asset, _, err := client.Assets.Get(1)
+	if err != nil {
+		t.Fatalf("Assets.Get returned error: %v", err)
+	}
+
+	if !asset.BYOD || asset.OrderNumber != "PO-1234" || asset.EOL != "36 months" {
+		t.Errorf("Assets.Get returned BYOD = %v, OrderNumber = %q, EOL = %q", asset.BYOD, asset.OrderNumber, asset.EOL)
+	}
+	if asset.Company == nil || asset.Company.Name != "Acme" || asset.RTDLocation == nil || asset.RTDLocation.ID != 3 {
+		t.Errorf("Assets.Get returned Company = %+v, RTDLocation = %+v", asset.Company, asset.RTDLocation)
+	}
+	if asset.CheckinCounter != 4 || asset.CheckoutCounter != 5 || asset.RequestsCounter != 1 {
+		t.Errorf("Assets.Get returned counters %d/%d/%d, expected 4/5/1", asset.CheckinCounter, asset.CheckoutCounter, asset.RequestsCounter)
+	}
+	if asset.BookValue == nil || asset.BookValue.Cents != 102450 {
+		t.Errorf("Assets.Get returned BookValue = %v, expected 1024.50", asset.BookValue)
+	}
+
+	dates := []struct {
+		field    string
+		got      *SnipeTime
+		expected time.Time
+	}{
+		{"LastCheckout", asset.LastCheckout, time.Date(2023, 3, 1, 9, 0, 0, 0, time.UTC)},
+		{"LastAuditDate", asset.LastAuditDate, time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC)},
+		{"NextAuditDate", asset.NextAuditDate, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"AssetEOLDate", asset.AssetEOLDate, time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range dates {
+		if tt.got == nil || !tt.got.Time.Equal(tt.expected) {
+			t.Errorf("Asset.%s = %v, expected %v", tt.field, tt.got, tt.expected)
+		}
+	}
+}
+
 func TestAssetsGet(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -287,6 +396,12 @@ func TestAssetsCreate(t *testing.T) {
 		if requestBody["asset_tag"] != "NEW-1" {
 			t.Errorf("Request body asset_tag = %v, expected %v", requestBody["asset_tag"], "NEW-1")
 		}
+		if requestBody["model_id"] != 1.0 || requestBody["status_id"] != 1.0 {
+			t.Errorf("Request body model_id = %v, status_id = %v, expected 1", requestBody["model_id"], requestBody["status_id"])
+		}
+		if _, ok := requestBody["model"]; ok {
+			t.Errorf("Request body contains a nested model: %v", requestBody)
+		}
 
 		fmt.Fprint(w, `{
 			"status": "success",
@@ -321,22 +436,12 @@ func TestAssetsCreate(t *testing.T) {
 		}`)
 	})
 
-	newAsset := Asset{
+	newAsset := AssetCreateRequest{
 		AssetTag: "NEW-1",
 		Serial:   "SN-NEW-1",
-		CommonFields: CommonFields{
-			Name: "New Asset",
-		},
-		Model: Model{
-			CommonFields: CommonFields{
-				ID: 1,
-			},
-		},
-		StatusLabel: StatusLabel{
-			CommonFields: CommonFields{
-				ID: 1,
-			},
-		},
+		Name:     "New Asset",
+		ModelID:  1,
+		StatusID: 1,
 	}
 
 	asset, _, err := client.Assets.Create(newAsset)
@@ -364,8 +469,9 @@ func TestAssetsUpdate(t *testing.T) {
 		var requestBody map[string]interface{}
 		json.NewDecoder(r.Body).Decode(&requestBody)
 		
-		if requestBody["name"] != "Updated Asset" {
-			t.Errorf("Request body name = %v, expected %v", requestBody["name"], "Updated Asset")
+		expected := map[string]interface{}{"name": "Updated Asset", "location_id": nil}
+		if !reflect.DeepEqual(requestBody, expected) {
+			t.Errorf("Request body = %v, expected %v", requestBody, expected)
 		}
 
 		fmt.Fprint(w, `{
@@ -401,10 +507,9 @@ func TestAssetsUpdate(t *testing.T) {
 		}`)
 	})
 
-	updateAsset := Asset{
-		CommonFields: CommonFields{
-			Name: "Updated Asset",
-		},
+	updateAsset := AssetUpdateRequest{
+		Name:       NewNullable("Updated Asset"),
+		LocationID: Null[int](),
 	}
 
 	asset, _, err := client.Assets.Update(1, updateAsset)
@@ -493,9 +598,9 @@ func TestAssetsCheckout(t *testing.T) {
 		t.Errorf("Assets.Checkout returned Status = %s, expected %s", asset.Status, "success")
 	}
 
-	if asset.Payload.User == nil || asset.Payload.User.ID != 2 {
+	if asset.Payload.AssignedTo == nil || asset.Payload.AssignedTo.User() == nil || asset.Payload.AssignedTo.ID() != 2 {
 		t.Errorf("Assets.Checkout assigned_to user ID = %v, expected %v", 
-			asset.Payload.User, 2)
+			asset.Payload.AssignedTo, 2)
 	}
 
 	if asset.Payload.AssignedType != "user" {
@@ -553,9 +658,9 @@ func TestAssetsCheckin(t *testing.T) {
 		t.Errorf("Assets.Checkin returned Status = %s, expected %s", asset.Status, "success")
 	}
 
-	if asset.User != nil {
+	if asset.AssignedTo != nil {
 		t.Errorf("Assets.Checkin assigned_to = %v, expected %v", 
-			asset.User, nil)
+			asset.AssignedTo, nil)
 	}
 
 	if asset.Available != true {
@@ -573,6 +678,7 @@ func TestAssetsGetAssetBySerial(t *testing.T) {
 		testHeader(t, r, "Accept", "application/json")
 		testHeader(t, r, "Authorization", "Bearer test-token")
 		fmt.Fprint(w, `{
+			"status": "success",
 			"total": 1,
 			"rows": [{
 				"id": 1,
@@ -656,8 +762,12 @@ func TestAssetsGetAssetBySerial(t *testing.T) {
 		},
 	}
 
-	if !reflect.DeepEqual(asset, expectedAsset) {
-		t.Errorf("Assets.GetAssetBySerial returned = %+v, expected %+v", asset, expectedAsset)
+	if len(asset.Rows) != 1 {
+		t.Fatalf("Assets.GetAssetBySerial returned %d assets, expected %d", len(asset.Rows), 1)
+	}
+
+	if !reflect.DeepEqual(asset.Rows[0], expectedAsset) {
+		t.Errorf("Assets.GetAssetBySerial returned = %+v, expected %+v", asset.Rows[0], expectedAsset)
 	}
 }
 
@@ -722,4 +832,419 @@ func TestAssetsGetAssetBySerialContext(t *testing.T) {
 	} else if err != context.DeadlineExceeded {
 		t.Errorf("Expected context.DeadlineExceeded error, got %v", err)
 	}
-}
\ No newline at end of file
+}
+func TestAssetsGetAssetByTag(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/bytag/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if r.URL.EscapedPath() != "/api/v1/hardware/bytag/AT%2F42" {
+			t.Errorf("Request path = %v, expected %v", r.URL.EscapedPath(), "/api/v1/hardware/bytag/AT%2F42")
+		}
+
+		fmt.Fprint(w, `{"id": 42, "name": "Laptop 42", "asset_tag": "AT/42"}`)
+	})
+
+	asset, _, err := client.Assets.GetAssetByTag("AT/42")
+	if err != nil {
+		t.Fatalf("Assets.GetAssetByTag returned error: %v", err)
+	}
+
+	if asset.ID != 42 || asset.AssetTag != "AT/42" {
+		t.Errorf("Assets.GetAssetByTag returned ID = %d, AssetTag = %q, expected %d, %q", asset.ID, asset.AssetTag, 42, "AT/42")
+	}
+}
+
+func TestAssetsAudit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/audit", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["asset_tag"] != "AT-42" {
+			t.Errorf("Request body asset_tag = %v, expected %v", requestBody["asset_tag"], "AT-42")
+		}
+		if requestBody["location_id"] != float64(3) {
+			t.Errorf("Request body location_id = %v, expected %v", requestBody["location_id"], 3)
+		}
+		if requestBody["next_audit_date"] != "2025-06-30" {
+			t.Errorf("Request body next_audit_date = %v, expected %v", requestBody["next_audit_date"], "2025-06-30")
+		}
+
+		fmt.Fprint(w, `{"status": "success", "messages": "Asset audited", "payload": {"asset_tag": "AT-42", "next_audit_date": "2025-06-30"}}`)
+	})
+
+	audit, _, err := client.Assets.Audit("AT-42", 3, time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Assets.Audit returned error: %v", err)
+	}
+
+	if audit.Payload == nil || audit.Payload.NextAuditDate != "2025-06-30" {
+		t.Errorf("Assets.Audit returned Payload = %+v, expected next audit date %q", audit.Payload, "2025-06-30")
+	}
+}
+
+func TestAssetsAuditOmitsZeroValues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/audit", func(w http.ResponseWriter, r *http.Request) {
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if _, ok := requestBody["location_id"]; ok {
+			t.Errorf("Request body location_id = %v, expected it to be omitted", requestBody["location_id"])
+		}
+		if _, ok := requestBody["next_audit_date"]; ok {
+			t.Errorf("Request body next_audit_date = %v, expected it to be omitted", requestBody["next_audit_date"])
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"asset_tag": "AT-42"}}`)
+	})
+
+	if _, _, err := client.Assets.Audit("AT-42", 0, time.Time{}); err != nil {
+		t.Fatalf("Assets.Audit returned error: %v", err)
+	}
+}
+
+func TestAssetsAuditDueAndOverdue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/audit/due", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if r.URL.Query().Get("limit") != "25" {
+			t.Errorf("Request URL query parameter 'limit' = %v, expected %v", r.URL.Query().Get("limit"), "25")
+		}
+
+		fmt.Fprint(w, `{"total": 2, "rows": [{"id": 1, "asset_tag": "AT-1"}, {"id": 2, "asset_tag": "AT-2"}]}`)
+	})
+	mux.HandleFunc("/api/v1/hardware/audit/overdue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 3, "asset_tag": "AT-3"}]}`)
+	})
+
+	due, _, err := client.Assets.AuditDue(&ListOptions{Limit: 25})
+	if err != nil {
+		t.Fatalf("Assets.AuditDue returned error: %v", err)
+	}
+	if due.Total != 2 || len(due.Rows) != 2 {
+		t.Errorf("Assets.AuditDue returned Total = %d with %d rows, expected 2 and 2", due.Total, len(due.Rows))
+	}
+
+	overdue, _, err := client.Assets.AuditOverdue(nil)
+	if err != nil {
+		t.Fatalf("Assets.AuditOverdue returned error: %v", err)
+	}
+	if len(overdue.Rows) != 1 || overdue.Rows[0].AssetTag != "AT-3" {
+		t.Errorf("Assets.AuditOverdue returned %+v, expected asset AT-3", overdue.Rows)
+	}
+}
+
+func TestAssetsPatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		expected := map[string]interface{}{"status_id": float64(4), "notes": "Screen replaced"}
+		if !reflect.DeepEqual(requestBody, expected) {
+			t.Errorf("Request body = %v, expected %v", requestBody, expected)
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 42, "asset_tag": "AT-42", "notes": "Screen replaced"}}`)
+	})
+
+	asset, _, err := client.Assets.Patch(42, map[string]interface{}{
+		"status_id": 4,
+		"notes":     "Screen replaced",
+	})
+	if err != nil {
+		t.Fatalf("Assets.Patch returned error: %v", err)
+	}
+
+	if asset.Notes != "Screen replaced" {
+		t.Errorf("Assets.Patch returned Notes = %q, expected %q", asset.Notes, "Screen replaced")
+	}
+}
+
+func TestAssetsRequestable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/requestable", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 7, "asset_tag": "LOANER-7"}]}`)
+	})
+
+	assets, _, err := client.Assets.Requestable(nil)
+	if err != nil {
+		t.Fatalf("Assets.Requestable returned error: %v", err)
+	}
+
+	if len(assets.Rows) != 1 || assets.Rows[0].AssetTag != "LOANER-7" {
+		t.Errorf("Assets.Requestable returned %+v, expected asset LOANER-7", assets.Rows)
+	}
+}
+
+func TestAssetsRequestAndCancel(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var methods []string
+	mux.HandleFunc("/api/v1/hardware/7/request", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		fmt.Fprint(w, `{"status": "success", "messages": "ok"}`)
+	})
+
+	response, _, err := client.Assets.Request(7)
+	if err != nil {
+		t.Fatalf("Assets.Request returned error: %v", err)
+	}
+	if response.Status != "success" {
+		t.Errorf("Assets.Request returned Status = %q, expected %q", response.Status, "success")
+	}
+
+	if _, _, err := client.Assets.CancelRequest(7); err != nil {
+		t.Fatalf("Assets.CancelRequest returned error: %v", err)
+	}
+
+	expected := []string{http.MethodPost, http.MethodDelete}
+	if !reflect.DeepEqual(methods, expected) {
+		t.Errorf("Requests used methods %v, expected %v", methods, expected)
+	}
+}
+
+func TestAssetsCheckoutWithOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/42/checkout", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		expected := map[string]interface{}{
+			"checkout_to_type":  "location",
+			"assigned_location": float64(3),
+			"checkout_at":       "2024-05-01",
+			"expected_checkin":  "2024-06-01",
+			"note":              "Conference room",
+		}
+		if !reflect.DeepEqual(requestBody, expected) {
+			t.Errorf("Request body = %v, expected %v", requestBody, expected)
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 42, "asset_tag": "AT-42"}}`)
+	})
+
+	opts := CheckoutOptions{
+		CheckoutToLocation: 3,
+		CheckoutAt:         NewDate(2024, 5, 1),
+		ExpectedCheckin:    NewDate(2024, 6, 1),
+		Note:               "Conference room",
+	}
+
+	asset, _, err := client.Assets.CheckoutWithOptions(42, opts)
+	if err != nil {
+		t.Fatalf("Assets.CheckoutWithOptions returned error: %v", err)
+	}
+
+	if asset.ID != 42 {
+		t.Errorf("Assets.CheckoutWithOptions returned ID = %d, expected %d", asset.ID, 42)
+	}
+}
+
+func TestCheckoutOptionsRequiresOneTarget(t *testing.T) {
+	tests := []struct {
+		name string
+		opts CheckoutOptions
+	}{
+		{"No target", CheckoutOptions{Note: "missing"}},
+		{"Two targets", CheckoutOptions{CheckoutToUser: 1, CheckoutToAsset: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := json.Marshal(tt.opts); err == nil {
+				t.Errorf("json.Marshal(%+v) returned nil error, expected an error", tt.opts)
+			}
+		})
+	}
+}
+
+func TestAssetsCheckinWithOptions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/42/checkin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		expected := map[string]interface{}{
+			"status_id":  float64(2),
+			"checkin_at": "2024-06-01",
+			"note":       "Returned with scratches",
+		}
+		if !reflect.DeepEqual(requestBody, expected) {
+			t.Errorf("Request body = %v, expected %v", requestBody, expected)
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 42, "asset_tag": "AT-42"}}`)
+	})
+
+	opts := CheckinOptions{
+		Note:      "Returned with scratches",
+		StatusID:  2,
+		CheckinAt: NewDate(2024, 6, 1),
+	}
+
+	if _, _, err := client.Assets.CheckinWithOptions(42, opts); err != nil {
+		t.Fatalf("Assets.CheckinWithOptions returned error: %v", err)
+	}
+}
+
+func TestAssetsDueForCheckin(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	day := func(offset int) string {
+		return time.Now().AddDate(0, 0, offset).Format("2006-01-02")
+	}
+
+	pages := 0
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		pages++
+
+		query := r.URL.Query()
+		if query.Get("status") != "Deployed" {
+			t.Errorf("Request URL query parameter 'status' = %v, expected %v", query.Get("status"), "Deployed")
+		}
+
+		switch query.Get("offset") {
+		case "":
+			fmt.Fprintf(w, `{"total": 4, "rows": [
+				{"id": 1, "expected_checkin": {"date": "%s", "formatted": "x"}},
+				{"id": 2, "expected_checkin": {"date": "%s", "formatted": "x"}}
+			]}`, day(30), day(3))
+		case "2":
+			fmt.Fprintf(w, `{"total": 4, "rows": [
+				{"id": 3, "expected_checkin": null},
+				{"id": 4, "expected_checkin": {"date": "%s", "formatted": "x"}}
+			]}`, day(-2))
+		default:
+			t.Errorf("Request URL query parameter 'offset' = %v, expected empty or 2", query.Get("offset"))
+		}
+	})
+
+	due, _, err := client.Assets.DueForCheckin(7*24*time.Hour, nil)
+	if err != nil {
+		t.Fatalf("Assets.DueForCheckin returned error: %v", err)
+	}
+
+	if pages != 2 {
+		t.Errorf("Assets.DueForCheckin fetched %d pages, expected %d", pages, 2)
+	}
+
+	if due.Total != 2 || len(due.Rows) != 2 {
+		t.Fatalf("Assets.DueForCheckin returned Total = %d with %d rows, expected 2 and 2", due.Total, len(due.Rows))
+	}
+
+	if due.Rows[0].ID != 4 || due.Rows[1].ID != 2 {
+		t.Errorf("Assets.DueForCheckin returned assets %d and %d, expected overdue asset 4 then asset 2", due.Rows[0].ID, due.Rows[1].ID)
+	}
+
+	page, _, err := client.Assets.DueForCheckin(7*24*time.Hour, &ListOptions{Offset: 1, Limit: 1})
+	if err != nil {
+		t.Fatalf("Assets.DueForCheckin returned error: %v", err)
+	}
+
+	if page.Total != 2 || len(page.Rows) != 1 || page.Rows[0].ID != 2 {
+		t.Errorf("Assets.DueForCheckin returned Total = %d, Rows = %+v, expected the second of 2 assets", page.Total, page.Rows)
+	}
+}
+
+func TestAssetsMaintenances(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/maintenances", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		query := r.URL.Query()
+		if query.Get("asset_id") != "42" {
+			t.Errorf("Request URL query parameter 'asset_id' = %v, expected %v", query.Get("asset_id"), "42")
+		}
+		if query.Get("sort") != "start_date" {
+			t.Errorf("Request URL query parameter 'sort' = %v, expected %v", query.Get("sort"), "start_date")
+		}
+
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 3, "title": "Battery replacement", "asset": {"id": 42}}]}`)
+	})
+
+	maintenances, _, err := client.Assets.Maintenances(42, &ListOptions{Sort: "start_date"})
+	if err != nil {
+		t.Fatalf("Assets.Maintenances returned error: %v", err)
+	}
+
+	if len(maintenances.Rows) != 1 || maintenances.Rows[0].Title != "Battery replacement" {
+		t.Errorf("Assets.Maintenances returned %+v, expected the battery replacement", maintenances.Rows)
+	}
+}
+
+func TestAssetsListDeleted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		query := r.URL.Query()
+		if query.Get("deleted") != "true" {
+			t.Errorf("Request URL query parameter 'deleted' = %v, expected %v", query.Get("deleted"), "true")
+		}
+		if query.Get("limit") != "50" {
+			t.Errorf("Request URL query parameter 'limit' = %v, expected %v", query.Get("limit"), "50")
+		}
+
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 5, "asset_tag": "AT-5", "deleted_at": {"datetime": "2023-01-01 10:00:00", "formatted": "x"}}]}`)
+	})
+
+	assets, _, err := client.Assets.ListDeleted(&ListOptions{Limit: 50})
+	if err != nil {
+		t.Fatalf("Assets.ListDeleted returned error: %v", err)
+	}
+
+	if len(assets.Rows) != 1 || assets.Rows[0].DeletedAt == nil || assets.Rows[0].DeletedAt.Year() != 2023 {
+		t.Errorf("Assets.ListDeleted returned %+v, expected asset 5 deleted in 2023", assets.Rows)
+	}
+}
+
+func TestAssetsPurge(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/5/purge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success"}`)
+	})
+
+	if _, err := client.Assets.Purge(5); err != nil {
+		t.Fatalf("Assets.Purge returned error: %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AssignedTo is the entity an asset is checked out to: a user, a location
+// or another asset. Use Kind to find which, and the accessor of that kind
+// to get it; the other accessors return nil.
+type AssignedTo struct {
+	kind     AssignedType
+	user     *User
+	location *Location
+	asset    *Asset
+}
+
+// AssignedToUser returns an AssignedTo holding user.
+func AssignedToUser(user *User) *AssignedTo {
+	return &AssignedTo{kind: AssignedTypeUser, user: user}
+}
+
+// AssignedToLocation returns an AssignedTo holding location.
+func AssignedToLocation(location *Location) *AssignedTo {
+	return &AssignedTo{kind: AssignedTypeLocation, location: location}
+}
+
+// AssignedToAsset returns an AssignedTo holding asset.
+func AssignedToAsset(asset *Asset) *AssignedTo {
+	return &AssignedTo{kind: AssignedTypeAsset, asset: asset}
+}
+
+// Kind returns AssignedTypeUser, AssignedTypeLocation or AssignedTypeAsset.
+func (a *AssignedTo) Kind() AssignedType {
+	return a.kind
+}
+
+// User returns the user the asset is checked out to, or nil if it is
+// checked out to something else.
+func (a *AssignedTo) User() *User {
+	return a.user
+}
+
+// Location returns the location the asset is checked out to, or nil if it
+// is checked out to something else.
+func (a *AssignedTo) Location() *Location {
+	return a.location
+}
+
+// Asset returns the asset the asset is checked out to, or nil if it is
+// checked out to something else.
+func (a *AssignedTo) Asset() *Asset {
+	return a.asset
+}
+
+// entity returns the user, location or asset, or nil if there is none.
+func (a *AssignedTo) entity() interface{} {
+	switch {
+	case a.user != nil:
+		return a.user
+	case a.location != nil:
+		return a.location
+	case a.asset != nil:
+		return a.asset
+	}
+	return nil
+}
+
+// common returns the common fields of the entity.
+func (a *AssignedTo) common() *CommonFields {
+	switch {
+	case a.user != nil:
+		return &a.user.CommonFields
+	case a.location != nil:
+		return &a.location.CommonFields
+	case a.asset != nil:
+		return &a.asset.CommonFields
+	}
+	return &CommonFields{}
+}
+
+// ID returns the unique identifier of the entity.
+func (a *AssignedTo) ID() int {
+	return a.common().ID
+}
+
+// Name returns the name of the entity.
+func (a *AssignedTo) Name() string {
+	return a.common().Name
+}
+
+// assignedKind returns the kind of an assigned_to object from its type
+// discriminator, which is either a kind ("user") or a model class
+// ("App\\Models\\User"). Objects without one are taken as assets if they
+// have an asset tag, and as users otherwise.
+func assignedKind(discriminator string, hasUsername, hasAssetTag bool) AssignedType {
+	if discriminator == "" {
+		if hasAssetTag && !hasUsername {
+			return AssignedTypeAsset
+		}
+		return AssignedTypeUser
+	}
+	return AssignedType(discriminator).Kind()
+}
+
+// UnmarshalJSON implements json.Unmarshaler for AssignedTo. The kind is
+// read from the object's "type" field, which Snipe-IT sets to the same
+// value as the asset's assigned_type. Objects without one are taken as
+// assets if they have an asset tag, and as users otherwise.
+func (a *AssignedTo) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var discriminator struct {
+		Type     string          `json:"type"`
+		Username json.RawMessage `json:"username"`
+		AssetTag json.RawMessage `json:"asset_tag"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return err
+	}
+
+	switch assignedKind(discriminator.Type, discriminator.Username != nil, discriminator.AssetTag != nil) {
+	case AssignedTypeUser:
+		var user User
+		if err := json.Unmarshal(data, &user); err != nil {
+			return err
+		}
+		*a = *AssignedToUser(&user)
+	case AssignedTypeLocation:
+		var location Location
+		if err := json.Unmarshal(data, &location); err != nil {
+			return err
+		}
+		*a = *AssignedToLocation(&location)
+	case AssignedTypeAsset:
+		var asset Asset
+		if err := json.Unmarshal(data, &asset); err != nil {
+			return err
+		}
+		*a = *AssignedToAsset(&asset)
+	default:
+		return fmt.Errorf("snipeit: cannot decode assigned_to of unknown type %q", discriminator.Type)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for AssignedTo, encoding the
+// entity with a "type" field holding its kind.
+func (a AssignedTo) MarshalJSON() ([]byte, error) {
+	entity := a.entity()
+	if entity == nil {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	object["type"], _ = json.Marshal(a.kind)
+	return json.Marshal(object)
+}
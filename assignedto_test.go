@@ -0,0 +1,89 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAssignedToUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		kind AssignedType
+		id   int
+	}{
+		{"User", `{"id": 2, "username": "jdoe", "name": "Jane Doe", "type": "user"}`, AssignedTypeUser, 2},
+		{"Location", `{"id": 3, "name": "HQ", "type": "location"}`, AssignedTypeLocation, 3},
+		{"Asset", `{"id": 4, "name": "Dock", "type": "asset"}`, AssignedTypeAsset, 4},
+		{"Model class", `{"id": 3, "name": "HQ", "type": "App\\Models\\Location"}`, AssignedTypeLocation, 3},
+		{"Untyped user", `{"id": 2, "name": "Jane Doe"}`, AssignedTypeUser, 2},
+		{"Untyped asset", `{"id": 4, "name": "Dock", "asset_tag": "AT-4"}`, AssignedTypeAsset, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var assigned AssignedTo
+			if err := json.Unmarshal([]byte(tt.data), &assigned); err != nil {
+				t.Fatalf("json.Unmarshal returned error: %v", err)
+			}
+
+			if assigned.Kind() != tt.kind || assigned.ID() != tt.id {
+				t.Errorf("AssignedTo = %s %d, expected %s %d", assigned.Kind(), assigned.ID(), tt.kind, tt.id)
+			}
+			if (assigned.User() != nil) != (tt.kind == AssignedTypeUser) ||
+				(assigned.Location() != nil) != (tt.kind == AssignedTypeLocation) ||
+				(assigned.Asset() != nil) != (tt.kind == AssignedTypeAsset) {
+				t.Errorf("AssignedTo of kind %s has User = %v, Location = %v, Asset = %v",
+					tt.kind, assigned.User(), assigned.Location(), assigned.Asset())
+			}
+		})
+	}
+
+	var assigned AssignedTo
+	if err := json.Unmarshal([]byte(`{"id": 1, "type": "component"}`), &assigned); err == nil {
+		t.Error("json.Unmarshal of an unknown type returned nil error")
+	}
+}
+
+func TestAssignedToMarshalJSON(t *testing.T) {
+	location := &Location{}
+	location.ID = 3
+	location.Name = "HQ"
+
+	data, err := json.Marshal(AssignedToLocation(location))
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	var assigned AssignedTo
+	if err := json.Unmarshal(data, &assigned); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if assigned.Location() == nil || assigned.Name() != "HQ" {
+		t.Errorf("AssignedTo round-tripped through %s to %s %q, expected location %q", data, assigned.Kind(), assigned.Name(), "HQ")
+	}
+}
+
+func TestAssetsGetAssignedToLocation(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "assigned_to": {"id": 3, "name": "HQ", "type": "location"}, "assigned_type": "App\\Models\\Location"}`)
+	})
+
+	asset, _, err := client.Assets.Get(1)
+	if err != nil {
+		t.Fatalf("Assets.Get returned error: %v", err)
+	}
+
+	if asset.AssignedTo == nil || asset.AssignedTo.Location() == nil || asset.AssignedTo.ID() != 3 {
+		t.Errorf("Assets.Get returned AssignedTo = %+v, expected location 3", asset.AssignedTo)
+	}
+	if asset.AssignedTo.User() != nil {
+		t.Errorf("Assets.Get returned AssignedTo.User() = %+v, expected nil", asset.AssignedTo.User())
+	}
+}
@@ -0,0 +1,241 @@
+// Package audits plans the physical audits of a Snipe-IT inventory: which
+// assets are due or overdue for audit, grouped by the site where they are
+// to be found, with a printable worksheet for each site.
+//
+// Plan lists the assets and computes when each is due for audit; Record
+// then records the audits of the assets found, setting the date of their
+// next audit:
+//
+//	schedule, err := audits.Plan(ctx, client, audits.PlanOptions{
+//	    Cadence:    12,
+//	    Within:     30 * 24 * time.Hour,
+//	    ByLocation: true,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, site := range schedule.Sites {
+//	    site.WriteTo(printer)
+//	}
+//
+//	// Later, once the assets of a site have been found
+//	err = schedule.Record(ctx, client, found...)
+package audits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// DefaultCadence is the number of months between audits when
+// PlanOptions.Cadence is 0.
+const DefaultCadence = 12
+
+// PlanOptions configures Plan.
+type PlanOptions struct {
+	// Cadence is the number of months between audits of an asset. Assets
+	// without a next audit date are due Cadence months after their last
+	// audit, and Record sets the next audit date Cadence months after the
+	// date of the schedule. Zero means DefaultCadence.
+	Cadence int
+
+	// Within includes the assets due within this duration from today, in
+	// addition to the overdue ones. Zero includes only the assets due today
+	// or earlier.
+	Within time.Duration
+
+	// ByLocation groups the assets by location, one site per location.
+	// Otherwise the schedule has a single site holding every asset.
+	ByLocation bool
+}
+
+// Status is the audit status of an asset.
+type Status int
+
+const (
+	// Due is the status of an asset due for audit today or later
+	Due Status = iota
+
+	// Overdue is the status of an asset whose audit was due before today
+	Overdue
+)
+
+// String returns "due" or "overdue".
+func (s Status) String() string {
+	if s == Overdue {
+		return "overdue"
+	}
+	return "due"
+}
+
+// Item is an asset to audit.
+type Item struct {
+	// Asset is the asset, as listed by the API
+	Asset snipeit.Asset
+
+	// LastAudited is the date the asset was last audited, or the zero Date
+	// if it never was
+	LastAudited snipeit.Date
+
+	// DueDate is the date the asset is due for audit
+	DueDate snipeit.Date
+
+	// Status tells whether the audit is due or overdue
+	Status Status
+}
+
+// Site is the assets to audit at a location.
+type Site struct {
+	// Name is the name of the location, "No location" for assets without a
+	// location, or "All locations" when the schedule is not grouped by
+	// location
+	Name string
+
+	// Location is the location, or nil when Name is not that of a location
+	Location *snipeit.Location
+
+	// Date is the date of the schedule
+	Date snipeit.Date
+
+	// Items are the assets to audit, sorted by due date, earliest first,
+	// then by asset tag
+	Items []Item
+}
+
+// Overdue returns the number of assets of the site whose audit is overdue.
+func (s Site) Overdue() int {
+	n := 0
+	for _, item := range s.Items {
+		if item.Status == Overdue {
+			n++
+		}
+	}
+	return n
+}
+
+// Schedule is the audits due at the time it was planned.
+type Schedule struct {
+	// Date is the date the schedule was planned
+	Date snipeit.Date
+
+	// Cadence is the number of months between audits
+	Cadence int
+
+	// Sites are the sites with assets to audit, sorted by name, with the
+	// assets without a location last
+	Sites []Site
+}
+
+// Plan returns the schedule of the audits due today, overdue or, with
+// opts.Within, coming up.
+//
+// An asset is due for audit at its next audit date; assets without one are
+// due opts.Cadence months after their last audit, and assets never audited
+// are due today. Snipe-IT's own list of assets due for audit depends on the
+// server's settings, so every asset is listed, page by page, subject to the
+// client's rate limiter. Dates are compared in the local time zone.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-list
+func Plan(ctx context.Context, client *snipeit.Client, opts PlanOptions) (*Schedule, error) {
+	cadence := opts.Cadence
+	if cadence == 0 {
+		cadence = DefaultCadence
+	}
+	now := time.Now()
+	today, end := snipeit.DateOf(now), snipeit.DateOf(now.Add(opts.Within))
+
+	sites := make(map[int]*Site)
+	var order []*Site
+	for asset, err := range client.Assets.IterateContext(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("audits: listing assets: %w", err)
+		}
+
+		item := Item{Asset: asset, DueDate: today}
+		if asset.LastAuditDate != nil && !asset.LastAuditDate.IsZero() {
+			item.LastAudited = snipeit.DateOf(asset.LastAuditDate.Time)
+			item.DueDate = item.LastAudited.AddMonths(cadence)
+		}
+		if asset.NextAuditDate != nil && !asset.NextAuditDate.IsZero() {
+			item.DueDate = snipeit.DateOf(asset.NextAuditDate.Time)
+		}
+		if item.DueDate.After(end) {
+			continue
+		}
+		if item.DueDate.Before(today) {
+			item.Status = Overdue
+		}
+
+		// Sites are keyed by location ID, with 0 for no location or for the
+		// single site
+		key, name := 0, "All locations"
+		var location *snipeit.Location
+		if opts.ByLocation {
+			name = "No location"
+			if asset.Location != nil && asset.Location.ID != 0 {
+				key, name, location = asset.Location.ID, asset.Location.Name, asset.Location
+			}
+		}
+		site := sites[key]
+		if site == nil {
+			site = &Site{Name: name, Location: location, Date: today}
+			sites[key] = site
+			order = append(order, site)
+		}
+		site.Items = append(site.Items, item)
+	}
+
+	schedule := &Schedule{Date: today, Cadence: cadence}
+	for _, site := range order {
+		sort.SliceStable(site.Items, func(i, j int) bool {
+			a, b := site.Items[i], site.Items[j]
+			if a.DueDate != b.DueDate {
+				return a.DueDate.Before(b.DueDate)
+			}
+			return a.Asset.AssetTag < b.Asset.AssetTag
+		})
+		schedule.Sites = append(schedule.Sites, *site)
+	}
+	sort.SliceStable(schedule.Sites, func(i, j int) bool {
+		a, b := schedule.Sites[i], schedule.Sites[j]
+		if (a.Location == nil) != (b.Location == nil) {
+			return b.Location == nil
+		}
+		return a.Name < b.Name
+	})
+	return schedule, nil
+}
+
+// NextAuditDate returns the date Record sets as the next audit date: the
+// date of the schedule plus its cadence.
+func (s *Schedule) NextAuditDate() snipeit.Date {
+	return s.Date.AddMonths(s.Cadence)
+}
+
+// Record records the audit of each item, typically the assets of a site
+// that were found, through the audit endpoint, setting their next audit
+// date to NextAuditDate. The location of the assets is left unchanged.
+//
+// The audits are recorded one at a time; an audit that fails does not stop
+// the others, and the errors are joined. Record stops early if ctx is done.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-audit
+func (s *Schedule) Record(ctx context.Context, client *snipeit.Client, items ...Item) error {
+	next := s.NextAuditDate().In(time.UTC)
+
+	var errs []error
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		if _, _, err := client.Assets.AuditContext(ctx, item.Asset.AssetTag, 0, next); err != nil {
+			errs = append(errs, fmt.Errorf("audits: asset %s: %w", item.Asset.AssetTag, err))
+		}
+	}
+	return errors.Join(errs...)
+}
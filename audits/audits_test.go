@@ -0,0 +1,202 @@
+package audits
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+func setup(t *testing.T) (*snipeit.Client, *http.ServeMux) {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := snipeit.NewClientWithOptions(server.URL, "test-token", &snipeit.ClientOptions{DisableRetries: true})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	return client, mux
+}
+
+// serveAssets serves assets, given as JSON objects, as a paginated list.
+func serveAssets(mux *http.ServeMux, rows ...string) {
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		var page []string
+		for i := offset; i < len(rows) && len(page) < limit; i++ {
+			page = append(page, rows[i])
+		}
+		fmt.Fprintf(w, `{"total": %d, "rows": [%s]}`, len(rows), strings.Join(page, ","))
+	})
+}
+
+// serveInventory serves assets at two locations and one without a
+// location, relative to today.
+func serveInventory(mux *http.ServeMux, today snipeit.Date) {
+	day := func(days int) string { return today.AddDays(days).String() }
+	serveAssets(mux,
+		// Next audit date in the past
+		fmt.Sprintf(`{"id": 1, "asset_tag": "A-1", "name": "Laptop", "location": {"id": 2, "name": "Warehouse"}, "next_audit_date": {"date": %q}}`, day(-3)),
+		// Next audit date coming up
+		fmt.Sprintf(`{"id": 2, "asset_tag": "A-2", "location": {"id": 1, "name": "Headquarters"}, "next_audit_date": {"date": %q}}`, day(10)),
+		// Next audit date too far away
+		fmt.Sprintf(`{"id": 3, "asset_tag": "A-3", "location": {"id": 1, "name": "Headquarters"}, "next_audit_date": {"date": %q}}`, day(60)),
+		// Last audited a cadence ago, due today
+		fmt.Sprintf(`{"id": 4, "asset_tag": "A-4", "location": {"id": 1, "name": "Headquarters"}, "last_audit_date": {"datetime": "%s 10:00:00"}}`, today.AddMonths(-6)),
+		// Never audited
+		`{"id": 5, "asset_tag": "A-5", "model": {"id": 1, "name": "Dock"}}`,
+		// Last audited recently
+		fmt.Sprintf(`{"id": 6, "asset_tag": "A-6", "location": {"id": 2, "name": "Warehouse"}, "last_audit_date": {"datetime": "%s 10:00:00"}}`, day(-30)),
+	)
+}
+
+func TestPlan(t *testing.T) {
+	client, mux := setup(t)
+	today := snipeit.DateOf(time.Now())
+	serveInventory(mux, today)
+
+	schedule, err := Plan(context.Background(), client, PlanOptions{Cadence: 6, Within: 30 * 24 * time.Hour, ByLocation: true})
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+
+	var got []string
+	for _, site := range schedule.Sites {
+		for _, item := range site.Items {
+			got = append(got, fmt.Sprintf("%s: %s %s %d", site.Name, item.Asset.AssetTag, item.Status, today.DaysUntil(item.DueDate)))
+		}
+	}
+	want := []string{
+		"Headquarters: A-4 due 0",
+		"Headquarters: A-2 due 10",
+		"Warehouse: A-1 overdue -3",
+		"No location: A-5 due 0",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Plan returned\n%q\nexpected\n%q", got, want)
+	}
+
+	if schedule.Date != today || schedule.NextAuditDate() != today.AddMonths(6) {
+		t.Errorf("Plan returned date %v, next audit date %v, expected %v and %v", schedule.Date, schedule.NextAuditDate(), today, today.AddMonths(6))
+	}
+	if len(schedule.Sites) == 3 {
+		if location := schedule.Sites[1].Location; location == nil || location.ID != 2 || schedule.Sites[2].Location != nil {
+			t.Errorf("Plan returned locations %+v and %+v, expected Warehouse and none", location, schedule.Sites[2].Location)
+		}
+		if item := schedule.Sites[0].Items[0]; item.LastAudited != today.AddMonths(-6) {
+			t.Errorf("A-4 was last audited %v, expected %v", item.LastAudited, today.AddMonths(-6))
+		}
+	}
+}
+
+func TestPlanSingleSite(t *testing.T) {
+	client, mux := setup(t)
+	today := snipeit.DateOf(time.Now())
+	serveInventory(mux, today)
+
+	schedule, err := Plan(context.Background(), client, PlanOptions{})
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+
+	if len(schedule.Sites) != 1 {
+		t.Fatalf("Plan returned %d sites, expected 1", len(schedule.Sites))
+	}
+	site := schedule.Sites[0]
+	var tags []string
+	for _, item := range site.Items {
+		tags = append(tags, item.Asset.AssetTag)
+	}
+	// A-4 is not due for another 6 months with the default cadence
+	if site.Name != "All locations" || site.Location != nil || fmt.Sprint(tags) != "[A-1 A-5]" {
+		t.Errorf("Plan returned %s with %v, expected All locations with [A-1 A-5]", site.Name, tags)
+	}
+	if schedule.Cadence != DefaultCadence || site.Overdue() != 1 {
+		t.Errorf("Plan returned cadence %d, %d overdue, expected %d and 1", schedule.Cadence, site.Overdue(), DefaultCadence)
+	}
+}
+
+func TestScheduleRecord(t *testing.T) {
+	client, mux := setup(t)
+
+	var mu sync.Mutex
+	var audited []string
+	mux.HandleFunc("/api/v1/hardware/audit", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := body["location_id"]; ok {
+			t.Errorf("Request body location_id = %v, expected it to be omitted", body["location_id"])
+		}
+		if body["asset_tag"] == "A-2" {
+			fmt.Fprint(w, `{"status": "error", "messages": "Asset not found"}`)
+			return
+		}
+		mu.Lock()
+		audited = append(audited, fmt.Sprintf("%v %v", body["asset_tag"], body["next_audit_date"]))
+		mu.Unlock()
+		fmt.Fprint(w, `{"status": "success", "payload": {}}`)
+	})
+
+	schedule := &Schedule{Date: snipeit.NewDate(2025, 1, 31), Cadence: 1}
+	items := []Item{
+		{Asset: snipeit.Asset{AssetTag: "A-1"}},
+		{Asset: snipeit.Asset{AssetTag: "A-2"}},
+		{Asset: snipeit.Asset{AssetTag: "A-3"}},
+	}
+	err := schedule.Record(context.Background(), client, items...)
+	if err == nil || !strings.Contains(err.Error(), "asset A-2") {
+		t.Errorf("Record returned error %v, expected one for asset A-2", err)
+	}
+	if fmt.Sprint(audited) != "[A-1 2025-03-03 A-3 2025-03-03]" {
+		t.Errorf("Record audited %v, expected A-1 and A-3 next on 2025-03-03", audited)
+	}
+}
+
+func TestScheduleWriteTo(t *testing.T) {
+	date := snipeit.NewDate(2025, 1, 15)
+	schedule := &Schedule{Date: date, Cadence: 12, Sites: []Site{
+		{Name: "Headquarters", Date: date, Items: []Item{
+			{Asset: snipeit.Asset{AssetTag: "A-1", CommonFields: snipeit.CommonFields{Name: "Laptop"}, Serial: "SN1"}, LastAudited: snipeit.NewDate(2024, 1, 10), DueDate: snipeit.NewDate(2025, 1, 10), Status: Overdue},
+			{Asset: snipeit.Asset{AssetTag: "A-22", Model: snipeit.Model{CommonFields: snipeit.CommonFields{Name: "Dock"}}}, DueDate: date},
+		}},
+		{Name: "No location", Date: date, Items: []Item{
+			{Asset: snipeit.Asset{AssetTag: "A-3"}, DueDate: date},
+		}},
+	}}
+
+	var b strings.Builder
+	n, err := schedule.WriteTo(&b)
+	if err != nil {
+		t.Fatalf("WriteTo returned error: %v", err)
+	}
+	want := "Audit worksheet: Headquarters\n" +
+		"Date: 2025-01-15    Assets: 2 (1 overdue)\n" +
+		"\n" +
+		"FOUND  TAG   NAME    MODEL  SERIAL  ASSIGNED TO  LAST AUDITED  DUE         STATUS\n" +
+		"[ ]    A-1   Laptop  -      SN1     -            2024-01-10    2025-01-10  overdue\n" +
+		"[ ]    A-22  -       Dock   -       -            never         2025-01-15  due\n" +
+		"\f" +
+		"Audit worksheet: No location\n" +
+		"Date: 2025-01-15    Assets: 1 (0 overdue)\n" +
+		"\n" +
+		"FOUND  TAG  NAME  MODEL  SERIAL  ASSIGNED TO  LAST AUDITED  DUE         STATUS\n" +
+		"[ ]    A-3  -     -      -       -            never         2025-01-15  due\n"
+	if b.String() != want {
+		t.Errorf("WriteTo wrote\n%s\nexpected\n%s", b.String(), want)
+	}
+	if n != int64(len(want)) {
+		t.Errorf("WriteTo returned %d bytes, expected %d", n, len(want))
+	}
+}
@@ -0,0 +1,106 @@
+package audits
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// worksheetColumns are the headers of the columns of a worksheet.
+var worksheetColumns = []string{"FOUND", "TAG", "NAME", "MODEL", "SERIAL", "ASSIGNED TO", "LAST AUDITED", "DUE", "STATUS"}
+
+// WriteTo writes the audit worksheet of the site to w as plain text, ready
+// to be printed and filled in while walking the site: a header with the
+// site and date, then a table of the assets with a box to tick for each
+// asset found.
+func (s Site) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	fmt.Fprintf(cw, "Audit worksheet: %s\n", s.Name)
+	fmt.Fprintf(cw, "Date: %s    Assets: %d (%d overdue)\n\n", s.Date, len(s.Items), s.Overdue())
+
+	tw := tabwriter.NewWriter(cw, 0, 0, 2, ' ', 0)
+	writeRow(tw, worksheetColumns)
+	for _, item := range s.Items {
+		writeRow(tw, worksheetRow(item))
+	}
+	if err := tw.Flush(); err != nil {
+		return cw.n, err
+	}
+	return cw.n, cw.err
+}
+
+// WriteTo writes the worksheet of each site of the schedule to w, one per
+// page: the worksheets are separated by form feeds.
+func (s *Schedule) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+	for i, site := range s.Sites {
+		if i > 0 {
+			n, err := io.WriteString(w, "\f")
+			written += int64(n)
+			if err != nil {
+				return written, err
+			}
+		}
+		n, err := site.WriteTo(w)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// worksheetRow returns the cells of item in a worksheet.
+func worksheetRow(item Item) []string {
+	asset := item.Asset
+	assignedTo := ""
+	if asset.AssignedTo != nil {
+		assignedTo = fmt.Sprintf("%s %s", asset.AssignedTo.Kind(), asset.AssignedTo.Name())
+	}
+	lastAudited := "never"
+	if !item.LastAudited.IsZero() {
+		lastAudited = item.LastAudited.String()
+	}
+	return []string{
+		"[ ]",
+		asset.AssetTag,
+		asset.Name,
+		asset.Model.Name,
+		asset.Serial,
+		assignedTo,
+		lastAudited,
+		item.DueDate.String(),
+		item.Status.String(),
+	}
+}
+
+// writeRow writes cells to tw as a row, showing empty cells as "-".
+func writeRow(tw *tabwriter.Writer, cells []string) {
+	for i, cell := range cells {
+		if cell == "" {
+			cell = "-"
+		}
+		if i > 0 {
+			fmt.Fprint(tw, "\t")
+		}
+		fmt.Fprint(tw, cell)
+	}
+	fmt.Fprintln(tw)
+}
+
+// countingWriter counts the bytes written to w and keeps the first error.
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
@@ -0,0 +1,48 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Bool is a boolean that decodes from the forms Snipe-IT uses for flags.
+// Depending on the server version and the endpoint, flags such as
+// activated, eula and byod are sent as JSON booleans, as 0 and 1, or as
+// strings such as "true", "1" or "yes". A null or empty value decodes to
+// false. Bool is always encoded as a JSON boolean.
+type Bool bool
+
+// UnmarshalJSON implements json.Unmarshaler for Bool.
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	value := strings.TrimSpace(string(data))
+	if value == "null" {
+		*b = false
+		return nil
+	}
+
+	if s, err := strconv.Unquote(value); err == nil {
+		value = strings.ToLower(strings.TrimSpace(s))
+	}
+
+	switch value {
+	case "true", "1", "yes", "on", "y":
+		*b = true
+	case "false", "0", "no", "off", "n", "":
+		*b = false
+	default:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			*b = f != 0
+			return nil
+		}
+		return fmt.Errorf("snipeit: cannot decode %s as a boolean", data)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for Bool.
+func (b Bool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(bool(b))
+}
@@ -0,0 +1,71 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBoolUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected Bool
+	}{
+		{`true`, true},
+		{`false`, false},
+		{`1`, true},
+		{`0`, false},
+		{`"1"`, true},
+		{`"0"`, false},
+		{`"true"`, true},
+		{`"False"`, false},
+		{`"yes"`, true},
+		{`"no"`, false},
+		{`""`, false},
+		{`null`, false},
+	}
+
+	for _, tt := range tests {
+		b := !tt.expected
+		if err := json.Unmarshal([]byte(tt.data), &b); err != nil {
+			t.Errorf("json.Unmarshal(%s) returned error: %v", tt.data, err)
+			continue
+		}
+		if b != tt.expected {
+			t.Errorf("json.Unmarshal(%s) = %v, expected %v", tt.data, b, tt.expected)
+		}
+	}
+
+	var b Bool
+	if err := json.Unmarshal([]byte(`"maybe"`), &b); err == nil {
+		t.Error("json.Unmarshal of \"maybe\" returned nil error")
+	}
+}
+
+func TestBoolModelFields(t *testing.T) {
+	var user User
+	if err := json.Unmarshal([]byte(`{"id": 1, "activated": "1", "vip": 0, "remote": true}`), &user); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if !user.Activated || user.VIP || !user.Remote {
+		t.Errorf("User Activated = %v, VIP = %v, Remote = %v, expected true, false, true", user.Activated, user.VIP, user.Remote)
+	}
+
+	var category Category
+	if err := json.Unmarshal([]byte(`{"id": 2, "eula": 1, "require_acceptance": "false"}`), &category); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if !category.EULA || category.RequireMAAC {
+		t.Errorf("Category EULA = %v, RequireMAAC = %v, expected true, false", category.EULA, category.RequireMAAC)
+	}
+
+	// Flags are encoded as JSON booleans
+	data, err := json.Marshal(user)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	var body map[string]interface{}
+	json.Unmarshal(data, &body)
+	if body["activated"] != true {
+		t.Errorf("json.Marshal activated = %v, expected true", body["activated"])
+	}
+}
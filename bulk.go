@@ -0,0 +1,155 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// bulkConcurrency is the maximum number of requests a bulk operation
+// has in flight at once. Requests are additionally subject to the
+// client's rate limiter.
+const bulkConcurrency = 4
+
+// BulkResult is the outcome of one item of a bulk operation.
+type BulkResult struct {
+	// ID is the unique identifier of the item the result is for
+	ID int
+
+	// Asset is the asset returned by the API, if the operation returns one
+	Asset *AssetResponse
+
+	// Err is the error for this item, or nil if it succeeded
+	Err error
+}
+
+// BulkCheckout checks out several assets with the same options.
+//
+// ids are the unique identifiers of the assets to check out.
+// opts specifies the checkout target and optional dates, name and note,
+// and is applied to every asset.
+//
+// The returned results are in the same order as ids, one per asset. The
+// returned error joins the errors of all failed checkouts, or is nil if
+// every checkout succeeded.
+func (s *AssetsService) BulkCheckout(ids []int, opts CheckoutOptions) ([]BulkResult, error) {
+	return s.BulkCheckoutContext(context.Background(), ids, opts)
+}
+
+// BulkCheckoutContext checks out several assets with the same options
+// and the provided context.
+//
+// ctx is the context for the requests. Checkouts not yet started when ctx
+// is canceled fail with the context's error.
+// ids are the unique identifiers of the assets to check out.
+// opts specifies the checkout target and optional dates, name and note,
+// and is applied to every asset.
+//
+// The returned results are in the same order as ids, one per asset. The
+// returned error joins the errors of all failed checkouts, or is nil if
+// every checkout succeeded.
+func (s *AssetsService) BulkCheckoutContext(ctx context.Context, ids []int, opts CheckoutOptions) ([]BulkResult, error) {
+	return runBulk(ctx, ids, func(ctx context.Context, id int) (*AssetResponse, error) {
+		asset, _, err := s.CheckoutWithOptionsContext(ctx, id, opts)
+		return asset, err
+	})
+}
+
+// BulkDelete deletes several assets.
+//
+// ids are the unique identifiers of the assets to delete.
+// Snipe-IT's API has no bulk delete endpoint, so each asset is deleted
+// with its own request.
+//
+// The returned results are in the same order as ids, one per asset. The
+// returned error joins the errors of all failed deletes, or is nil if
+// every delete succeeded.
+func (s *AssetsService) BulkDelete(ids []int) ([]BulkResult, error) {
+	return s.BulkDeleteContext(context.Background(), ids)
+}
+
+// BulkDeleteContext deletes several assets with the provided context.
+//
+// ctx is the context for the requests. Deletes not yet started when ctx
+// is canceled fail with the context's error.
+// ids are the unique identifiers of the assets to delete.
+//
+// The returned results are in the same order as ids, one per asset. The
+// returned error joins the errors of all failed deletes, or is nil if
+// every delete succeeded.
+func (s *AssetsService) BulkDeleteContext(ctx context.Context, ids []int) ([]BulkResult, error) {
+	return runBulk(ctx, ids, func(ctx context.Context, id int) (*AssetResponse, error) {
+		_, err := s.DeleteContext(ctx, id)
+		return nil, err
+	})
+}
+
+// BulkUpdate applies the same partial update to several assets.
+//
+// ids are the unique identifiers of the assets to update.
+// fields maps API field names to their new values, as for Patch.
+// Snipe-IT's API has no bulk update endpoint, so each asset is patched
+// with its own request.
+//
+// The returned results are in the same order as ids, one per asset. The
+// returned error joins the errors of all failed updates, or is nil if
+// every update succeeded.
+func (s *AssetsService) BulkUpdate(ids []int, fields map[string]interface{}) ([]BulkResult, error) {
+	return s.BulkUpdateContext(context.Background(), ids, fields)
+}
+
+// BulkUpdateContext applies the same partial update to several assets
+// with the provided context.
+//
+// ctx is the context for the requests. Updates not yet started when ctx
+// is canceled fail with the context's error.
+// ids are the unique identifiers of the assets to update.
+// fields maps API field names to their new values, as for Patch.
+//
+// The returned results are in the same order as ids, one per asset. The
+// returned error joins the errors of all failed updates, or is nil if
+// every update succeeded.
+func (s *AssetsService) BulkUpdateContext(ctx context.Context, ids []int, fields map[string]interface{}) ([]BulkResult, error) {
+	return runBulk(ctx, ids, func(ctx context.Context, id int) (*AssetResponse, error) {
+		asset, _, err := s.PatchContext(ctx, id, fields)
+		return asset, err
+	})
+}
+
+// runBulk calls fn for each id with at most bulkConcurrency calls in flight,
+// and collects the results in the order of ids.
+func runBulk(ctx context.Context, ids []int, fn func(ctx context.Context, id int) (*AssetResponse, error)) ([]BulkResult, error) {
+	results := make([]BulkResult, len(ids))
+	sem := make(chan struct{}, bulkConcurrency)
+	var wg sync.WaitGroup
+
+	for i, id := range ids {
+		results[i].ID = id
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i, id int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i].Asset, results[i].Err = fn(ctx, id)
+		}(i, id)
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("asset %d: %w", result.ID, result.Err))
+		}
+	}
+
+	return results, errors.Join(errs...)
+}
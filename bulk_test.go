@@ -0,0 +1,168 @@
+package snipeit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestAssetsBulkCheckout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.disableRetries = true
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	block := make(chan struct{})
+
+	mux.HandleFunc("/api/v1/hardware/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		if inFlight == bulkConcurrency {
+			close(block)
+		}
+		mu.Unlock()
+
+		<-block
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		var id int
+		fmt.Sscanf(strings.TrimPrefix(r.URL.Path, "/api/v1/hardware/"), "%d/checkout", &id)
+		if id == 3 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"status": "error", "message": "Asset is not available for checkout"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"status": "success", "payload": {"id": %d}}`, id)
+	})
+
+	ids := []int{1, 2, 3, 4, 5, 6}
+	results, err := client.Assets.BulkCheckout(ids, CheckoutOptions{CheckoutToUser: 12})
+	if err == nil {
+		t.Fatal("Assets.BulkCheckout returned nil error, expected the failure for asset 3")
+	}
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Errorf("Assets.BulkCheckout returned error %v, expected it to wrap an *ErrorResponse", err)
+	}
+
+	if len(results) != len(ids) {
+		t.Fatalf("Assets.BulkCheckout returned %d results, expected %d", len(results), len(ids))
+	}
+
+	for i, result := range results {
+		if result.ID != ids[i] {
+			t.Errorf("results[%d].ID = %d, expected %d", i, result.ID, ids[i])
+		}
+		if (result.Err != nil) != (result.ID == 3) {
+			t.Errorf("results[%d].Err = %v, expected an error only for asset 3", i, result.Err)
+		}
+		if result.Err == nil && (result.Asset == nil || result.Asset.ID != result.ID) {
+			t.Errorf("results[%d].Asset = %+v, expected asset %d", i, result.Asset, result.ID)
+		}
+	}
+
+	if maxInFlight > bulkConcurrency {
+		t.Errorf("Assets.BulkCheckout had %d requests in flight, expected at most %d", maxInFlight, bulkConcurrency)
+	}
+}
+
+func TestAssetsBulkCheckoutCanceled(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := client.Assets.BulkCheckoutContext(ctx, []int{1, 2}, CheckoutOptions{CheckoutToUser: 12})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Assets.BulkCheckoutContext returned error %v, expected %v", err, context.Canceled)
+	}
+
+	for i, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("results[%d].Err = %v, expected %v", i, result.Err, context.Canceled)
+		}
+	}
+}
+
+func TestAssetsBulkDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.disableRetries = true
+
+	var mu sync.Mutex
+	deleted := map[string]bool{}
+	mux.HandleFunc("/api/v1/hardware/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/hardware/")
+		if id == "9" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"status": "error", "message": "Asset not found"}`)
+			return
+		}
+
+		mu.Lock()
+		deleted[id] = true
+		mu.Unlock()
+		fmt.Fprint(w, `{"status": "success"}`)
+	})
+
+	results, err := client.Assets.BulkDelete([]int{7, 8, 9})
+	if err == nil || !strings.Contains(err.Error(), "asset 9") {
+		t.Errorf("Assets.BulkDelete returned error %v, expected a failure for asset 9", err)
+	}
+
+	if !deleted["7"] || !deleted["8"] {
+		t.Errorf("Assets.BulkDelete deleted %v, expected assets 7 and 8", deleted)
+	}
+
+	if results[2].Err == nil || results[0].Err != nil || results[1].Err != nil {
+		t.Errorf("Assets.BulkDelete returned %+v, expected only the third result to fail", results)
+	}
+}
+
+func TestAssetsBulkUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if len(requestBody) != 1 || requestBody["location_id"] != float64(5) {
+			t.Errorf("Request body = %v, expected only location_id 5", requestBody)
+		}
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/hardware/")
+		fmt.Fprintf(w, `{"status": "success", "payload": {"id": %s, "location": {"id": 5}}}`, id)
+	})
+
+	results, err := client.Assets.BulkUpdate([]int{1, 2}, map[string]interface{}{"location_id": 5})
+	if err != nil {
+		t.Fatalf("Assets.BulkUpdate returned error: %v", err)
+	}
+
+	for i, result := range results {
+		if result.Asset == nil || result.Asset.ID != result.ID {
+			t.Errorf("results[%d].Asset = %+v, expected asset %d", i, result.Asset, result.ID)
+		}
+	}
+}
@@ -0,0 +1,276 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultCacheSize is the number of responses a MemoryCache holds when
+// NewMemoryCache is called with a non-positive size.
+const defaultCacheSize = 256
+
+// CachedResponse is a response body stored by a Cache, along with the
+// validators used to revalidate it with a conditional request.
+type CachedResponse struct {
+	// ETag is the value of the response's ETag header
+	ETag string
+
+	// LastModified is the value of the response's Last-Modified header
+	LastModified string
+
+	// Body is the raw response body
+	Body []byte
+
+	// StoredAt is when the response was received
+	StoredAt time.Time
+}
+
+// Cache stores the responses to GET requests.
+//
+// When a client has a Cache and a CacheTTL, GET requests that decode JSON
+// are answered from the cache, without being sent, while their response
+// is younger than CacheTTL. Older responses that carried an ETag or
+// Last-Modified header are revalidated by sending the request with
+// If-None-Match and If-Modified-Since headers; if the server answers
+// 304 Not Modified, the cached body is decoded instead. Without a
+// CacheTTL, only responses carrying one of those headers are stored.
+//
+// After a successful POST, PUT, PATCH or DELETE request, the client deletes
+// the responses that the write may have changed: those of the collection
+// of the resource written, such as every asset and asset list after an
+// asset is checked out, and the lists nested under other resources, such
+// as the assets of a user or of a location. Other responses, such as the
+// asset counts of the location list, stay cached until they expire.
+//
+// Keys include the request URL, so a Cache should not be shared between
+// clients using different API tokens.
+type Cache interface {
+	// Get returns the response stored under key, if any.
+	Get(key string) (*CachedResponse, bool)
+
+	// Set stores a response under key.
+	Set(key string, response *CachedResponse)
+
+	// Delete removes the response stored under key, if any.
+	Delete(key string)
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used
+// response once it is full. It is safe for concurrent use.
+type MemoryCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// memoryCacheEntry is the value stored in MemoryCache.order.
+type memoryCacheEntry struct {
+	key      string
+	response *CachedResponse
+}
+
+// NewMemoryCache returns a MemoryCache holding up to size responses.
+// If size is not positive, a default of 256 is used.
+func NewMemoryCache(size int) *MemoryCache {
+	if size <= 0 {
+		size = defaultCacheSize
+	}
+
+	return &MemoryCache{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Get returns the response stored under key, if any.
+func (c *MemoryCache) Get(key string) (*CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*memoryCacheEntry).response, true
+}
+
+// Set stores a response under key, evicting the least recently used
+// response if the cache is full.
+func (c *MemoryCache) Set(key string, response *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*memoryCacheEntry).response = response
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&memoryCacheEntry{key: key, response: response})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// Delete removes the response stored under key, if any.
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.order.Remove(elem)
+		delete(c.entries, key)
+	}
+}
+
+// cacheIndex holds the keys of the responses a client has cached by URL
+// path, so that those a write may have changed can be deleted whatever
+// their query string. It is safe for concurrent use.
+type cacheIndex struct {
+	mu   sync.Mutex
+	keys map[string]map[string]bool
+}
+
+// add records that the response to a request for u is cached under key.
+func (i *cacheIndex) add(u *url.URL, key string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	path := strings.TrimSuffix(u.Path, "/")
+	if i.keys == nil {
+		i.keys = make(map[string]map[string]bool)
+	}
+	if i.keys[path] == nil {
+		i.keys[path] = make(map[string]bool)
+	}
+	i.keys[path][key] = true
+}
+
+// evict deletes from cache the responses that a write to u may have
+// changed: those cached for the collection u belongs to, such as
+// /api/v1/hardware and /api/v1/hardware/1 for /api/v1/hardware/1/checkout,
+// and those of the lists nested under other resources, such as
+// /api/v1/users/5/assets.
+func (i *cacheIndex) evict(cache Cache, u *url.URL) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	written := apiSegments(u.Path)
+	for path, keys := range i.keys {
+		segments := apiSegments(path)
+		if len(written) > 0 && len(segments) > 0 && segments[0] != written[0] && len(segments) < 3 {
+			continue
+		}
+		for key := range keys {
+			cache.Delete(key)
+		}
+		delete(i.keys, path)
+	}
+}
+
+// apiSegments returns the segments of path below the API root, such as
+// ["hardware", "1", "checkout"] for /api/v1/hardware/1/checkout.
+func apiSegments(path string) []string {
+	if _, rest, ok := strings.Cut(path, "/api/v1/"); ok {
+		path = rest
+	}
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
+
+// cacheKey returns the key under which the response to req is cached.
+func cacheKey(req *http.Request) string {
+	return req.Method + " " + req.URL.String()
+}
+
+// cacheable reports whether the response to req, decoded into v, can be
+// cached. Responses copied to a writer or streamed are not kept.
+func (c *Client) cacheable(req *http.Request, v interface{}) bool {
+	_, isWriter := v.(io.Writer)
+	_, isStream := v.(streamDecoder)
+	return c.cache != nil && req.Method == http.MethodGet && !isWriter && !isStream
+}
+
+// freshResponse returns the cached response to req if it is younger than
+// the client's CacheTTL, or nil.
+func (c *Client) freshResponse(req *http.Request, v interface{}) *CachedResponse {
+	if c.cacheTTL <= 0 || !c.cacheable(req, v) {
+		return nil
+	}
+
+	cached, ok := c.cache.Get(cacheKey(req))
+	if !ok || time.Since(cached.StoredAt) >= c.cacheTTL {
+		return nil
+	}
+	return cached
+}
+
+// hasValidators reports whether r can be revalidated with a conditional
+// request.
+func (r *CachedResponse) hasValidators() bool {
+	return r.ETag != "" || r.LastModified != ""
+}
+
+// refreshed returns a copy of r stored now, for a response the server has
+// confirmed is unchanged.
+func (r *CachedResponse) refreshed() *CachedResponse {
+	refreshed := *r
+	refreshed.StoredAt = time.Now()
+	return &refreshed
+}
+
+// addValidators adds the conditional request headers for cached to req.
+func addValidators(req *http.Request, cached *CachedResponse) {
+	if cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+	if cached.LastModified != "" {
+		req.Header.Set("If-Modified-Since", cached.LastModified)
+	}
+}
+
+// newCachedResponse returns the cache entry for a successful response, or
+// nil if the client has no CacheTTL and the response carries no
+// validators.
+func (c *Client) newCachedResponse(resp *http.Response, body []byte) *CachedResponse {
+	cached := &CachedResponse{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Body:         body,
+		StoredAt:     time.Now(),
+	}
+	if c.cacheTTL <= 0 && !cached.hasValidators() {
+		return nil
+	}
+	return cached
+}
+
+// newCacheHit returns the response of a request answered from the cache.
+func newCacheHit(req *http.Request, cached *CachedResponse) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(cached.Body)),
+		ContentLength: int64(len(cached.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,251 @@
+package snipeit
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClientCache(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 1, "name": "Laptop"}]}`)
+	})
+
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{Cache: NewMemoryCache(0), CacheTTL: time.Minute})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		assets, resp, err := client.Assets.List(nil)
+		if err != nil {
+			t.Fatalf("Assets.List #%d returned error: %v", i+1, err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("Assets.List #%d returned status %d, expected %d", i+1, resp.StatusCode, http.StatusOK)
+		}
+
+		if assets.Total != 1 || len(assets.Rows) != 1 || assets.Rows[0].Name != "Laptop" {
+			t.Errorf("Assets.List #%d returned %+v, expected the cached laptop", i+1, assets)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("server received %d requests, expected %d", requests, 1)
+	}
+}
+
+func TestClientCacheExpires(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"total": 0, "rows": []}`)
+	})
+
+	cache := NewMemoryCache(0)
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{Cache: cache, CacheTTL: time.Minute})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if _, _, err := client.Assets.List(nil); err != nil {
+		t.Fatalf("Assets.List returned error: %v", err)
+	}
+
+	// Age the cached response past the TTL
+	for _, elem := range cache.entries {
+		elem.Value.(*memoryCacheEntry).response.StoredAt = time.Now().Add(-2 * time.Minute)
+	}
+
+	if _, _, err := client.Assets.List(nil); err != nil {
+		t.Fatalf("Assets.List returned error: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("server received %d requests, expected %d", requests, 2)
+	}
+}
+
+func TestClientCacheRevalidates(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 1, "name": "Laptop"}]}`)
+	})
+
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{Cache: NewMemoryCache(0)})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	for i, expectedStatus := range []int{http.StatusOK, http.StatusNotModified} {
+		assets, resp, err := client.Assets.List(nil)
+		if err != nil {
+			t.Fatalf("Assets.List #%d returned error: %v", i+1, err)
+		}
+
+		if resp.StatusCode != expectedStatus {
+			t.Errorf("Assets.List #%d returned status %d, expected %d", i+1, resp.StatusCode, expectedStatus)
+		}
+
+		if assets.Total != 1 || len(assets.Rows) != 1 || assets.Rows[0].Name != "Laptop" {
+			t.Errorf("Assets.List #%d returned %+v, expected the cached laptop", i+1, assets)
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("server received %d requests, expected %d", requests, 2)
+	}
+}
+
+func TestClientCacheSkipsResponsesWithoutValidators(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" || r.Header.Get("If-Modified-Since") != "" {
+			t.Errorf("request sent conditional headers %v", r.Header)
+		}
+		fmt.Fprint(w, `{"total": 0, "rows": []}`)
+	})
+
+	cache := NewMemoryCache(0)
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{Cache: cache})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Assets.List(nil); err != nil {
+			t.Fatalf("Assets.List returned error: %v", err)
+		}
+	}
+
+	if cache.order.Len() != 0 {
+		t.Errorf("cache holds %d responses, expected none", cache.order.Len())
+	}
+}
+
+func TestMemoryCacheEviction(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", &CachedResponse{Body: []byte("a")})
+	cache.Set("b", &CachedResponse{Body: []byte("b")})
+
+	// Using "a" makes "b" the least recently used response
+	cache.Get("a")
+	cache.Set("c", &CachedResponse{Body: []byte("c")})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("MemoryCache kept %q, expected it to be evicted", "b")
+	}
+	for _, key := range []string{"a", "c"} {
+		if response, ok := cache.Get(key); !ok || string(response.Body) != key {
+			t.Errorf("MemoryCache.Get(%q) = %v, %v, expected the stored response", key, response, ok)
+		}
+	}
+}
+
+func TestMemoryCacheDelete(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", &CachedResponse{Body: []byte("a")})
+	cache.Delete("a")
+	cache.Delete("missing")
+
+	if _, ok := cache.Get("a"); ok || cache.order.Len() != 0 {
+		t.Errorf("MemoryCache kept %q after Delete", "a")
+	}
+}
+
+func TestClientCacheInvalidatedByWrites(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	name := "Laptop"
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": 1, "name": %q}`, name)
+	})
+	mux.HandleFunc("/api/v1/hardware/1/checkout", func(w http.ResponseWriter, r *http.Request) {
+		name = "Checked out"
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 1}}`)
+	})
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"total": 1, "rows": [{"id": 1, "name": %q}]}`, name)
+	})
+	mux.HandleFunc("/api/v1/users/5/assets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"total": 1, "rows": [{"id": 1, "name": %q}]}`, name)
+	})
+	mux.HandleFunc("/api/v1/users/5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 5, "name": "Jane"}`)
+	})
+	mux.HandleFunc("/api/v1/locations", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 3, "name": "HQ"}]}`)
+	})
+
+	cache := NewMemoryCache(0)
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{Cache: cache, CacheTTL: time.Minute})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	get := func(path string) *AssetsResponse {
+		t.Helper()
+		req, err := client.newRequest("GET", path, nil)
+		if err != nil {
+			t.Fatalf("newRequest returned error: %v", err)
+		}
+		var assets AssetsResponse
+		if _, err := client.Do(req, &assets); err != nil {
+			t.Fatalf("Do(GET %s) returned error: %v", path, err)
+		}
+		return &assets
+	}
+
+	if _, _, err := client.Assets.Get(1); err != nil {
+		t.Fatalf("Assets.Get returned error: %v", err)
+	}
+	get("api/v1/hardware?limit=10")
+	get("api/v1/users/5/assets")
+	get("api/v1/users/5")
+	get("api/v1/locations")
+
+	if _, _, err := client.Assets.Checkout(1, map[string]interface{}{"assigned_user": 5}); err != nil {
+		t.Fatalf("Assets.Checkout returned error: %v", err)
+	}
+
+	asset, _, err := client.Assets.Get(1)
+	if err != nil {
+		t.Fatalf("Assets.Get returned error: %v", err)
+	}
+	if asset.Name != "Checked out" {
+		t.Errorf("Assets.Get after Checkout returned name %q, expected %q", asset.Name, "Checked out")
+	}
+	for _, path := range []string{"api/v1/hardware?limit=10", "api/v1/users/5/assets"} {
+		if assets := get(path); len(assets.Rows) != 1 || assets.Rows[0].Name != "Checked out" {
+			t.Errorf("GET %s after Checkout returned %+v, expected the checked out asset", path, assets.Rows)
+		}
+	}
+
+	// Other resources and top-level lists stay cached
+	for _, path := range []string{"api/v1/users/5", "api/v1/locations"} {
+		if _, ok := cache.Get("GET " + serverURL + "/" + path); !ok {
+			t.Errorf("cache dropped the response to GET %s, expected it to be kept", path)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// CategoriesService handles communication with the category-related endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/categories
+type CategoriesService struct {
+	client *Client
+}
+
+// CategoryResponse represents the API response for a single category.
+// The single category endpoint returns the category data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Category.
+type CategoryResponse struct {
+	Response
+	// Payload contains the category as returned in the payload field, if any
+	Payload *Category `json:"payload,omitempty"`
+	Category
+}
+
+// UnmarshalJSON implements json.Unmarshaler for CategoryResponse.
+func (r *CategoryResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Category)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Category
+	}
+	return nil
+}
+
+// CategoriesResponse represents the API response for multiple categories.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Categories.
+type CategoriesResponse struct {
+	Response
+	// Rows contains the list of Category objects
+	Rows []Category `json:"rows"`
+}
+
+// CategoryListOptions specifies the options for listing categories.
+// It embeds ListOptions for pagination, search and sorting, and adds
+// a filter on the category type.
+type CategoryListOptions struct {
+	ListOptions
+
+	// CategoryType filters categories by the kind of item they hold
+	// ("asset", "accessory", "consumable", "component" or "license")
+	CategoryType CategoryType `url:"category_type,omitempty"`
+}
+
+// List returns a list of categories with pagination options.
+//
+// opts can be used to customize the response with pagination, search, sorting
+// and the category type filter. If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/categories
+func (s *CategoriesService) List(opts *CategoryListOptions) (*CategoriesResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of categories with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, sorting
+// and the category type filter. If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/categories
+func (s *CategoriesService) ListContext(ctx context.Context, opts *CategoryListOptions) (*CategoriesResponse, *http.Response, error) {
+	u := "api/v1/categories"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var categories CategoriesResponse
+	resp, err := s.client.Do(req, &categories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &categories, resp, nil
+}
+
+// Iterate returns an iterator over every category, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Category.
+func (s *CategoriesService) Iterate(opts *CategoryListOptions) iter.Seq2[Category, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every category with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Category.
+func (s *CategoriesService) IterateContext(ctx context.Context, opts *CategoryListOptions) iter.Seq2[Category, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *CategoryListOptions) ([]Category, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single category by its ID.
+//
+// id is the unique identifier of the category to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/categoriesid
+func (s *CategoriesService) Get(id int) (*CategoryResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single category by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the category to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/categoriesid
+func (s *CategoriesService) GetContext(ctx context.Context, id int) (*CategoryResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/categories/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var category CategoryResponse
+	resp, err := s.client.Do(req, &category)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &category, resp, nil
+}
+
+// Create creates a new category in Snipe-IT.
+//
+// category must contain the required fields:
+// - Name: The name of the category
+// - CategoryType: One of the CategoryType constants, such as CategoryTypeAsset
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/categories-1
+func (s *CategoriesService) Create(category Category) (*CategoryResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), category)
+}
+
+// CreateContext creates a new category in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// category must contain the required fields:
+// - Name: The name of the category
+// - CategoryType: One of the CategoryType constants, such as CategoryTypeAsset
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/categories-1
+func (s *CategoriesService) CreateContext(ctx context.Context, category Category) (*CategoryResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/categories", category)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response CategoryResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing category in Snipe-IT.
+//
+// id is the unique identifier of the category to update.
+// category contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/categoriesid-2
+func (s *CategoriesService) Update(id int, category Category) (*CategoryResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, category)
+}
+
+// UpdateContext updates an existing category in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the category to update.
+// category contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/categoriesid-2
+func (s *CategoriesService) UpdateContext(ctx context.Context, id int, category Category) (*CategoryResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/categories/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, category)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response CategoryResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a category from Snipe-IT.
+//
+// id is the unique identifier of the category to delete.
+// Snipe-IT refuses to delete categories that still have items assigned.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/categoriesid-1
+func (s *CategoriesService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a category from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the category to delete.
+// Snipe-IT refuses to delete categories that still have items assigned.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/categoriesid-1
+func (s *CategoriesService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/categories/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,126 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCategoriesList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/categories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if r.URL.Query().Get("category_type") != "license" {
+			t.Errorf("Request URL query parameter 'category_type' = %v, expected %v", r.URL.Query().Get("category_type"), "license")
+		}
+
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{"id": 7, "name": "Productivity", "category_type": "License", "eula": true}
+			]
+		}`)
+	})
+
+	categories, _, err := client.Categories.List(&CategoryListOptions{CategoryType: "license"})
+	if err != nil {
+		t.Fatalf("Categories.List returned error: %v", err)
+	}
+
+	if len(categories.Rows) != 1 {
+		t.Fatalf("Categories.List returned %d categories, expected %d", len(categories.Rows), 1)
+	}
+
+	if categories.Rows[0].CategoryType != "License" || !categories.Rows[0].EULA {
+		t.Errorf("Categories.List returned %+v, expected a License category with EULA", categories.Rows[0])
+	}
+}
+
+func TestCategoriesGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/categories/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 7, "name": "Productivity", "category_type": "License"}`)
+	})
+
+	category, _, err := client.Categories.Get(7)
+	if err != nil {
+		t.Fatalf("Categories.Get returned error: %v", err)
+	}
+
+	if category.ID != 7 || category.Name != "Productivity" {
+		t.Errorf("Categories.Get returned ID = %d, Name = %q, expected %d, %q", category.ID, category.Name, 7, "Productivity")
+	}
+}
+
+func TestCategoriesCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/categories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["category_type"] != "accessory" {
+			t.Errorf("Request body category_type = %v, expected %v", requestBody["category_type"], "accessory")
+		}
+		if _, ok := requestBody["type"]; ok {
+			t.Errorf("Request body type = %v, expected it to be omitted", requestBody["type"])
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 8, "name": "Docks", "category_type": "accessory"}}`)
+	})
+
+	category, _, err := client.Categories.Create(Category{
+		CommonFields: CommonFields{Name: "Docks"},
+		CategoryType: "accessory",
+	})
+	if err != nil {
+		t.Fatalf("Categories.Create returned error: %v", err)
+	}
+
+	if category.Payload == nil || category.Payload.ID != 8 {
+		t.Errorf("Categories.Create returned Payload = %+v, expected ID %d", category.Payload, 8)
+	}
+}
+
+func TestCategoriesUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/categories/8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 8, "name": "Docking Stations"}}`)
+	})
+
+	category, _, err := client.Categories.Update(8, Category{CommonFields: CommonFields{Name: "Docking Stations"}})
+	if err != nil {
+		t.Fatalf("Categories.Update returned error: %v", err)
+	}
+
+	if category.Name != "Docking Stations" {
+		t.Errorf("Categories.Update returned Name = %q, expected %q", category.Name, "Docking Stations")
+	}
+}
+
+func TestCategoriesDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/categories/8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success"}`)
+	})
+
+	if _, err := client.Categories.Delete(8); err != nil {
+		t.Fatalf("Categories.Delete returned error: %v", err)
+	}
+}
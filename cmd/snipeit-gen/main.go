@@ -0,0 +1,80 @@
+// Command snipeit-gen generates typed Go structs for the custom fieldsets
+// of a Snipe-IT instance, and asset types with a typed accessor for each
+// custom field (e.g., asset.MACAddress()).
+//
+// The Snipe-IT URL and API token are read from the SNIPEIT_URL and
+// SNIPEIT_API_TOKEN environment variables.
+//
+// Usage:
+//
+//	snipeit-gen [-o customfields.go] [-package customfields] [-fieldset id]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/michellepellon/go-snipeit"
+	"github.com/michellepellon/go-snipeit/fieldgen"
+)
+
+func main() {
+	output := flag.String("o", "", "output file (default stdout)")
+	pkg := flag.String("package", "customfields", "package name of the generated file")
+	fieldsetID := flag.Int("fieldset", 0, "generate only the fieldset with this ID")
+	flag.Parse()
+
+	snipeURL := os.Getenv("SNIPEIT_URL")
+	apiToken := os.Getenv("SNIPEIT_API_TOKEN")
+
+	if snipeURL == "" || apiToken == "" {
+		log.Fatal("SNIPEIT_URL and SNIPEIT_API_TOKEN environment variables must be set")
+	}
+
+	client, err := snipeit.NewClient(snipeURL, apiToken)
+	if err != nil {
+		log.Fatalf("Error creating client: %v", err)
+	}
+
+	var fieldsets []snipeit.Fieldset
+	if *fieldsetID != 0 {
+		fieldset, _, err := client.Fieldsets.Get(*fieldsetID)
+		if err != nil {
+			log.Fatalf("Error getting fieldset %d: %v", *fieldsetID, err)
+		}
+		fieldsets = append(fieldsets, fieldset.Fieldset)
+	} else {
+		list, _, err := client.Fieldsets.List(nil)
+		if err != nil {
+			log.Fatalf("Error listing fieldsets: %v", err)
+		}
+		fieldsets = list.Rows
+	}
+
+	var buf bytes.Buffer
+	if err := fieldgen.Generate(&buf, fieldsets, &fieldgen.Options{Package: *pkg}); err != nil {
+		log.Fatalf("Error generating code: %v", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			log.Fatalf("Error creating output file: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		log.Fatalf("Error writing output: %v", err)
+	}
+
+	if *output != "" {
+		fmt.Fprintf(os.Stderr, "Generated %d fieldset(s) into %s\n", len(fieldsets), *output)
+	}
+}
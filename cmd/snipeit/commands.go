@@ -0,0 +1,254 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/michellepellon/go-snipeit"
+	"github.com/michellepellon/go-snipeit/export"
+)
+
+func runList(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("list")
+	search := fs.String("search", "", "list only the assets matching this term")
+	limit := fs.Int("limit", 50, "maximum number of assets to list")
+	all := fs.Bool("all", false, "list every asset, ignoring -limit")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	opts := &snipeit.ListOptions{Search: *search, Limit: *limit}
+
+	var assets []snipeit.Asset
+	if *all {
+		opts.Limit = 0
+		for asset, err := range c.client.Assets.IterateContext(ctx, opts) {
+			if err != nil {
+				return err
+			}
+			assets = append(assets, asset)
+		}
+	} else {
+		list, _, err := c.client.Assets.ListContext(ctx, opts)
+		if err != nil {
+			return err
+		}
+		assets = list.Rows
+		if list.Total > len(assets) {
+			defer fmt.Fprintf(c.stderr, "Showing %d of %d assets; use -all to list every asset.\n", len(assets), list.Total)
+		}
+	}
+
+	if *asJSON {
+		return printJSON(c.stdout, assets)
+	}
+	return printAssets(c.stdout, assets)
+}
+
+func runGet(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("get")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := c.parse(fs, args, 1); err != nil {
+		return err
+	}
+	id, err := parseID(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	asset, _, err := c.client.Assets.GetContext(ctx, id)
+	if err != nil {
+		return err
+	}
+	return c.printAsset(asset.Asset, *asJSON)
+}
+
+func runSearch(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("search")
+	tag := fs.String("tag", "", "find the asset with this asset tag")
+	serial := fs.String("serial", "", "find the assets with this serial number")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	switch {
+	case (*tag == "") == (*serial == ""):
+		fmt.Fprintln(c.stderr, "snipeit search: exactly one of -tag and -serial is required")
+		fs.Usage()
+		return errUsage
+	case *tag != "":
+		asset, _, err := c.client.Assets.GetAssetByTagContext(ctx, *tag)
+		if err != nil {
+			return err
+		}
+		return c.printAsset(asset.Asset, *asJSON)
+	default:
+		assets, _, err := c.client.Assets.GetAssetBySerialContext(ctx, *serial)
+		if err != nil {
+			return err
+		}
+		if *asJSON {
+			return printJSON(c.stdout, assets.Rows)
+		}
+		return printAssets(c.stdout, assets.Rows)
+	}
+}
+
+func runCreate(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("create")
+	var req snipeit.AssetCreateRequest
+	fs.IntVar(&req.ModelID, "model", 0, "ID of the asset model (required)")
+	fs.IntVar(&req.StatusID, "status", 0, "ID of the status label (required)")
+	fs.StringVar(&req.AssetTag, "tag", "", "asset tag (required)")
+	fs.StringVar(&req.Name, "name", "", "name of the asset")
+	fs.StringVar(&req.Serial, "serial", "", "serial number of the asset")
+	fs.IntVar(&req.LocationID, "location", 0, "ID of the location of the asset")
+	fs.StringVar(&req.Notes, "notes", "", "notes about the asset")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+	if req.ModelID == 0 || req.StatusID == 0 || req.AssetTag == "" {
+		fmt.Fprintln(c.stderr, "snipeit create: -model, -status and -tag are required")
+		fs.Usage()
+		return errUsage
+	}
+
+	asset, _, err := c.client.Assets.CreateContext(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.printAsset(asset.Asset, *asJSON)
+}
+
+func runCheckout(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("checkout")
+	var opts snipeit.CheckoutOptions
+	fs.IntVar(&opts.CheckoutToUser, "user", 0, "ID of the user to check the asset out to")
+	fs.IntVar(&opts.CheckoutToLocation, "location", 0, "ID of the location to check the asset out to")
+	fs.IntVar(&opts.CheckoutToAsset, "asset", 0, "ID of the asset to check the asset out to")
+	fs.StringVar(&opts.Note, "note", "", "note about the checkout")
+	expected := fs.String("expected", "", "expected checkin date, as YYYY-MM-DD")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := c.parse(fs, args, 1); err != nil {
+		return err
+	}
+	id, err := parseID(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	targets := 0
+	for _, target := range []int{opts.CheckoutToUser, opts.CheckoutToLocation, opts.CheckoutToAsset} {
+		if target != 0 {
+			targets++
+		}
+	}
+	if targets != 1 {
+		fmt.Fprintln(c.stderr, "snipeit checkout: exactly one of -user, -location and -asset is required")
+		fs.Usage()
+		return errUsage
+	}
+	if *expected != "" {
+		if opts.ExpectedCheckin, err = snipeit.ParseDate(*expected); err != nil {
+			return fmt.Errorf("invalid -expected date: %w", err)
+		}
+	}
+
+	asset, _, err := c.client.Assets.CheckoutWithOptionsContext(ctx, id, opts)
+	if err != nil {
+		return err
+	}
+	return c.printAsset(asset.Asset, *asJSON)
+}
+
+func runCheckin(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("checkin")
+	var opts snipeit.CheckinOptions
+	fs.StringVar(&opts.Note, "note", "", "note about the checkin")
+	fs.IntVar(&opts.LocationID, "location", 0, "ID of the location to assign the asset to")
+	fs.IntVar(&opts.StatusID, "status", 0, "ID of the status label to assign the asset")
+	asJSON := fs.Bool("json", false, "print JSON instead of a table")
+	if err := c.parse(fs, args, 1); err != nil {
+		return err
+	}
+	id, err := parseID(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	asset, _, err := c.client.Assets.CheckinWithOptionsContext(ctx, id, opts)
+	if err != nil {
+		return err
+	}
+	return c.printAsset(asset.Asset, *asJSON)
+}
+
+func runExport(ctx context.Context, c *cli, args []string) error {
+	fs := c.flagSet("export")
+	format := fs.String("format", "csv", "output format: csv or json")
+	fields := fs.String("fields", "", "comma-separated fields to export (default: standard and custom fields)")
+	search := fs.String("search", "", "export only the assets matching this term")
+	output := fs.String("o", "", "output file (default stdout)")
+	if err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	var opts export.ExportOptions
+	switch strings.ToLower(*format) {
+	case "csv":
+		opts.Format = export.CSV
+	case "json":
+		opts.Format = export.JSON
+	default:
+		fmt.Fprintf(c.stderr, "snipeit export: unknown format %q\n", *format)
+		fs.Usage()
+		return errUsage
+	}
+	if *fields != "" {
+		for _, field := range strings.Split(*fields, ",") {
+			opts.Fields = append(opts.Fields, strings.TrimSpace(field))
+		}
+	}
+	if *search != "" {
+		opts.List = &snipeit.ListOptions{Search: *search}
+	}
+
+	if *output == "" {
+		return export.Assets(ctx, c.client, c.stdout, opts)
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := export.Assets(ctx, c.client, f, opts); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// printAsset prints asset as JSON if asJSON is true, and as a table
+// otherwise.
+func (c *cli) printAsset(asset snipeit.Asset, asJSON bool) error {
+	if asJSON {
+		return printJSON(c.stdout, asset)
+	}
+	return printAssets(c.stdout, []snipeit.Asset{asset})
+}
+
+// parseID parses the ID argument of a command.
+func parseID(arg string) (int, error) {
+	id, err := strconv.Atoi(arg)
+	if err != nil || id <= 0 {
+		return 0, errors.New("invalid asset ID " + strconv.Quote(arg))
+	}
+	return id, nil
+}
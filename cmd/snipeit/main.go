@@ -0,0 +1,154 @@
+// Command snipeit manages the assets of a Snipe-IT instance from the
+// command line.
+//
+// The Snipe-IT URL and API token are read from the SNIPEIT_URL and
+// SNIPEIT_API_TOKEN environment variables; the other variables read by
+// snipeit.NewClientFromEnv, such as SNIPEIT_TIMEOUT, are honored too.
+//
+// Usage:
+//
+//	snipeit list [-search term] [-limit n] [-all] [-json]
+//	snipeit get [-json] id
+//	snipeit search [-json] (-tag tag | -serial serial)
+//	snipeit create -model id -status id -tag tag [-name name] [-serial serial] [-json]
+//	snipeit checkout (-user id | -location id | -asset id) [-note text] [-expected date] [-json] id
+//	snipeit checkin [-note text] [-location id] [-status id] [-json] id
+//	snipeit export [-format csv|json] [-fields a,b,c] [-search term] [-o file]
+//
+// Assets are printed as a table, or as JSON with -json.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// command is a subcommand of the CLI.
+type command struct {
+	// usage is the synopsis of the command's arguments
+	usage string
+
+	// summary describes the command in one line
+	summary string
+
+	// run runs the command with its arguments
+	run func(ctx context.Context, c *cli, args []string) error
+}
+
+var commands = map[string]command{
+	"list":     {"[-search term] [-limit n] [-all] [-json]", "list assets", runList},
+	"get":      {"[-json] id", "show an asset", runGet},
+	"search":   {"[-json] (-tag tag | -serial serial)", "find assets by asset tag or serial", runSearch},
+	"create":   {"-model id -status id -tag tag [-name name] [-serial serial] [-json]", "create an asset", runCreate},
+	"checkout": {"(-user id | -location id | -asset id) [-note text] [-expected date] [-json] id", "check an asset out", runCheckout},
+	"checkin":  {"[-note text] [-location id] [-status id] [-json] id", "check an asset in", runCheckin},
+	"export":   {"[-format csv|json] [-fields a,b,c] [-search term] [-o file]", "export every asset", runExport},
+}
+
+// errUsage is returned for invalid command lines, after the usage is
+// printed.
+var errUsage = errors.New("invalid usage")
+
+// cli holds the client and outputs of a command.
+type cli struct {
+	// client is the client of the command, created by parse
+	client    *snipeit.Client
+	newClient func() (*snipeit.Client, error)
+
+	stdout io.Writer
+	stderr io.Writer
+
+	// usage is the synopsis of the running command's arguments
+	usage string
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := run(ctx, os.Args[1:], os.Stdout, os.Stderr, snipeit.NewClientFromEnv)
+	switch {
+	case errors.Is(err, errUsage):
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "snipeit: %s\n", strings.TrimPrefix(err.Error(), "snipeit: "))
+		os.Exit(1)
+	}
+}
+
+// run runs the command line args, creating the client with newClient.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer, newClient func() (*snipeit.Client, error)) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "-help" {
+		usage(stderr)
+		return errUsage
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "snipeit: unknown command %q\n", args[0])
+		usage(stderr)
+		return errUsage
+	}
+
+	c := &cli{newClient: newClient, stdout: stdout, stderr: stderr, usage: cmd.usage}
+	return cmd.run(ctx, c, args[1:])
+}
+
+// usage prints the commands of the CLI to w.
+func usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "Usage: snipeit <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-9s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run 'snipeit <command> -h' for the flags of a command.")
+}
+
+// flagSet returns the flag set of the named command, printing errors and
+// usage to c.stderr.
+func (c *cli) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: snipeit %s %s\n", name, c.usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse parses the flags of fs from args, checks that nargs positional
+// arguments follow them, and then creates the client of the command.
+func (c *cli) parse(fs *flag.FlagSet, args []string, nargs int) error {
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if fs.NArg() != nargs {
+		fmt.Fprintf(fs.Output(), "snipeit %s: expected %d argument(s), got %d\n", fs.Name(), nargs, fs.NArg())
+		fs.Usage()
+		return errUsage
+	}
+
+	client, err := c.newClient()
+	if err != nil {
+		return err
+	}
+	c.client = client
+	return nil
+}
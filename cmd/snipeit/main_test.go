@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/michellepellon/go-snipeit"
+	"github.com/michellepellon/go-snipeit/snipeittest"
+)
+
+// runCLI runs the command line args against server, and returns its
+// standard output.
+func runCLI(t *testing.T, server *snipeittest.Server, args ...string) (string, error) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	err := run(context.Background(), args, &stdout, &stderr, func() (*snipeit.Client, error) {
+		return server.Client(), nil
+	})
+	return stdout.String(), err
+}
+
+func TestCreateGetCheckoutCheckin(t *testing.T) {
+	server := snipeittest.NewServer()
+	defer server.Close()
+	user := server.AddUser(snipeit.User{Username: "alice", FirstName: "Alice"})
+
+	out, err := runCLI(t, server, "create", "-model", "7", "-status", "1", "-tag", "AT-1", "-serial", "SN1", "-json")
+	if err != nil {
+		t.Fatalf("create returned error: %v", err)
+	}
+	var created snipeit.Asset
+	if err := json.Unmarshal([]byte(out), &created); err != nil || created.AssetTag != "AT-1" {
+		t.Fatalf("create printed %q, expected asset AT-1 as JSON", out)
+	}
+	id := strconv.Itoa(created.ID)
+
+	out, err = runCLI(t, server, "get", id)
+	if err != nil {
+		t.Fatalf("get returned error: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(out), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[0], "ID") || !strings.Contains(lines[1], "AT-1") {
+		t.Errorf("get printed %q, expected a table with asset AT-1", out)
+	}
+
+	if _, err := runCLI(t, server, "checkout", "-user", strconv.Itoa(user.ID), "-expected", "2030-01-31", id); err != nil {
+		t.Fatalf("checkout returned error: %v", err)
+	}
+	if asset, _ := server.Asset(created.ID); asset.AssignedTo == nil || asset.AssignedTo.ID() != user.ID {
+		t.Errorf("Asset after checkout = %+v, expected it assigned to user %d", asset, user.ID)
+	}
+
+	if _, err := runCLI(t, server, "checkin", "-note", "returned", id); err != nil {
+		t.Fatalf("checkin returned error: %v", err)
+	}
+	if asset, _ := server.Asset(created.ID); asset.AssignedTo != nil {
+		t.Errorf("Asset after checkin = %+v, expected it unassigned", asset)
+	}
+}
+
+func TestListAndSearch(t *testing.T) {
+	server := snipeittest.NewServer()
+	defer server.Close()
+	server.AddAsset(snipeit.Asset{AssetTag: "AT-1", Serial: "SN1"})
+	server.AddAsset(snipeit.Asset{AssetTag: "AT-2", Serial: "SN2"})
+	server.AddAsset(snipeit.Asset{AssetTag: "AT-3", Serial: "SN1"})
+
+	out, err := runCLI(t, server, "list", "-all", "-json")
+	if err != nil {
+		t.Fatalf("list returned error: %v", err)
+	}
+	var assets []snipeit.Asset
+	if err := json.Unmarshal([]byte(out), &assets); err != nil || len(assets) != 3 {
+		t.Errorf("list -all printed %q, expected 3 assets as JSON", out)
+	}
+
+	out, err = runCLI(t, server, "list", "-limit", "1")
+	if err != nil {
+		t.Fatalf("list returned error: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(out), "\n"); len(lines) != 2 {
+		t.Errorf("list -limit 1 printed %q, expected a header and 1 row", out)
+	}
+
+	out, err = runCLI(t, server, "search", "-serial", "SN1")
+	if err != nil {
+		t.Fatalf("search returned error: %v", err)
+	}
+	if !strings.Contains(out, "AT-1") || !strings.Contains(out, "AT-3") || strings.Contains(out, "AT-2") {
+		t.Errorf("search -serial SN1 printed %q, expected AT-1 and AT-3", out)
+	}
+
+	if _, err := runCLI(t, server, "search", "-tag", "AT-9"); err == nil {
+		t.Error("search of an unknown tag returned no error")
+	}
+}
+
+func TestExport(t *testing.T) {
+	server := snipeittest.NewServer()
+	defer server.Close()
+	server.AddAsset(snipeit.Asset{AssetTag: "AT-1", Serial: "SN1"})
+
+	path := filepath.Join(t.TempDir(), "assets.csv")
+	if _, err := runCLI(t, server, "export", "-fields", "asset_tag,serial", "-o", path); err != nil {
+		t.Fatalf("export returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile returned error: %v", err)
+	}
+	if want := "asset_tag,serial\nAT-1,SN1\n"; string(data) != want {
+		t.Errorf("export wrote %q, expected %q", data, want)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	server := snipeittest.NewServer()
+	defer server.Close()
+
+	for _, args := range [][]string{
+		nil,
+		{"unknown"},
+		{"get"},
+		{"get", "-h"},
+		{"checkout", "5"},
+		{"search", "-tag", "AT-1", "-serial", "SN1"},
+		{"export", "-format", "xml"},
+	} {
+		if _, err := runCLI(t, server, args...); !errors.Is(err, errUsage) {
+			t.Errorf("run(%q) returned %v, expected errUsage", args, err)
+		}
+	}
+
+	if len(server.Requests()) != 0 {
+		t.Errorf("Invalid command lines sent requests: %v", server.Requests())
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// assetColumns are the headers of the columns of asset tables.
+var assetColumns = []string{"ID", "TAG", "NAME", "SERIAL", "MODEL", "STATUS", "ASSIGNED TO", "LOCATION"}
+
+// printAssets prints assets to w as a table.
+func printAssets(w io.Writer, assets []snipeit.Asset) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	printRow(tw, assetColumns)
+	for _, asset := range assets {
+		printRow(tw, assetRow(asset))
+	}
+	return tw.Flush()
+}
+
+// assetRow returns the cells of asset in an asset table.
+func assetRow(asset snipeit.Asset) []string {
+	assignedTo := ""
+	if asset.AssignedTo != nil {
+		assignedTo = fmt.Sprintf("%s %s", asset.AssignedTo.Kind(), asset.AssignedTo.Name())
+	}
+	location := ""
+	if asset.Location != nil {
+		location = asset.Location.Name
+	}
+	return []string{
+		strconv.Itoa(asset.ID),
+		asset.AssetTag,
+		asset.Name,
+		asset.Serial,
+		asset.Model.Name,
+		asset.StatusLabel.Name,
+		assignedTo,
+		location,
+	}
+}
+
+// printRow writes cells to tw as a row, showing empty cells as "-".
+func printRow(tw *tabwriter.Writer, cells []string) {
+	for i, cell := range cells {
+		if cell == "" {
+			cell = "-"
+		}
+		if i > 0 {
+			fmt.Fprint(tw, "\t")
+		}
+		fmt.Fprint(tw, cell)
+	}
+	fmt.Fprintln(tw)
+}
+
+// printJSON prints v to w as indented JSON.
+func printJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,245 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// CompaniesService handles communication with the company-related endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/companies
+type CompaniesService struct {
+	client *Client
+}
+
+// CompanyResponse represents the API response for a single company.
+// The single company endpoint returns the company data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Company.
+type CompanyResponse struct {
+	Response
+	// Payload contains the company as returned in the payload field, if any
+	Payload *Company `json:"payload,omitempty"`
+	Company
+}
+
+// UnmarshalJSON implements json.Unmarshaler for CompanyResponse.
+func (r *CompanyResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Company)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Company
+	}
+	return nil
+}
+
+// CompaniesResponse represents the API response for multiple companies.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Companies.
+type CompaniesResponse struct {
+	Response
+	// Rows contains the list of Company objects
+	Rows []Company `json:"rows"`
+}
+
+// List returns a list of companies with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/companies
+func (s *CompaniesService) List(opts *ListOptions) (*CompaniesResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of companies with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/companies
+func (s *CompaniesService) ListContext(ctx context.Context, opts *ListOptions) (*CompaniesResponse, *http.Response, error) {
+	u := "api/v1/companies"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var companies CompaniesResponse
+	resp, err := s.client.Do(req, &companies)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &companies, resp, nil
+}
+
+// Iterate returns an iterator over every company, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Company.
+func (s *CompaniesService) Iterate(opts *ListOptions) iter.Seq2[Company, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every company with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Company.
+func (s *CompaniesService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Company, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]Company, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single company by its ID.
+//
+// id is the unique identifier of the company to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/companiesid
+func (s *CompaniesService) Get(id int) (*CompanyResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single company by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the company to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/companiesid
+func (s *CompaniesService) GetContext(ctx context.Context, id int) (*CompanyResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/companies/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var company CompanyResponse
+	resp, err := s.client.Do(req, &company)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &company, resp, nil
+}
+
+// Create creates a new company in Snipe-IT.
+//
+// company must contain the required fields:
+// - Name: The name of the company
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/companies-1
+func (s *CompaniesService) Create(company Company) (*CompanyResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), company)
+}
+
+// CreateContext creates a new company in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// company must contain the required fields:
+// - Name: The name of the company
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/companies-1
+func (s *CompaniesService) CreateContext(ctx context.Context, company Company) (*CompanyResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/companies", company)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response CompanyResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing company in Snipe-IT.
+//
+// id is the unique identifier of the company to update.
+// company contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/companiesid-1
+func (s *CompaniesService) Update(id int, company Company) (*CompanyResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, company)
+}
+
+// UpdateContext updates an existing company in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the company to update.
+// company contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/companiesid-1
+func (s *CompaniesService) UpdateContext(ctx context.Context, id int, company Company) (*CompanyResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/companies/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, company)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response CompanyResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a company from Snipe-IT.
+//
+// id is the unique identifier of the company to delete.
+// Snipe-IT refuses to delete companies that still have items or users assigned.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/companiesid-2
+func (s *CompaniesService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a company from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the company to delete.
+// Snipe-IT refuses to delete companies that still have items or users assigned.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/companiesid-2
+func (s *CompaniesService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/companies/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
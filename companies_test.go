@@ -0,0 +1,126 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCompaniesList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/companies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "Authorization", "Bearer test-token")
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 1, "name": "Acme Corp", "email": "it@acme.example", "assets_count": 120, "users_count": 40},
+				{"id": 2, "name": "Globex", "assets_count": 15, "users_count": 6}
+			]
+		}`)
+	})
+
+	companies, _, err := client.Companies.List(nil)
+	if err != nil {
+		t.Fatalf("Companies.List returned error: %v", err)
+	}
+
+	if len(companies.Rows) != 2 {
+		t.Fatalf("Companies.List returned %d companies, expected %d", len(companies.Rows), 2)
+	}
+
+	acme := companies.Rows[0]
+	if acme.Name != "Acme Corp" || acme.Email != "it@acme.example" || acme.AssetsCount != 120 || acme.UsersCount != 40 {
+		t.Errorf("Companies.List returned %+v, expected Acme Corp with 120 assets and 40 users", acme)
+	}
+}
+
+func TestCompaniesGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/companies/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "name": "Acme Corp", "phone": "555-0100"}`)
+	})
+
+	company, _, err := client.Companies.Get(1)
+	if err != nil {
+		t.Fatalf("Companies.Get returned error: %v", err)
+	}
+
+	if company.ID != 1 || company.Phone != "555-0100" {
+		t.Errorf("Companies.Get returned %+v, expected ID 1 with phone 555-0100", company.Company)
+	}
+}
+
+func TestCompaniesCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/companies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["name"] != "Initech" {
+			t.Errorf("Request body name = %v, expected %v", requestBody["name"], "Initech")
+		}
+
+		fmt.Fprint(w, `{
+			"status": "success",
+			"messages": "Company created successfully.",
+			"payload": {"id": 3, "name": "Initech"}
+		}`)
+	})
+
+	company, _, err := client.Companies.Create(Company{CommonFields: CommonFields{Name: "Initech"}})
+	if err != nil {
+		t.Fatalf("Companies.Create returned error: %v", err)
+	}
+
+	if company.Status != "success" {
+		t.Errorf("Companies.Create returned Status = %s, expected %s", company.Status, "success")
+	}
+
+	if company.Payload == nil || company.Payload.ID != 3 {
+		t.Errorf("Companies.Create returned Payload = %+v, expected ID %d", company.Payload, 3)
+	}
+}
+
+func TestCompaniesUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/companies/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 3, "name": "Initrode"}}`)
+	})
+
+	company, _, err := client.Companies.Update(3, Company{CommonFields: CommonFields{Name: "Initrode"}})
+	if err != nil {
+		t.Fatalf("Companies.Update returned error: %v", err)
+	}
+
+	if company.Name != "Initrode" {
+		t.Errorf("Companies.Update returned Name = %q, expected %q", company.Name, "Initrode")
+	}
+}
+
+func TestCompaniesDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/companies/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success", "messages": "Company deleted"}`)
+	})
+
+	if _, err := client.Companies.Delete(3); err != nil {
+		t.Fatalf("Companies.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,225 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Environment variables read by NewClientFromEnv.
+const (
+	// EnvURL holds the base URL of the Snipe-IT instance
+	EnvURL = "SNIPEIT_URL"
+
+	// EnvToken holds the API token
+	EnvToken = "SNIPEIT_API_TOKEN"
+
+	// EnvRateLimit holds the maximum number of requests per second
+	EnvRateLimit = "SNIPEIT_RATE_LIMIT"
+
+	// EnvRateBurst holds the number of requests allowed in a burst
+	EnvRateBurst = "SNIPEIT_RATE_BURST"
+
+	// EnvMaxRetries holds the maximum number of retries; 0 disables retries
+	EnvMaxRetries = "SNIPEIT_MAX_RETRIES"
+
+	// EnvTimeout holds the HTTP client timeout as a Go duration (e.g., "30s")
+	EnvTimeout = "SNIPEIT_TIMEOUT"
+)
+
+// Config is a configuration file describing one or more Snipe-IT instances.
+//
+// Config files are JSON documents such as:
+//
+//	{
+//	  "default": "production",
+//	  "instances": {
+//	    "production": {"url": "https://assets.example.com", "token_env": "SNIPEIT_PROD_TOKEN", "rate_limit": 5},
+//	    "staging": {"url": "https://assets-staging.example.com", "token": "...", "max_retries": 0}
+//	  }
+//	}
+type Config struct {
+	// Default is the name of the instance used when none is requested
+	Default string `json:"default,omitempty"`
+
+	// Instances maps instance names to their configuration
+	Instances map[string]InstanceConfig `json:"instances"`
+}
+
+// InstanceConfig describes how to connect to a single Snipe-IT instance.
+type InstanceConfig struct {
+	// URL is the base URL of the Snipe-IT instance
+	URL string `json:"url"`
+
+	// Token is the API token
+	Token string `json:"token,omitempty"`
+
+	// TokenEnv is the name of an environment variable holding the API token,
+	// to keep the token out of the file. It takes precedence over Token.
+	TokenEnv string `json:"token_env,omitempty"`
+
+	// RateLimit is the maximum number of requests per second.
+	// If zero, no rate limiting is applied.
+	RateLimit float64 `json:"rate_limit,omitempty"`
+
+	// RateBurst is the number of requests allowed in a burst.
+	// If zero, a default burst size is used.
+	RateBurst int `json:"rate_burst,omitempty"`
+
+	// MaxRetries is the maximum number of retries for failed requests.
+	// Zero disables retries; if nil, DefaultRetryPolicy is used.
+	MaxRetries *int `json:"max_retries,omitempty"`
+
+	// Timeout is the HTTP client timeout as a Go duration (e.g., "30s").
+	// If empty, the client's default of 60s is used; a negative duration
+	// disables the timeout.
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// NewClientFromEnv returns a new Snipe-IT API client configured from
+// environment variables.
+//
+// SNIPEIT_URL and SNIPEIT_API_TOKEN are required. SNIPEIT_RATE_LIMIT,
+// SNIPEIT_RATE_BURST, SNIPEIT_MAX_RETRIES and SNIPEIT_TIMEOUT optionally
+// configure rate limiting, retries and the HTTP timeout, with the same
+// meaning as the fields of InstanceConfig.
+//
+// Returns an error if a required variable is missing or a value is invalid.
+func NewClientFromEnv() (*Client, error) {
+	instance := InstanceConfig{
+		URL:     os.Getenv(EnvURL),
+		Token:   os.Getenv(EnvToken),
+		Timeout: os.Getenv(EnvTimeout),
+	}
+	if instance.URL == "" || instance.Token == "" {
+		return nil, fmt.Errorf("snipeit: %s and %s environment variables must be set", EnvURL, EnvToken)
+	}
+
+	if value := os.Getenv(EnvRateLimit); value != "" {
+		rateLimit, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("snipeit: invalid %s: %w", EnvRateLimit, err)
+		}
+		instance.RateLimit = rateLimit
+	}
+
+	if value := os.Getenv(EnvRateBurst); value != "" {
+		rateBurst, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("snipeit: invalid %s: %w", EnvRateBurst, err)
+		}
+		instance.RateBurst = rateBurst
+	}
+
+	if value := os.Getenv(EnvMaxRetries); value != "" {
+		maxRetries, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("snipeit: invalid %s: %w", EnvMaxRetries, err)
+		}
+		instance.MaxRetries = &maxRetries
+	}
+
+	return instance.NewClient()
+}
+
+// NewClientFromFile returns a new Snipe-IT API client for an instance
+// described in a JSON configuration file.
+//
+// path is the path of the configuration file.
+// name is the name of the instance to connect to. If empty, the file's
+// default instance is used, or its only instance if there is just one.
+//
+// See Config for the file format.
+func NewClientFromFile(path, name string) (*Client, error) {
+	config, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return config.NewClient(name)
+}
+
+// LoadConfig reads a JSON configuration file. Unknown fields are rejected,
+// so that misspelled settings are not silently ignored.
+//
+// path is the path of the configuration file.
+func LoadConfig(path string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var config Config
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&config); err != nil {
+		return nil, fmt.Errorf("snipeit: parsing config %s: %w", path, err)
+	}
+
+	return &config, nil
+}
+
+// NewClient returns a new Snipe-IT API client for the named instance.
+//
+// name is the name of the instance to connect to. If empty, the default
+// instance is used, or the only instance if there is just one.
+func (c *Config) NewClient(name string) (*Client, error) {
+	if name == "" {
+		name = c.Default
+	}
+	if name == "" {
+		if len(c.Instances) != 1 {
+			return nil, errors.New("snipeit: config has no default instance")
+		}
+		for instanceName := range c.Instances {
+			name = instanceName
+		}
+	}
+
+	instance, ok := c.Instances[name]
+	if !ok {
+		return nil, fmt.Errorf("snipeit: config has no instance named %q", name)
+	}
+
+	return instance.NewClient()
+}
+
+// NewClient returns a new Snipe-IT API client for the instance.
+//
+// Returns an error if the URL or token is missing or a setting is invalid.
+func (ic InstanceConfig) NewClient() (*Client, error) {
+	token := ic.Token
+	if ic.TokenEnv != "" {
+		token = os.Getenv(ic.TokenEnv)
+	}
+
+	options := &ClientOptions{}
+
+	if ic.RateLimit > 0 {
+		options.RateLimiter = NewTokenBucketRateLimiter(ic.RateLimit, ic.RateBurst)
+	}
+
+	if ic.MaxRetries != nil {
+		if *ic.MaxRetries <= 0 {
+			options.DisableRetries = true
+		} else {
+			options.RetryPolicy = DefaultRetryPolicy()
+			options.RetryPolicy.MaxRetries = *ic.MaxRetries
+		}
+	}
+
+	if ic.Timeout != "" {
+		timeout, err := time.ParseDuration(ic.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("snipeit: invalid timeout: %w", err)
+		}
+		options.Timeout = timeout
+	}
+
+	return NewClientWithOptions(ic.URL, token, options)
+}
@@ -0,0 +1,101 @@
+package snipeit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewClientFromEnv(t *testing.T) {
+	t.Setenv(EnvURL, "https://assets.example.com")
+	t.Setenv(EnvToken, "env-token")
+	t.Setenv(EnvRateLimit, "2.5")
+	t.Setenv(EnvRateBurst, "4")
+	t.Setenv(EnvMaxRetries, "0")
+	t.Setenv(EnvTimeout, "15s")
+
+	client, err := NewClientFromEnv()
+	if err != nil {
+		t.Fatalf("NewClientFromEnv returned error: %v", err)
+	}
+
+	if client.BaseURL.String() != "https://assets.example.com/" {
+		t.Errorf("BaseURL = %q, expected %q", client.BaseURL, "https://assets.example.com/")
+	}
+	if client.token != "Bearer env-token" {
+		t.Errorf("token = %q, expected %q", client.token, "Bearer env-token")
+	}
+
+	limiter, ok := client.rateLimiter.(*TokenBucketRateLimiter)
+	if !ok || limiter.tokensPerSec != 2.5 || limiter.maxTokens != 4 {
+		t.Errorf("rateLimiter = %+v, expected 2.5 requests per second with a burst of 4", client.rateLimiter)
+	}
+	if !client.disableRetries {
+		t.Error("disableRetries = false, expected retries to be disabled")
+	}
+	if client.client.Timeout != 15*time.Second {
+		t.Errorf("Timeout = %v, expected %v", client.client.Timeout, 15*time.Second)
+	}
+}
+
+func TestNewClientFromEnvErrors(t *testing.T) {
+	t.Setenv(EnvURL, "https://assets.example.com")
+	t.Setenv(EnvToken, "")
+	if _, err := NewClientFromEnv(); err == nil || !strings.Contains(err.Error(), EnvToken) {
+		t.Errorf("NewClientFromEnv without a token returned error %v, expected it to mention %s", err, EnvToken)
+	}
+
+	t.Setenv(EnvToken, "env-token")
+	t.Setenv(EnvMaxRetries, "many")
+	if _, err := NewClientFromEnv(); err == nil || !strings.Contains(err.Error(), EnvMaxRetries) {
+		t.Errorf("NewClientFromEnv with an invalid retry count returned error %v, expected it to mention %s", err, EnvMaxRetries)
+	}
+}
+
+func TestNewClientFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snipeit.json")
+	config := `{
+		"default": "production",
+		"instances": {
+			"production": {"url": "https://assets.example.com", "token_env": "TEST_SNIPEIT_PROD_TOKEN", "rate_limit": 5},
+			"staging": {"url": "https://staging.example.com", "token": "staging-token", "max_retries": 1}
+		}
+	}`
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_SNIPEIT_PROD_TOKEN", "prod-token")
+
+	client, err := NewClientFromFile(path, "")
+	if err != nil {
+		t.Fatalf("NewClientFromFile returned error: %v", err)
+	}
+	if client.BaseURL.Host != "assets.example.com" || client.token != "Bearer prod-token" || client.rateLimiter == nil {
+		t.Errorf("default instance client = %s with token %q, expected the rate-limited production instance", client.BaseURL, client.token)
+	}
+
+	client, err = NewClientFromFile(path, "staging")
+	if err != nil {
+		t.Fatalf("NewClientFromFile returned error: %v", err)
+	}
+	if client.BaseURL.Host != "staging.example.com" || client.retryPolicy.MaxRetries != 1 {
+		t.Errorf("staging client = %s with %d retries, expected staging.example.com with 1 retry", client.BaseURL, client.retryPolicy.MaxRetries)
+	}
+
+	if _, err := NewClientFromFile(path, "qa"); err == nil {
+		t.Error("NewClientFromFile with an unknown instance returned no error")
+	}
+}
+
+func TestLoadConfigUnknownField(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snipeit.json")
+	if err := os.WriteFile(path, []byte(`{"instances": {"main": {"url": "https://assets.example.com", "tokn": "x"}}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadConfig(path); err == nil {
+		t.Error("LoadConfig with a misspelled field returned no error")
+	}
+}
@@ -0,0 +1,325 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// ConsumablesService handles communication with the consumable-related endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumables
+type ConsumablesService struct {
+	client *Client
+}
+
+// ConsumableResponse represents the API response for a single consumable.
+// The single consumable endpoint returns the consumable data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Consumable.
+type ConsumableResponse struct {
+	Response
+	// Payload contains the consumable as returned in the payload field, if any
+	Payload *Consumable `json:"payload,omitempty"`
+	Consumable
+}
+
+// UnmarshalJSON implements json.Unmarshaler for ConsumableResponse.
+func (r *ConsumableResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Consumable)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Consumable
+	}
+	return nil
+}
+
+// ConsumablesResponse represents the API response for multiple consumables.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Consumables.
+type ConsumablesResponse struct {
+	Response
+	// Rows contains the list of Consumable objects
+	Rows []Consumable `json:"rows"`
+}
+
+// ConsumableCheckoutsResponse represents the API response listing the
+// checkouts of a consumable.
+type ConsumableCheckoutsResponse struct {
+	Response
+	// Rows contains the list of ConsumableCheckout objects
+	Rows []ConsumableCheckout `json:"rows"`
+}
+
+// List returns a list of consumables with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumables
+func (s *ConsumablesService) List(opts *ListOptions) (*ConsumablesResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of consumables with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumables
+func (s *ConsumablesService) ListContext(ctx context.Context, opts *ListOptions) (*ConsumablesResponse, *http.Response, error) {
+	u := "api/v1/consumables"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var consumables ConsumablesResponse
+	resp, err := s.client.Do(req, &consumables)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &consumables, resp, nil
+}
+
+// Iterate returns an iterator over every consumable, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Consumable.
+func (s *ConsumablesService) Iterate(opts *ListOptions) iter.Seq2[Consumable, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every consumable with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Consumable.
+func (s *ConsumablesService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Consumable, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]Consumable, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single consumable by its ID.
+//
+// id is the unique identifier of the consumable to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumablesid
+func (s *ConsumablesService) Get(id int) (*ConsumableResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single consumable by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the consumable to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumablesid
+func (s *ConsumablesService) GetContext(ctx context.Context, id int) (*ConsumableResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/consumables/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var consumable ConsumableResponse
+	resp, err := s.client.Do(req, &consumable)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &consumable, resp, nil
+}
+
+// Create creates a new consumable in Snipe-IT.
+//
+// consumable must contain the required fields:
+// - Name: The name of the consumable
+// - Qty: The quantity in stock
+// - CategoryID: The ID of a consumable category
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumables-1
+func (s *ConsumablesService) Create(consumable Consumable) (*ConsumableResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), consumable)
+}
+
+// CreateContext creates a new consumable in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// consumable must contain the required fields:
+// - Name: The name of the consumable
+// - Qty: The quantity in stock
+// - CategoryID: The ID of a consumable category
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumables-1
+func (s *ConsumablesService) CreateContext(ctx context.Context, consumable Consumable) (*ConsumableResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/consumables", consumable)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response ConsumableResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing consumable in Snipe-IT.
+//
+// id is the unique identifier of the consumable to update.
+// consumable contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumablesid-1
+func (s *ConsumablesService) Update(id int, consumable Consumable) (*ConsumableResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, consumable)
+}
+
+// UpdateContext updates an existing consumable in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the consumable to update.
+// consumable contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumablesid-1
+func (s *ConsumablesService) UpdateContext(ctx context.Context, id int, consumable Consumable) (*ConsumableResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/consumables/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, consumable)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response ConsumableResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a consumable from Snipe-IT.
+//
+// id is the unique identifier of the consumable to delete.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumablesid-2
+func (s *ConsumablesService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a consumable from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the consumable to delete.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumablesid-2
+func (s *ConsumablesService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/consumables/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Checkout checks out one unit of a consumable to a user.
+//
+// id is the unique identifier of the consumable to check out.
+// userID is the unique identifier of the user to check the consumable out to.
+// Consumables are used up once checked out and cannot be checked back in.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumablescheckout
+func (s *ConsumablesService) Checkout(id, userID int) (*ConsumableResponse, *http.Response, error) {
+	return s.CheckoutContext(context.Background(), id, userID)
+}
+
+// CheckoutContext checks out one unit of a consumable to a user with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the consumable to check out.
+// userID is the unique identifier of the user to check the consumable out to.
+// Consumables are used up once checked out and cannot be checked back in.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumablescheckout
+func (s *ConsumablesService) CheckoutContext(ctx context.Context, id, userID int) (*ConsumableResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/consumables/%d/checkout", id)
+	body := map[string]interface{}{
+		"assigned_to": userID,
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response ConsumableResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Users returns the checkouts of a consumable, listing who each unit was checked out to.
+//
+// id is the unique identifier of the consumable.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumablesviewusers
+func (s *ConsumablesService) Users(id int) (*ConsumableCheckoutsResponse, *http.Response, error) {
+	return s.UsersContext(context.Background(), id)
+}
+
+// UsersContext returns the checkouts of a consumable with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the consumable.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/consumablesviewusers
+func (s *ConsumablesService) UsersContext(ctx context.Context, id int) (*ConsumableCheckoutsResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/consumables/view/%d/users", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var checkouts ConsumableCheckoutsResponse
+	resp, err := s.client.Do(req, &checkouts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &checkouts, resp, nil
+}
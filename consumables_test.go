@@ -0,0 +1,147 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestConsumablesList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/consumables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("search") != "toner" {
+			t.Errorf("Request URL query parameter 'search' = %v, expected %v", r.URL.Query().Get("search"), "toner")
+		}
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{"id": 1, "name": "Black Toner", "item_no": "TN-760", "qty": 20, "remaining": 4, "min_amt": 5}
+			]
+		}`)
+	})
+
+	consumables, _, err := client.Consumables.List(&ListOptions{Search: "toner"})
+	if err != nil {
+		t.Fatalf("Consumables.List returned error: %v", err)
+	}
+
+	if len(consumables.Rows) != 1 {
+		t.Fatalf("Consumables.List returned %d consumables, expected %d", len(consumables.Rows), 1)
+	}
+
+	consumable := consumables.Rows[0]
+	if consumable.ItemNo != "TN-760" || consumable.Qty != 20 || consumable.Remaining != 4 || consumable.MinAmt != 5 {
+		t.Errorf("Consumables.List returned %+v, expected TN-760 with qty 20, 4 remaining and min 5", consumable)
+	}
+}
+
+func TestConsumablesCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/consumables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["qty"] != float64(20) {
+			t.Errorf("Request body qty = %v, expected %v", requestBody["qty"], float64(20))
+		}
+
+		fmt.Fprint(w, `{
+			"status": "success",
+			"payload": {"id": 2, "name": "Black Toner", "qty": 20}
+		}`)
+	})
+
+	consumable, _, err := client.Consumables.Create(Consumable{
+		CommonFields: CommonFields{Name: "Black Toner"},
+		Qty:          20,
+		CategoryID:   6,
+	})
+	if err != nil {
+		t.Fatalf("Consumables.Create returned error: %v", err)
+	}
+
+	if consumable.Payload == nil || consumable.Payload.ID != 2 {
+		t.Errorf("Consumables.Create returned Payload = %+v, expected ID %d", consumable.Payload, 2)
+	}
+}
+
+func TestConsumablesDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/consumables/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success"}`)
+	})
+
+	if _, err := client.Consumables.Delete(2); err != nil {
+		t.Fatalf("Consumables.Delete returned error: %v", err)
+	}
+}
+
+func TestConsumablesCheckout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/consumables/2/checkout", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["assigned_to"] != float64(7) {
+			t.Errorf("Request body assigned_to = %v, expected %v", requestBody["assigned_to"], float64(7))
+		}
+
+		fmt.Fprint(w, `{"status": "success", "messages": "Consumable checked out successfully."}`)
+	})
+
+	consumable, _, err := client.Consumables.Checkout(2, 7)
+	if err != nil {
+		t.Fatalf("Consumables.Checkout returned error: %v", err)
+	}
+
+	if consumable.Status != "success" {
+		t.Errorf("Consumables.Checkout returned Status = %s, expected %s", consumable.Status, "success")
+	}
+}
+
+func TestConsumablesUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/consumables/view/2/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{
+					"name": "Jane Doe",
+					"created_at": {"datetime": "2024-02-01 10:00:00", "formatted": "Thu Feb 01, 2024 10:00AM"},
+					"note": "Printer on floor 2"
+				}
+			]
+		}`)
+	})
+
+	checkouts, _, err := client.Consumables.Users(2)
+	if err != nil {
+		t.Fatalf("Consumables.Users returned error: %v", err)
+	}
+
+	if len(checkouts.Rows) != 1 {
+		t.Fatalf("Consumables.Users returned %d rows, expected %d", len(checkouts.Rows), 1)
+	}
+
+	if checkouts.Rows[0].Note != "Printer on floor 2" || checkouts.Rows[0].CreatedAt == nil {
+		t.Errorf("Consumables.Users returned %+v, expected note and checkout time", checkouts.Rows[0])
+	}
+}
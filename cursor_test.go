@@ -0,0 +1,211 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// updatedAssets serves assets sorted by update time, newest first, with
+// their update times, as the hardware list endpoint does for
+// sort=updated_at.
+type updatedAssets struct {
+	mu     sync.Mutex
+	assets []map[string]interface{}
+	pages  int
+}
+
+// set records the asset with the given ID as updated at the given time,
+// given as "2006-01-02 15:04:05".
+func (u *updatedAssets) set(id int, updated string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	asset := map[string]interface{}{
+		"id":         id,
+		"asset_tag":  "AT-" + strconv.Itoa(id),
+		"updated_at": map[string]interface{}{"datetime": updated, "formatted": updated},
+	}
+	for i, a := range u.assets {
+		if a["id"] == id {
+			u.assets = append(u.assets[:i], u.assets[i+1:]...)
+			break
+		}
+	}
+	// Keep the newest first, as the server would sort them
+	i := 0
+	for i < len(u.assets) && u.assets[i]["updated_at"].(map[string]interface{})["datetime"].(string) >= updated {
+		i++
+	}
+	u.assets = append(u.assets[:i], append([]map[string]interface{}{asset}, u.assets[i:]...)...)
+}
+
+func (u *updatedAssets) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		u.mu.Lock()
+		defer u.mu.Unlock()
+		u.pages++
+
+		query := r.URL.Query()
+		if query.Get("sort") != "updated_at" || query.Get("sort_dir") != "desc" {
+			t.Errorf("Request URL query = %v, expected sort=updated_at and sort_dir=desc", query)
+		}
+
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		rows := []map[string]interface{}{}
+		for i := offset; i < len(u.assets) && len(rows) < limit; i++ {
+			rows = append(rows, u.assets[i])
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"total": len(u.assets), "rows": rows})
+	}
+}
+
+func TestAssetsListUpdatedSince(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	assets := &updatedAssets{}
+	for id := 1; id <= 1000; id++ {
+		assets.set(id, "2024-01-01 00:00:00")
+	}
+	assets.set(7, "2024-03-02 10:00:00")
+	assets.set(3, "2024-03-01 09:00:00")
+	assets.set(5, "2024-03-01 09:00:00")
+	mux.HandleFunc("/api/v1/hardware", assets.handler(t))
+
+	since := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	updated, _, err := client.Assets.ListUpdatedSince(since, nil)
+	if err != nil {
+		t.Fatalf("Assets.ListUpdatedSince returned error: %v", err)
+	}
+
+	if assets.pages != 1 {
+		t.Errorf("Assets.ListUpdatedSince fetched %d pages, expected only the first", assets.pages)
+	}
+
+	var ids []int
+	for _, asset := range updated.Rows {
+		ids = append(ids, asset.ID)
+	}
+	if updated.Total != 3 || fmt.Sprint(ids) != "[3 5 7]" {
+		t.Errorf("Assets.ListUpdatedSince returned Total = %d, assets %v, expected 3 and [3 5 7]", updated.Total, ids)
+	}
+
+	page, _, err := client.Assets.ListUpdatedSince(since, &ListOptions{Offset: 1, Limit: 1})
+	if err != nil {
+		t.Fatalf("Assets.ListUpdatedSince returned error: %v", err)
+	}
+	if page.Total != 3 || len(page.Rows) != 1 || page.Rows[0].ID != 5 {
+		t.Errorf("Assets.ListUpdatedSince returned Total = %d, Rows = %+v, expected the second of 3 assets", page.Total, page.Rows)
+	}
+
+	all, _, err := client.Assets.ListUpdatedSince(time.Time{}, nil)
+	if err != nil {
+		t.Fatalf("Assets.ListUpdatedSince returned error: %v", err)
+	}
+	if all.Total != 1000 {
+		t.Errorf("Assets.ListUpdatedSince of the zero time returned %d assets, expected 1000", all.Total)
+	}
+}
+
+func TestAssetsSync(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	assets := &updatedAssets{}
+	assets.set(1, "2024-03-01 09:00:00")
+	assets.set(2, "2024-03-01 09:00:00")
+	assets.set(3, "2024-03-01 08:00:00")
+	mux.HandleFunc("/api/v1/hardware", assets.handler(t))
+
+	path := filepath.Join(t.TempDir(), "cursor.json")
+	runSync := func() []int {
+		t.Helper()
+		cursor, err := LoadCursor(path)
+		if err != nil {
+			t.Fatalf("LoadCursor returned error: %v", err)
+		}
+
+		var ids []int
+		if err := client.Assets.Sync(cursor, func(asset Asset) error {
+			ids = append(ids, asset.ID)
+			return nil
+		}); err != nil {
+			t.Fatalf("Assets.Sync returned error: %v", err)
+		}
+		return ids
+	}
+
+	if ids := runSync(); fmt.Sprint(ids) != "[3 1 2]" {
+		t.Errorf("First sync processed %v, expected every asset, oldest first", ids)
+	}
+	if ids := runSync(); len(ids) != 0 {
+		t.Errorf("Sync without changes processed %v, expected none", ids)
+	}
+
+	// An update in the same second as the watermark is still picked up
+	assets.set(4, "2024-03-01 09:00:00")
+	assets.set(3, "2024-03-01 09:30:00")
+	if ids := runSync(); fmt.Sprint(ids) != "[4 3]" {
+		t.Errorf("Sync processed %v, expected the updated assets [4 3]", ids)
+	}
+
+	cursor, _ := LoadCursor(path)
+	if want := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC); !cursor.Watermark.Equal(want) || fmt.Sprint(cursor.Seen) != "[3]" {
+		t.Errorf("Cursor = %+v, expected watermark %v with asset 3 seen", cursor, want)
+	}
+}
+
+func TestAssetsSyncError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	assets := &updatedAssets{}
+	assets.set(1, "2024-03-01 08:00:00")
+	assets.set(2, "2024-03-01 09:00:00")
+	assets.set(3, "2024-03-01 10:00:00")
+	mux.HandleFunc("/api/v1/hardware", assets.handler(t))
+
+	path := filepath.Join(t.TempDir(), "cursor.json")
+	cursor, err := LoadCursor(path)
+	if err != nil {
+		t.Fatalf("LoadCursor returned error: %v", err)
+	}
+
+	failure := errors.New("cmdb unavailable")
+	err = client.Assets.Sync(cursor, func(asset Asset) error {
+		if asset.ID == 2 {
+			return failure
+		}
+		return nil
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("Assets.Sync returned %v, expected the error of fn", err)
+	}
+
+	saved, err := LoadCursor(path)
+	if err != nil {
+		t.Fatalf("LoadCursor returned error: %v", err)
+	}
+	if want := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC); !saved.Watermark.Equal(want) || fmt.Sprint(saved.Seen) != "[1]" {
+		t.Errorf("Saved cursor = %+v, expected it after asset 1 only", saved)
+	}
+
+	var ids []int
+	if err := client.Assets.Sync(saved, func(asset Asset) error {
+		ids = append(ids, asset.ID)
+		return nil
+	}); err != nil {
+		t.Fatalf("Assets.Sync returned error: %v", err)
+	}
+	if fmt.Sprint(ids) != "[2 3]" {
+		t.Errorf("Resumed sync processed %v, expected [2 3]", ids)
+	}
+}
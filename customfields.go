@@ -0,0 +1,164 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// customFieldPrefix prefixes the database column names of custom fields.
+const customFieldPrefix = "_snipeit_"
+
+// customFieldColumn returns the database column name for column, adding
+// the "_snipeit_" prefix if it is missing.
+func customFieldColumn(column string) string {
+	if strings.HasPrefix(column, customFieldPrefix) {
+		return column
+	}
+	return customFieldPrefix + column
+}
+
+// CustomFieldValue is the value of a custom field on a resource, as
+// returned by the Snipe-IT API.
+type CustomFieldValue struct {
+	// Value is the value of the field. Numbers and booleans are converted
+	// to their string form, and a null value is empty.
+	Value string `json:"value"`
+
+	// Field is the database column name of the field
+	// (e.g., "_snipeit_mac_address_1"), which is used to set its value
+	Field string `json:"field"`
+
+	// Format is the validation format of the field
+	// (e.g., "ANY", "MAC", "IP", "NUMERIC")
+	Format string `json:"field_format"`
+
+	// Element is the form element of the field
+	// (e.g., "text", "textarea", "listbox", "checkbox")
+	Element string `json:"element,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler for CustomFieldValue.
+func (v *CustomFieldValue) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Value   json.RawMessage `json:"value"`
+		Field   string          `json:"field"`
+		Format  string          `json:"field_format"`
+		Element string          `json:"element"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	value, err := customFieldString(raw.Value)
+	if err != nil {
+		return fmt.Errorf("snipeit: custom field %s: %w", raw.Field, err)
+	}
+
+	*v = CustomFieldValue{Value: value, Field: raw.Field, Format: raw.Format, Element: raw.Element}
+	return nil
+}
+
+// customFieldString converts a custom field value to a string.
+func customFieldString(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case json.Number:
+		return value.String(), nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	}
+	return "", fmt.Errorf("unsupported value %s", raw)
+}
+
+// CustomFields holds the custom fields of a resource, keyed by field name
+// (e.g., "MAC Address").
+type CustomFields map[string]CustomFieldValue
+
+// UnmarshalJSON implements json.Unmarshaler for CustomFields. Snipe-IT
+// returns an object keyed by field name, or an empty array when the
+// resource has no custom fields.
+func (cf *CustomFields) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if string(trimmed) == "null" {
+		*cf = nil
+		return nil
+	}
+
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var values []json.RawMessage
+		if err := json.Unmarshal(trimmed, &values); err != nil {
+			return err
+		}
+		if len(values) > 0 {
+			return fmt.Errorf("snipeit: cannot decode custom fields from a non-empty array")
+		}
+		*cf = nil
+		return nil
+	}
+
+	var fields map[string]CustomFieldValue
+	if err := json.Unmarshal(trimmed, &fields); err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		fields = nil
+	}
+	*cf = fields
+	return nil
+}
+
+// Get returns the value of the custom field with the given name
+// (e.g., "MAC Address"), and whether the resource has that field.
+func (cf CustomFields) Get(name string) (string, bool) {
+	field, ok := cf[name]
+	return field.Value, ok
+}
+
+// Column returns the custom field stored in the given database column
+// (e.g., "_snipeit_mac_address_1"), and whether the resource has that field.
+func (cf CustomFields) Column(column string) (CustomFieldValue, bool) {
+	for _, field := range cf {
+		if field.Field == column {
+			return field, true
+		}
+	}
+	return CustomFieldValue{}, false
+}
+
+// withCustomFieldValues adds values, which map custom field column names to
+// their new values, to the JSON object data as the top-level "_snipeit_*"
+// keys the API expects.
+func withCustomFieldValues(data []byte, values map[string]string) ([]byte, error) {
+	if len(values) == 0 {
+		return data, nil
+	}
+
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, err
+	}
+	for column, value := range values {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		body[customFieldColumn(column)] = encoded
+	}
+	return json.Marshal(body)
+}
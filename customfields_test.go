@@ -0,0 +1,123 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAssetCustomFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"id": 1,
+			"custom_fields": {
+				"MAC Address": {"field": "_snipeit_mac_address_1", "value": "00:1B:44:11:3A:B7", "field_format": "MAC", "element": "text"},
+				"RAM": {"field": "_snipeit_ram_2", "value": 16, "field_format": "NUMERIC", "element": "text"},
+				"Encrypted": {"field": "_snipeit_encrypted_3", "value": true, "field_format": "BOOLEAN", "element": "checkbox"},
+				"IMEI": {"field": "_snipeit_imei_4", "value": null, "field_format": "ANY", "element": "text"}
+			}
+		}`)
+	})
+
+	asset, _, err := client.Assets.Get(1)
+	if err != nil {
+		t.Fatalf("Assets.Get returned error: %v", err)
+	}
+
+	expected := CustomFields{
+		"MAC Address": {Value: "00:1B:44:11:3A:B7", Field: "_snipeit_mac_address_1", Format: "MAC", Element: "text"},
+		"RAM":         {Value: "16", Field: "_snipeit_ram_2", Format: "NUMERIC", Element: "text"},
+		"Encrypted":   {Value: "true", Field: "_snipeit_encrypted_3", Format: "BOOLEAN", Element: "checkbox"},
+		"IMEI":        {Value: "", Field: "_snipeit_imei_4", Format: "ANY", Element: "text"},
+	}
+	if !reflect.DeepEqual(asset.CustomFields, expected) {
+		t.Errorf("Asset.CustomFields = %+v, expected %+v", asset.CustomFields, expected)
+	}
+
+	if mac, ok := asset.CustomFields.Get("MAC Address"); !ok || mac != "00:1B:44:11:3A:B7" {
+		t.Errorf("CustomFields.Get returned %q, %v, expected the MAC address", mac, ok)
+	}
+	if _, ok := asset.CustomFields.Get("Serial"); ok {
+		t.Error("CustomFields.Get found a field the asset does not have")
+	}
+	if ram, ok := asset.CustomFields.Column("_snipeit_ram_2"); !ok || ram.Value != "16" {
+		t.Errorf("CustomFields.Column returned %+v, %v, expected the RAM field", ram, ok)
+	}
+}
+
+func TestCustomFieldsUnmarshalJSONEmpty(t *testing.T) {
+	for _, data := range []string{`[]`, `{}`, `null`} {
+		cf := CustomFields{"stale": {}}
+		if err := json.Unmarshal([]byte(data), &cf); err != nil {
+			t.Errorf("json.Unmarshal(%s) returned error: %v", data, err)
+		}
+		if cf != nil {
+			t.Errorf("json.Unmarshal(%s) = %+v, expected nil", data, cf)
+		}
+	}
+
+	var cf CustomFields
+	if err := json.Unmarshal([]byte(`[{"value": "x"}]`), &cf); err == nil {
+		t.Error("json.Unmarshal of a non-empty array returned nil error")
+	}
+}
+
+func TestAssetsCreateCustomFieldValues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["_snipeit_mac_address_1"] != "00:1B:44:11:3A:B7" || body["_snipeit_imei_4"] != "490154203237518" {
+			t.Errorf("Request body = %v, expected flattened custom field values", body)
+		}
+		if body["asset_tag"] != "AT-1" {
+			t.Errorf("Request body asset_tag = %v, expected %q", body["asset_tag"], "AT-1")
+		}
+		if _, ok := body["CustomFieldValues"]; ok {
+			t.Error("Request body contains CustomFieldValues")
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 1, "asset_tag": "AT-1"}}`)
+	})
+
+	_, _, err := client.Assets.Create(AssetCreateRequest{
+		AssetTag: "AT-1",
+		CustomFieldValues: map[string]string{
+			"_snipeit_mac_address_1": "00:1B:44:11:3A:B7",
+			"imei_4":                 "490154203237518",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Assets.Create returned error: %v", err)
+	}
+}
+
+func TestAssetsSetCustomField(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if expected := map[string]interface{}{"_snipeit_ram_2": "32"}; !reflect.DeepEqual(body, expected) {
+			t.Errorf("Request body = %v, expected %v", body, expected)
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 1}}`)
+	})
+
+	if _, _, err := client.Assets.SetCustomField(1, "ram_2", "32"); err != nil {
+		t.Fatalf("Assets.SetCustomField returned error: %v", err)
+	}
+}
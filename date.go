@@ -0,0 +1,184 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Date is a calendar date without a time of day or time zone, such as a
+// purchase or checkout date. It is sent to the API as "YYYY-MM-DD", the
+// only form Snipe-IT accepts for date fields, and unlike a time.Time it
+// names the same day in every time zone.
+//
+// The zero Date is unset: it is encoded as null and IsZero reports true.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns the date of year, month and day. Out of range values
+// are normalized as by time.Date, so NewDate(2024, 1, 32) is February 1.
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the date of t in t's own time zone.
+func DateOf(t time.Time) Date {
+	if t.IsZero() {
+		return Date{}
+	}
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// Today returns the current date in loc, or in the local time zone if loc
+// is nil.
+func Today(loc *time.Location) Date {
+	if loc == nil {
+		loc = time.Local
+	}
+	return DateOf(time.Now().In(loc))
+}
+
+// ParseDate parses a date in the "YYYY-MM-DD" form, or the date part of
+// any timestamp accepted by ParseSnipeTime, in the timestamp's own zone.
+// An empty string gives the zero Date.
+func ParseDate(value string) (Date, error) {
+	if value == "" {
+		return Date{}, nil
+	}
+	if t, err := time.Parse(snipeDateLayout, value); err == nil {
+		return DateOf(t), nil
+	}
+	st, err := ParseSnipeTime(value)
+	if err != nil {
+		return Date{}, fmt.Errorf("snipeit: cannot parse date %q", value)
+	}
+	return DateOf(st.Time), nil
+}
+
+// IsZero reports whether d is the zero Date.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// String returns d in the "YYYY-MM-DD" form, or "" for the zero Date.
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// In returns midnight at the start of d in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns d plus n days, which may be negative.
+func (d Date) AddDays(n int) Date {
+	return NewDate(d.Year, d.Month, d.Day+n)
+}
+
+// AddMonths returns d plus n months, which may be negative. As with
+// time.Time.AddDate, a day past the end of the resulting month overflows
+// into the next one.
+func (d Date) AddMonths(n int) Date {
+	return NewDate(d.Year, d.Month+time.Month(n), d.Day)
+}
+
+// Before reports whether d is before other.
+func (d Date) Before(other Date) bool {
+	return d.compare(other) < 0
+}
+
+// After reports whether d is after other.
+func (d Date) After(other Date) bool {
+	return d.compare(other) > 0
+}
+
+// DaysUntil returns the number of days from d to other, which is negative
+// if other is before d.
+func (d Date) DaysUntil(other Date) int {
+	return int(other.In(time.UTC).Sub(d.In(time.UTC)).Hours() / 24)
+}
+
+// compare returns -1, 0 or 1 as d is before, equal to or after other.
+func (d Date) compare(other Date) int {
+	switch {
+	case d.Year != other.Year:
+		return sign(d.Year - other.Year)
+	case d.Month != other.Month:
+		return sign(int(d.Month - other.Month))
+	}
+	return sign(d.Day - other.Day)
+}
+
+// sign returns -1, 0 or 1 as n is negative, zero or positive.
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
+
+// MarshalText implements encoding.TextMarshaler for Date, so that dates
+// can be used in query strings and CSV files.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for Date.
+func (d *Date) UnmarshalText(text []byte) error {
+	parsed, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for Date. The zero Date is
+// written as null.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Date. It accepts every
+// shape SnipeTime does, taking the date in the value's own zone.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var st SnipeTime
+	if err := st.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*d = DateOf(st.Time)
+	return nil
+}
+
+// snipeTimeType is the type of SnipeTime.
+var snipeTimeType = reflect.TypeOf(SnipeTime{})
+
+// localizeTimes decodes again the SnipeTime values in v, the destination
+// data was decoded into, interpreting the times data gives without a zone
+// in loc rather than UTC.
+func localizeTimes(data []byte, v interface{}, loc *time.Location) {
+	if v == nil {
+		return
+	}
+	walkJSON(bytes.TrimSpace(data), reflect.ValueOf(v), func(data []byte, v reflect.Value) {
+		if v.Type() == snipeTimeType && v.CanAddr() {
+			v.Addr().Interface().(*SnipeTime).unmarshalIn(data, loc)
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected Date
+	}{
+		{"2024-05-01", NewDate(2024, 5, 1)},
+		{"2024-05-01 23:30:00", NewDate(2024, 5, 1)},
+		{"2024-05-01T23:30:00-05:00", NewDate(2024, 5, 1)},
+		{"", Date{}},
+	}
+
+	for _, tt := range tests {
+		d, err := ParseDate(tt.value)
+		if err != nil {
+			t.Errorf("ParseDate(%q) returned error: %v", tt.value, err)
+			continue
+		}
+		if d != tt.expected {
+			t.Errorf("ParseDate(%q) = %v, expected %v", tt.value, d, tt.expected)
+		}
+	}
+
+	if _, err := ParseDate("05/01/2024"); err == nil {
+		t.Error("ParseDate of an unknown format returned nil error")
+	}
+}
+
+func TestDateArithmetic(t *testing.T) {
+	d := NewDate(2024, 1, 31)
+
+	if next := d.AddDays(1); next != NewDate(2024, 2, 1) {
+		t.Errorf("AddDays(1) = %v, expected 2024-02-01", next)
+	}
+	if next := d.AddMonths(1); next != NewDate(2024, 3, 2) {
+		t.Errorf("AddMonths(1) = %v, expected 2024-03-02", next)
+	}
+	if !d.Before(NewDate(2024, 2, 1)) || d.After(NewDate(2024, 2, 1)) {
+		t.Errorf("%v compared wrongly with 2024-02-01", d)
+	}
+	if days := d.DaysUntil(NewDate(2024, 3, 31)); days != 60 {
+		t.Errorf("DaysUntil = %d, expected %d", days, 60)
+	}
+	if days := NewDate(2024, 3, 31).DaysUntil(d); days != -60 {
+		t.Errorf("DaysUntil = %d, expected %d", days, -60)
+	}
+}
+
+func TestDateJSON(t *testing.T) {
+	var v struct {
+		String Date  `json:"string"`
+		Object Date  `json:"object"`
+		Null   *Date `json:"null"`
+	}
+	data := `{"string": "2024-05-01", "object": {"date": "2024-06-01", "formatted": "Jun 1, 2024"}, "null": null}`
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if v.String != NewDate(2024, 5, 1) || v.Object != NewDate(2024, 6, 1) || v.Null != nil {
+		t.Errorf("json.Unmarshal = %+v, expected 2024-05-01, 2024-06-01 and nil", v)
+	}
+
+	out, err := json.Marshal(CheckinOptions{CheckinAt: NewDate(2024, 6, 1)})
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	if expected := `{"checkin_at":"2024-06-01"}`; string(out) != expected {
+		t.Errorf("json.Marshal = %s, expected %s", out, expected)
+	}
+}
+
+func TestServerTimezone(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 1,
+			"purchase_date": {"date": "2024-05-01", "formatted": "May 1, 2024"},
+			"last_checkout": {"datetime": "2024-05-02 09:30:00", "formatted": "May 2, 2024 9:30AM"},
+			"created_at": "2024-05-03T10:00:00Z"}]}`)
+	})
+
+	zone := time.FixedZone("EST", -5*60*60)
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{ServerTimezone: zone})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	check := func(asset Asset) {
+		t.Helper()
+		if expected := time.Date(2024, 5, 1, 0, 0, 0, 0, zone); !asset.PurchaseDate.Equal(expected) {
+			t.Errorf("PurchaseDate = %v, expected %v", asset.PurchaseDate, expected)
+		}
+		if d := DateOf(asset.PurchaseDate.In(zone)); d != NewDate(2024, 5, 1) {
+			t.Errorf("PurchaseDate in the server zone is %v, expected 2024-05-01", d)
+		}
+		if expected := time.Date(2024, 5, 2, 9, 30, 0, 0, zone); !asset.LastCheckout.Equal(expected) {
+			t.Errorf("LastCheckout = %v, expected %v", asset.LastCheckout, expected)
+		}
+
+		// Times with a zone are left as sent
+		if expected := time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC); !asset.CreatedAt.Equal(expected) {
+			t.Errorf("CreatedAt = %v, expected %v", asset.CreatedAt, expected)
+		}
+	}
+
+	assets, _, err := client.Assets.List(nil)
+	if err != nil {
+		t.Fatalf("Assets.List returned error: %v", err)
+	}
+	check(assets.Rows[0])
+
+	// Dates still round-trip as dates
+	out, err := json.Marshal(assets.Rows[0].PurchaseDate)
+	if err != nil || string(out) != `"2024-05-01"` {
+		t.Errorf("json.Marshal(PurchaseDate) = %s, %v, expected \"2024-05-01\"", out, err)
+	}
+
+	err = client.Assets.Stream(nil, func(asset Asset) error {
+		check(asset)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Assets.Stream returned error: %v", err)
+	}
+}
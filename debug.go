@@ -0,0 +1,88 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+)
+
+// redacted replaces the value of sensitive headers in wire dumps.
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders lists the headers whose values are redacted from wire dumps.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// wireDumper writes HTTP wire dumps for debugging. Writes are serialized
+// so that dumps of concurrent requests do not interleave.
+type wireDumper struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// write writes a single dump, followed by a blank line.
+func (d *wireDumper) write(dump []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.w.Write(bytes.TrimRight(dump, "\r\n"))
+	io.WriteString(d.w, "\n\n")
+}
+
+// dumpRequest writes req, including its body, with sensitive headers redacted.
+// The body is read through GetBody, so req itself is left untouched; requests
+// without GetBody are dumped without their body.
+func (d *wireDumper) dumpRequest(req *http.Request) {
+	dumpReq := req.Clone(req.Context())
+	redactHeaders(dumpReq.Header)
+
+	withBody := req.Body == nil || req.Body == http.NoBody
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			dumpReq.Body = body
+			withBody = true
+		}
+	}
+
+	dump, err := httputil.DumpRequestOut(dumpReq, withBody)
+	if err != nil {
+		return
+	}
+	d.write(dump)
+}
+
+// dumpResponse writes resp, including its body, with sensitive headers redacted.
+// The body is buffered and resp.Body replaced so it can still be read.
+func (d *wireDumper) dumpResponse(resp *http.Response) {
+	dumpResp := *resp
+	dumpResp.Header = resp.Header.Clone()
+	redactHeaders(dumpResp.Header)
+
+	dump, err := httputil.DumpResponse(&dumpResp, true)
+	resp.Body = dumpResp.Body
+	if err != nil {
+		return
+	}
+	d.write(dump)
+}
+
+// redactHeaders replaces the values of sensitive headers in h.
+func redactHeaders(h http.Header) {
+	for _, name := range sensitiveHeaders {
+		if _, ok := h[name]; ok {
+			h.Set(name, redacted)
+		}
+	}
+}
+
+// requestDumper returns the dumper for a request, or nil if the request
+// should not be dumped. Dumping is enabled by ClientOptions.Debug or, for
+// a single request, by RequestOptions.Debug.
+func (c *Client) requestDumper(opts *RequestOptions) *wireDumper {
+	if c.debug || (opts != nil && opts.Debug) {
+		return c.dumper
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package snipeit
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClientDebugDump(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "snipeit_session", Value: "secret-session"})
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 1}}`)
+	})
+
+	var dump bytes.Buffer
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{Debug: true, DumpWriter: &dump})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	asset, _, err := client.Assets.Create(AssetCreateRequest{Name: "Laptop"})
+	if err != nil {
+		t.Fatalf("Assets.Create returned error: %v", err)
+	}
+	if asset.ID != 1 {
+		t.Errorf("Assets.Create returned ID = %d, expected %d", asset.ID, 1)
+	}
+
+	output := dump.String()
+	for _, expected := range []string{"POST /api/v1/hardware", `"name":"Laptop"`, "200 OK", `"status": "success"`, "Authorization: [REDACTED]", "Set-Cookie: [REDACTED]"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("dump does not contain %q:\n%s", expected, output)
+		}
+	}
+	for _, secret := range []string{"test-token", "secret-session"} {
+		if strings.Contains(output, secret) {
+			t.Errorf("dump contains %q:\n%s", secret, output)
+		}
+	}
+}
+
+func TestRequestOptionsDebug(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 0, "rows": []}`)
+	})
+
+	var dump bytes.Buffer
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{DumpWriter: &dump})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	req, _ := client.newRequest("GET", "api/v1/hardware", nil)
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if dump.Len() != 0 {
+		t.Errorf("Do dumped a request without Debug:\n%s", dump.String())
+	}
+
+	req, _ = client.newRequest("GET", "api/v1/hardware", nil)
+	if _, err := client.DoWithOptions(req, nil, &RequestOptions{Debug: true}); err != nil {
+		t.Fatalf("DoWithOptions returned error: %v", err)
+	}
+	if !strings.Contains(dump.String(), "GET /api/v1/hardware") {
+		t.Errorf("DoWithOptions with Debug did not dump the request:\n%s", dump.String())
+	}
+}
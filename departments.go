@@ -0,0 +1,292 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// DepartmentsService handles communication with the department-related endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/departments
+type DepartmentsService struct {
+	client *Client
+}
+
+// DepartmentResponse represents the API response for a single department.
+// The single department endpoint returns the department data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Department.
+type DepartmentResponse struct {
+	Response
+	// Payload contains the department as returned in the payload field, if any
+	Payload *Department `json:"payload,omitempty"`
+	Department
+}
+
+// UnmarshalJSON implements json.Unmarshaler for DepartmentResponse.
+func (r *DepartmentResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Department)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Department
+	}
+	return nil
+}
+
+// DepartmentsResponse represents the API response for multiple departments.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Departments.
+type DepartmentsResponse struct {
+	Response
+	// Rows contains the list of Department objects
+	Rows []Department `json:"rows"`
+}
+
+// List returns a list of departments with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/departments
+func (s *DepartmentsService) List(opts *ListOptions) (*DepartmentsResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of departments with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/departments
+func (s *DepartmentsService) ListContext(ctx context.Context, opts *ListOptions) (*DepartmentsResponse, *http.Response, error) {
+	u := "api/v1/departments"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var departments DepartmentsResponse
+	resp, err := s.client.Do(req, &departments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &departments, resp, nil
+}
+
+// Iterate returns an iterator over every department, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Department.
+func (s *DepartmentsService) Iterate(opts *ListOptions) iter.Seq2[Department, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every department with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Department.
+func (s *DepartmentsService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Department, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]Department, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single department by its ID.
+//
+// id is the unique identifier of the department to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/departmentsid
+func (s *DepartmentsService) Get(id int) (*DepartmentResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single department by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the department to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/departmentsid
+func (s *DepartmentsService) GetContext(ctx context.Context, id int) (*DepartmentResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/departments/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var department DepartmentResponse
+	resp, err := s.client.Do(req, &department)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &department, resp, nil
+}
+
+// Create creates a new department in Snipe-IT.
+//
+// department must contain the required fields:
+// - Name: The name of the department
+// - LocationID: The ID of the department's location
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/departments-1
+func (s *DepartmentsService) Create(department Department) (*DepartmentResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), department)
+}
+
+// CreateContext creates a new department in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// department must contain the required fields:
+// - Name: The name of the department
+// - LocationID: The ID of the department's location
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/departments-1
+func (s *DepartmentsService) CreateContext(ctx context.Context, department Department) (*DepartmentResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/departments", department)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response DepartmentResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing department in Snipe-IT.
+//
+// id is the unique identifier of the department to update.
+// department contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/departmentsid-2
+func (s *DepartmentsService) Update(id int, department Department) (*DepartmentResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, department)
+}
+
+// UpdateContext updates an existing department in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the department to update.
+// department contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/departmentsid-2
+func (s *DepartmentsService) UpdateContext(ctx context.Context, id int, department Department) (*DepartmentResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/departments/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, department)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response DepartmentResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a department from Snipe-IT.
+//
+// id is the unique identifier of the department to delete.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/departmentsid-1
+func (s *DepartmentsService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a department from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the department to delete.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/departmentsid-1
+func (s *DepartmentsService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/departments/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Users returns the users in a department.
+//
+// id is the unique identifier of the department.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/users
+func (s *DepartmentsService) Users(id int, opts *ListOptions) (*UsersResponse, *http.Response, error) {
+	return s.UsersContext(context.Background(), id, opts)
+}
+
+// UsersContext returns the users in a department with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the department.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/users
+func (s *DepartmentsService) UsersContext(ctx context.Context, id int, opts *ListOptions) (*UsersResponse, *http.Response, error) {
+	query := struct {
+		ListOptions
+		DepartmentID int `url:"department_id"`
+	}{DepartmentID: id}
+	if opts != nil {
+		query.ListOptions = *opts
+	}
+
+	u, err := s.client.AddOptions("api/v1/users", query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users UsersResponse
+	resp, err := s.client.Do(req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &users, resp, nil
+}
@@ -0,0 +1,134 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDepartmentsList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/departments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{
+					"id": 1,
+					"name": "Engineering",
+					"company": {"id": 2, "name": "Acme Corp"},
+					"manager": {"id": 5, "name": "Grace Hopper"},
+					"location": {"id": 3, "name": "HQ"},
+					"users_count": 42
+				}
+			]
+		}`)
+	})
+
+	departments, _, err := client.Departments.List(nil)
+	if err != nil {
+		t.Fatalf("Departments.List returned error: %v", err)
+	}
+
+	if len(departments.Rows) != 1 {
+		t.Fatalf("Departments.List returned %d departments, expected %d", len(departments.Rows), 1)
+	}
+
+	department := departments.Rows[0]
+	if department.Company == nil || department.Company.ID != 2 {
+		t.Errorf("Departments.List returned Company = %+v, expected ID %d", department.Company, 2)
+	}
+	if department.Manager == nil || department.Manager.Name != "Grace Hopper" {
+		t.Errorf("Departments.List returned Manager = %+v, expected %q", department.Manager, "Grace Hopper")
+	}
+	if department.Location == nil || department.Location.ID != 3 {
+		t.Errorf("Departments.List returned Location = %+v, expected ID %d", department.Location, 3)
+	}
+	if department.UsersCount != 42 {
+		t.Errorf("Departments.List returned UsersCount = %d, expected %d", department.UsersCount, 42)
+	}
+}
+
+func TestDepartmentsCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/departments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["manager_id"] != float64(5) {
+			t.Errorf("Request body manager_id = %v, expected %v", requestBody["manager_id"], float64(5))
+		}
+		if requestBody["location_id"] != float64(3) {
+			t.Errorf("Request body location_id = %v, expected %v", requestBody["location_id"], float64(3))
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 4, "name": "Finance"}}`)
+	})
+
+	department, _, err := client.Departments.Create(Department{
+		CommonFields: CommonFields{Name: "Finance"},
+		ManagerID:    5,
+		LocationID:   3,
+	})
+	if err != nil {
+		t.Fatalf("Departments.Create returned error: %v", err)
+	}
+
+	if department.ID != 4 {
+		t.Errorf("Departments.Create returned ID = %d, expected %d", department.ID, 4)
+	}
+}
+
+func TestDepartmentsDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/departments/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success"}`)
+	})
+
+	if _, err := client.Departments.Delete(4); err != nil {
+		t.Fatalf("Departments.Delete returned error: %v", err)
+	}
+}
+
+func TestDepartmentsUsers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if r.URL.Query().Get("department_id") != "1" {
+			t.Errorf("Request URL query parameter 'department_id' = %v, expected %v", r.URL.Query().Get("department_id"), "1")
+		}
+		if r.URL.Query().Get("limit") != "25" {
+			t.Errorf("Request URL query parameter 'limit' = %v, expected %v", r.URL.Query().Get("limit"), "25")
+		}
+
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 5, "name": "Grace Hopper", "username": "ghopper"},
+				{"id": 6, "name": "Alan Turing", "username": "aturing"}
+			]
+		}`)
+	})
+
+	users, _, err := client.Departments.Users(1, &ListOptions{Limit: 25})
+	if err != nil {
+		t.Fatalf("Departments.Users returned error: %v", err)
+	}
+
+	if len(users.Rows) != 2 || users.Rows[1].Username != "aturing" {
+		t.Errorf("Departments.Users returned %+v, expected 2 users ending with aturing", users.Rows)
+	}
+}
@@ -0,0 +1,248 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// DepreciationsService handles communication with the depreciation-related endpoints
+// of the Snipe-IT API. Depreciations are the schedules over which models
+// and licenses lose their value.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/depreciations
+type DepreciationsService struct {
+	client *Client
+}
+
+// DepreciationResponse represents the API response for a single depreciation.
+// The single depreciation endpoint returns the depreciation data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Depreciation.
+type DepreciationResponse struct {
+	Response
+	// Payload contains the depreciation as returned in the payload field, if any
+	Payload *Depreciation `json:"payload,omitempty"`
+	Depreciation
+}
+
+// UnmarshalJSON implements json.Unmarshaler for DepreciationResponse.
+func (r *DepreciationResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Depreciation)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Depreciation
+	}
+	return nil
+}
+
+// DepreciationsResponse represents the API response for multiple depreciations.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Depreciations.
+type DepreciationsResponse struct {
+	Response
+	// Rows contains the list of Depreciation objects
+	Rows []Depreciation `json:"rows"`
+}
+
+// List returns a list of depreciations with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/depreciations
+func (s *DepreciationsService) List(opts *ListOptions) (*DepreciationsResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of depreciations with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/depreciations
+func (s *DepreciationsService) ListContext(ctx context.Context, opts *ListOptions) (*DepreciationsResponse, *http.Response, error) {
+	u := "api/v1/depreciations"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var depreciations DepreciationsResponse
+	resp, err := s.client.Do(req, &depreciations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &depreciations, resp, nil
+}
+
+// Iterate returns an iterator over every depreciation, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Depreciation.
+func (s *DepreciationsService) Iterate(opts *ListOptions) iter.Seq2[Depreciation, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every depreciation with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Depreciation.
+func (s *DepreciationsService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Depreciation, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]Depreciation, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single depreciation by its ID.
+//
+// id is the unique identifier of the depreciation to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/depreciationsid
+func (s *DepreciationsService) Get(id int) (*DepreciationResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single depreciation by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the depreciation to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/depreciationsid
+func (s *DepreciationsService) GetContext(ctx context.Context, id int) (*DepreciationResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/depreciations/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var depreciation DepreciationResponse
+	resp, err := s.client.Do(req, &depreciation)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &depreciation, resp, nil
+}
+
+// Create creates a new depreciation in Snipe-IT.
+//
+// depreciation must contain the required fields:
+// - Name: The name of the depreciation
+// - Months: The number of months over which assets depreciate
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/depreciations-1
+func (s *DepreciationsService) Create(depreciation Depreciation) (*DepreciationResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), depreciation)
+}
+
+// CreateContext creates a new depreciation in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// depreciation must contain the required fields:
+// - Name: The name of the depreciation
+// - Months: The number of months over which assets depreciate
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/depreciations-1
+func (s *DepreciationsService) CreateContext(ctx context.Context, depreciation Depreciation) (*DepreciationResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/depreciations", depreciation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response DepreciationResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing depreciation in Snipe-IT.
+//
+// id is the unique identifier of the depreciation to update.
+// depreciation contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/depreciationsid-1
+func (s *DepreciationsService) Update(id int, depreciation Depreciation) (*DepreciationResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, depreciation)
+}
+
+// UpdateContext updates an existing depreciation in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the depreciation to update.
+// depreciation contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/depreciationsid-1
+func (s *DepreciationsService) UpdateContext(ctx context.Context, id int, depreciation Depreciation) (*DepreciationResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/depreciations/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, depreciation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response DepreciationResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a depreciation from Snipe-IT.
+//
+// id is the unique identifier of the depreciation to delete.
+// Snipe-IT refuses to delete depreciations that are still used by models or licenses.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/depreciationsid-2
+func (s *DepreciationsService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a depreciation from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the depreciation to delete.
+// Snipe-IT refuses to delete depreciations that are still used by models or licenses.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/depreciationsid-2
+func (s *DepreciationsService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/depreciations/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,126 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDepreciationsList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/depreciations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "Authorization", "Bearer test-token")
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 1, "name": "Computers", "months": "36 months", "depreciation_min": "100.00"},
+				{"id": 2, "name": "Furniture", "months": 84, "depreciation_min": 10, "depreciation_type": "percent"}
+			]
+		}`)
+	})
+
+	depreciations, _, err := client.Depreciations.List(nil)
+	if err != nil {
+		t.Fatalf("Depreciations.List returned error: %v", err)
+	}
+
+	if len(depreciations.Rows) != 2 {
+		t.Fatalf("Depreciations.List returned %d depreciations, expected %d", len(depreciations.Rows), 2)
+	}
+
+	computers := depreciations.Rows[0]
+	if computers.Name != "Computers" || computers.Months != 36 || computers.DepreciationMin != 100 || computers.DepreciationType != "" {
+		t.Errorf("Depreciations.List returned %+v, expected Computers over 36 months down to 100", computers)
+	}
+	furniture := depreciations.Rows[1]
+	if furniture.Months != 84 || furniture.DepreciationMin != 10 || furniture.DepreciationType != DepreciationTypePercent {
+		t.Errorf("Depreciations.List returned %+v, expected Furniture over 84 months down to 10 percent", furniture)
+	}
+}
+
+func TestDepreciationsGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/depreciations/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "name": "Computers", "months": "36 months"}`)
+	})
+
+	depreciation, _, err := client.Depreciations.Get(1)
+	if err != nil {
+		t.Fatalf("Depreciations.Get returned error: %v", err)
+	}
+
+	if depreciation.ID != 1 || depreciation.Months != 36 {
+		t.Errorf("Depreciations.Get returned %+v, expected ID 1 over 36 months", depreciation.Depreciation)
+	}
+}
+
+func TestDepreciationsCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/depreciations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["name"] != "Phones" || requestBody["months"] != float64(24) {
+			t.Errorf("Request body = %v, expected Phones over 24 months", requestBody)
+		}
+
+		fmt.Fprint(w, `{
+			"status": "success",
+			"messages": "Depreciation created successfully.",
+			"payload": {"id": 3, "name": "Phones", "months": 24}
+		}`)
+	})
+
+	depreciation, _, err := client.Depreciations.Create(Depreciation{CommonFields: CommonFields{Name: "Phones"}, Months: 24})
+	if err != nil {
+		t.Fatalf("Depreciations.Create returned error: %v", err)
+	}
+
+	if depreciation.Payload == nil || depreciation.Payload.ID != 3 {
+		t.Errorf("Depreciations.Create returned Payload = %+v, expected ID %d", depreciation.Payload, 3)
+	}
+}
+
+func TestDepreciationsUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/depreciations/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 3, "name": "Phones", "months": 30}}`)
+	})
+
+	depreciation, _, err := client.Depreciations.Update(3, Depreciation{CommonFields: CommonFields{Name: "Phones"}, Months: 30})
+	if err != nil {
+		t.Fatalf("Depreciations.Update returned error: %v", err)
+	}
+
+	if depreciation.Months != 30 {
+		t.Errorf("Depreciations.Update returned Months = %d, expected %d", depreciation.Months, 30)
+	}
+}
+
+func TestDepreciationsDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/depreciations/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success", "messages": "Depreciation deleted"}`)
+	})
+
+	if _, err := client.Depreciations.Delete(3); err != nil {
+		t.Fatalf("Depreciations.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"net/http"
+)
+
+// Option overrides a setting of a client derived with Client.With.
+type Option func(*Client)
+
+// With returns a shallow copy of the client with opts applied. The copy
+// shares the HTTP client, and so its transport and connection pool, with
+// the original, which is left unchanged.
+//
+// For example, a batch job can use an aggressive retry policy while
+// interactive requests fail fast:
+//
+//	batch := client.With(snipeit.WithRetryPolicy(policy))
+//	interactive := client.With(snipeit.WithoutRetries())
+func (c *Client) With(opts ...Option) *Client {
+	clone := *c
+	clone.headers = c.headers.Clone()
+	for _, opt := range opts {
+		opt(&clone)
+	}
+	clone.initServices()
+	return &clone
+}
+
+// WithRetryPolicy sets the retry policy of a derived client and enables
+// retries.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+		c.disableRetries = false
+	}
+}
+
+// WithoutRetries disables retries on a derived client.
+func WithoutRetries() Option {
+	return func(c *Client) {
+		c.disableRetries = true
+	}
+}
+
+// WithRateLimiter sets the rate limiter of a derived client.
+// If limiter is nil, no rate limiting is applied.
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(c *Client) {
+		c.rateLimiter = limiter
+	}
+}
+
+// WithHeaders adds headers sent with every request of a derived client,
+// replacing the client's headers of the same name.
+func WithHeaders(headers http.Header) Option {
+	return func(c *Client) {
+		if c.headers == nil {
+			c.headers = make(http.Header)
+		}
+		setHeaders(c.headers, headers)
+	}
+}
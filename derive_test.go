@@ -0,0 +1,87 @@
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClientWith(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	attempts := 0
+	var traceIDs []string
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		traceIDs = append(traceIDs, r.Header.Get("X-Trace-Id"))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	limited := 0
+	policy := DefaultRetryPolicy()
+	policy.MaxRetries = 2
+	policy.InitialBackoff = time.Millisecond
+
+	batch := client.With(
+		WithRetryPolicy(policy),
+		WithRateLimiter(RateLimiterFunc(func(ctx context.Context) error {
+			limited++
+			return nil
+		})),
+		WithHeaders(http.Header{"X-Trace-Id": {"batch"}}),
+	)
+	interactive := client.With(WithoutRetries())
+
+	if batch.Assets.client != batch || batch.client != client.client {
+		t.Error("With did not bind the services to the derived client or share the HTTP client")
+	}
+
+	batch.Assets.Get(1)
+	if attempts != 3 || limited != 3 {
+		t.Errorf("derived batch client made %d attempts and %d rate limiter calls, expected 3 and 3", attempts, limited)
+	}
+
+	attempts = 0
+	interactive.Assets.Get(1)
+	if attempts != 1 {
+		t.Errorf("derived interactive client made %d attempts, expected %d", attempts, 1)
+	}
+
+	if traceIDs[0] != "batch" || traceIDs[len(traceIDs)-1] != "" {
+		t.Errorf("server received trace IDs %q, expected the header only from the batch client", traceIDs)
+	}
+
+	if client.disableRetries || client.rateLimiter != nil || client.headers != nil {
+		t.Error("With modified the original client")
+	}
+}
+
+func TestClientWithHeadersMerge(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "X-Tenant", "acme")
+		testHeader(t, r, "X-Trace-Id", "derived")
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{
+		Headers: http.Header{"X-Tenant": {"acme"}, "X-Trace-Id": {"base"}},
+	})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	derived := client.With(WithHeaders(http.Header{"X-Trace-Id": {"derived"}}))
+	if _, _, err := derived.Assets.Get(1); err != nil {
+		t.Fatalf("Assets.Get returned error: %v", err)
+	}
+
+	if client.headers.Get("X-Trace-Id") != "base" {
+		t.Errorf("With modified the original client's headers")
+	}
+}
@@ -0,0 +1,248 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// FieldChange is a difference between two versions of an object, such as
+// a local copy of an asset and the asset in Snipe-IT.
+type FieldChange struct {
+	// Field is the JSON path of the field (e.g., "serial", "model" or
+	// "custom_fields.MAC Address")
+	Field string
+
+	// Local and Remote are the values of the field in each version, or nil
+	// if it is unset. The values of fields referencing other objects,
+	// such as "model", are the referenced objects, and those of custom
+	// fields are strings.
+	Local  interface{}
+	Remote interface{}
+}
+
+// String formats the change as the field and its remote and local values,
+// such as `serial: "SN1" -> "SN2"`.
+func (c FieldChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Field, formatChangeValue(c.Remote), formatChangeValue(c.Local))
+}
+
+// Diff returns the fields whose values differ between local and remote,
+// two versions of an Asset, User, Company or other model, in the order
+// the fields are declared in, with custom fields last in order of name.
+// It returns nil if the versions are the same.
+//
+// Fields referencing other objects, such as an asset's model and
+// location, are compared by ID, or by name where either object has no ID,
+// and assigned_to by type and ID. Times are compared as instants, and
+// custom fields by value. Fields not decoded from the API, such as
+// CommonFields.Raw, are ignored.
+//
+// For example, to review an update before applying it:
+//
+//	for _, change := range snipeit.Diff(local, remote.Asset) {
+//	    fmt.Println(change)
+//	}
+func Diff[T any](local, remote T) []FieldChange {
+	var changes []FieldChange
+	diffValues("", reflect.ValueOf(&local).Elem(), reflect.ValueOf(&remote).Elem(), &changes)
+
+	// CommonFields declares the custom fields first, but they read best
+	// after the standard fields
+	sort.SliceStable(changes, func(i, j int) bool {
+		return !strings.HasPrefix(changes[i].Field, "custom_fields.") && strings.HasPrefix(changes[j].Field, "custom_fields.")
+	})
+	return changes
+}
+
+// customFieldsType is the type of CustomFields.
+var customFieldsType = reflect.TypeOf(CustomFields{})
+
+// diffValues appends to changes the differences between local and remote,
+// the values of the field at path. Objects at the root are compared field
+// by field, while objects below it referencing other models are compared
+// as a whole.
+func diffValues(path string, local, remote reflect.Value, changes *[]FieldChange) {
+	if local.Kind() == reflect.Pointer || local.Kind() == reflect.Interface {
+		if local.IsNil() || remote.IsNil() {
+			if local.IsNil() != remote.IsNil() {
+				addChange(path, local, remote, changes)
+			}
+			return
+		}
+		diffValues(path, local.Elem(), remote.Elem(), changes)
+		return
+	}
+
+	t := local.Type()
+	switch {
+	case t == snipeTimeType:
+		if !local.Interface().(SnipeTime).Equal(remote.Interface().(SnipeTime).Time) {
+			addChange(path, local, remote, changes)
+		}
+	case t == assignedToType:
+		l, r := local.Addr().Interface().(*AssignedTo), remote.Addr().Interface().(*AssignedTo)
+		if l.Kind() != r.Kind() || l.ID() != r.ID() {
+			addChange(path, local, remote, changes)
+		}
+	case t == customFieldsType:
+		diffCustomFields(path, local.Interface().(CustomFields), remote.Interface().(CustomFields), changes)
+	case t.Kind() == reflect.Struct && path != "" && isReference(t):
+		if !sameReference(local, remote) {
+			addChange(path, local, remote, changes)
+		}
+	case t.Kind() == reflect.Struct && !reflect.PointerTo(t).Implements(unmarshalerType):
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Anonymous && f.Type.Kind() == reflect.Struct && f.Tag.Get("json") == "" {
+				diffValues(path, local.Field(i), remote.Field(i), changes)
+				continue
+			}
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if !f.IsExported() || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			diffValues(joinPath(path, name), local.Field(i), remote.Field(i), changes)
+		}
+	case t.Kind() == reflect.Map && t.Key().Kind() == reflect.String:
+		for _, key := range mapKeys(local, remote) {
+			l, r := local.MapIndex(reflect.ValueOf(key).Convert(t.Key())), remote.MapIndex(reflect.ValueOf(key).Convert(t.Key()))
+			if !l.IsValid() || !r.IsValid() {
+				if l.IsValid() != r.IsValid() {
+					addChange(joinPath(path, key), l, r, changes)
+				}
+				continue
+			}
+			diffValues(joinPath(path, key), l, r, changes)
+		}
+	default:
+		if !reflect.DeepEqual(local.Interface(), remote.Interface()) {
+			addChange(path, local, remote, changes)
+		}
+	}
+}
+
+// diffCustomFields appends to changes the custom fields, at path, whose
+// values differ between local and remote.
+func diffCustomFields(path string, local, remote CustomFields, changes *[]FieldChange) {
+	for _, name := range mapKeys(reflect.ValueOf(local), reflect.ValueOf(remote)) {
+		l, lok := local[name]
+		r, rok := remote[name]
+		if lok == rok && l.Value == r.Value {
+			continue
+		}
+		change := FieldChange{Field: joinPath(path, name)}
+		if lok {
+			change.Local = l.Value
+		}
+		if rok {
+			change.Remote = r.Value
+		}
+		*changes = append(*changes, change)
+	}
+}
+
+// addChange appends the change of the field at path from remote to local.
+func addChange(path string, local, remote reflect.Value, changes *[]FieldChange) {
+	*changes = append(*changes, FieldChange{Field: path, Local: changeValue(local), Remote: changeValue(remote)})
+}
+
+// changeValue returns the value of a field for a FieldChange: the value
+// pointed to, or nil if it is unset.
+func changeValue(v reflect.Value) interface{} {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Type() == assignedToType && v.CanAddr() {
+		return v.Addr().Interface()
+	}
+	return v.Interface()
+}
+
+// isReference reports whether the struct type t is a model, which a field
+// of another model references.
+func isReference(t reflect.Type) bool {
+	return commonFieldsIndex(t) >= 0
+}
+
+// sameReference reports whether local and remote, models of the same
+// type, are the same object: by ID if both have one, and by name
+// otherwise.
+func sameReference(local, remote reflect.Value) bool {
+	i := commonFieldsIndex(local.Type())
+	l := local.Field(i).Interface().(CommonFields)
+	r := remote.Field(i).Interface().(CommonFields)
+	if l.ID != 0 && r.ID != 0 {
+		return l.ID == r.ID
+	}
+	return l.Name == r.Name
+}
+
+// commonFieldsIndex returns the index of the CommonFields embedded by the
+// struct type t.
+func commonFieldsIndex(t reflect.Type) int {
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.Anonymous && f.Type == commonFieldsType {
+			return i
+		}
+	}
+	return -1
+}
+
+// mapKeys returns the keys of the maps local and remote, sorted.
+func mapKeys(local, remote reflect.Value) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, m := range []reflect.Value{local, remote} {
+		iter := m.MapRange()
+		for iter.Next() {
+			key := iter.Key().String()
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// formatChangeValue formats the value of a field for FieldChange.String.
+func formatChangeValue(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return fmt.Sprintf("%q", value)
+	case SnipeTime:
+		if value.IsZero() {
+			return "null"
+		}
+		return value.Format("2006-01-02 15:04:05")
+	case *AssignedTo:
+		return fmt.Sprintf("%s %q (%d)", value.Kind(), value.Name(), value.ID())
+	case fmt.Stringer:
+		return value.String()
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.String {
+		return fmt.Sprintf("%q", v.String())
+	}
+	if v.Kind() == reflect.Struct && isReference(v.Type()) {
+		common := v.Field(commonFieldsIndex(v.Type())).Interface().(CommonFields)
+		return fmt.Sprintf("%q (%d)", common.Name, common.ID)
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,85 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	var remote Asset
+	err := json.Unmarshal([]byte(`{
+		"id": 1, "name": "Laptop", "asset_tag": "AT-1", "serial": "SN1",
+		"model": {"id": 7, "name": "Latitude 5520"},
+		"location": {"id": 4, "name": "HQ"},
+		"assigned_to": {"id": 3, "username": "alice", "name": "Alice Smith", "type": "user"},
+		"purchase_date": {"date": "2024-03-01", "formatted": "2024-03-01"},
+		"purchase_cost": "1299.50",
+		"custom_fields": {
+			"MAC Address": {"field": "_snipeit_mac_address_1", "value": "00:1B:44:11:3A:B7"},
+			"RAM": {"field": "_snipeit_ram_2", "value": "16"}
+		}
+	}`), &remote)
+	if err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	local := remote
+	local.Raw = json.RawMessage(`{}`)
+	local.Serial = "SN2"
+	local.Model = Model{CommonFields: CommonFields{ID: 8, Name: "ThinkPad X1"}}
+	local.Location = &Location{CommonFields: CommonFields{ID: 4, Name: "Headquarters"}}
+	local.AssignedTo = nil
+	local.PurchaseDate = &SnipeTime{time.Date(2024, 3, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600))}
+	local.CustomFields = CustomFields{
+		"MAC Address": {Field: "_snipeit_mac_address_1", Value: "00:1B:44:11:3A:B7"},
+		"RAM":         {Field: "_snipeit_ram_2", Value: "32"},
+		"IMEI":        {Field: "_snipeit_imei_3", Value: "3568"},
+	}
+
+	changes := Diff(local, remote)
+
+	var fields []string
+	for _, change := range changes {
+		fields = append(fields, change.String())
+	}
+	expected := []string{
+		`serial: "SN1" -> "SN2"`,
+		`model: "Latitude 5520" (7) -> "ThinkPad X1" (8)`,
+		`assigned_to: user "Alice Smith" (3) -> null`,
+		`custom_fields.IMEI: null -> "3568"`,
+		`custom_fields.RAM: "16" -> "32"`,
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("Diff returned %q, expected %q", fields, expected)
+	}
+
+	if changes[1].Local.(Model).ID != 8 || changes[1].Remote.(Model).ID != 7 {
+		t.Errorf("Diff returned model change %+v, expected the local and remote models", changes[1])
+	}
+}
+
+func TestDiffSame(t *testing.T) {
+	asset := Asset{AssetTag: "AT-1", PurchaseCost: &Money{Cents: 100}}
+	other := asset
+	other.PurchaseCost = &Money{Cents: 100}
+
+	if changes := Diff(asset, other); changes != nil {
+		t.Errorf("Diff returned %v, expected no changes", changes)
+	}
+}
+
+func TestDiffUser(t *testing.T) {
+	local := &User{Username: "alice", Permissions: Permissions{"admin": "1"}}
+	remote := &User{Username: "alice", Permissions: Permissions{"admin": "0", "superuser": "0"}, VIP: true}
+
+	expected := []FieldChange{
+		{Field: "permissions.admin", Local: "1", Remote: "0"},
+		{Field: "permissions.superuser", Local: nil, Remote: "0"},
+		{Field: "vip", Local: Bool(false), Remote: Bool(true)},
+	}
+	if changes := Diff(local, remote); !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Diff returned %+v, expected %+v", changes, expected)
+	}
+}
@@ -0,0 +1,186 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import "strings"
+
+// StatusType is the type of a status label, which decides whether assets
+// with the label can be checked out.
+type StatusType string
+
+// Status label types.
+const (
+	// StatusTypeDeployable assets can be checked out
+	StatusTypeDeployable StatusType = "deployable"
+
+	// StatusTypePending assets are not yet ready to be checked out
+	StatusTypePending StatusType = "pending"
+
+	// StatusTypeUndeployable assets cannot be checked out, such as assets
+	// out for repair
+	StatusTypeUndeployable StatusType = "undeployable"
+
+	// StatusTypeArchived assets are retired and hidden from most lists
+	StatusTypeArchived StatusType = "archived"
+)
+
+// Valid reports whether t is one of the status types Snipe-IT knows.
+func (t StatusType) Valid() bool {
+	switch t.normalize() {
+	case StatusTypeDeployable, StatusTypePending, StatusTypeUndeployable, StatusTypeArchived:
+		return true
+	}
+	return false
+}
+
+// Is reports whether t is other, ignoring case.
+func (t StatusType) Is(other StatusType) bool {
+	return t.normalize() == other.normalize()
+}
+
+// normalize returns t in lower case.
+func (t StatusType) normalize() StatusType {
+	return StatusType(strings.ToLower(strings.TrimSpace(string(t))))
+}
+
+// StatusMeta is the state Snipe-IT reports for an asset's status label,
+// which refines the label's type with whether the asset is checked out.
+type StatusMeta string
+
+// Status label meta states.
+const (
+	// StatusMetaDeployable assets can be checked out and are not
+	StatusMetaDeployable StatusMeta = "deployable"
+
+	// StatusMetaDeployed assets have a deployable label and are checked out
+	StatusMetaDeployed StatusMeta = "deployed"
+
+	// StatusMetaPending assets have a pending label
+	StatusMetaPending StatusMeta = "pending"
+
+	// StatusMetaUndeployable assets have an undeployable label
+	StatusMetaUndeployable StatusMeta = "undeployable"
+
+	// StatusMetaArchived assets have an archived label
+	StatusMetaArchived StatusMeta = "archived"
+)
+
+// Is reports whether m is other, ignoring case.
+func (m StatusMeta) Is(other StatusMeta) bool {
+	return strings.EqualFold(strings.TrimSpace(string(m)), string(other))
+}
+
+// CategoryType is the kind of item a category holds.
+type CategoryType string
+
+// Category types.
+const (
+	CategoryTypeAsset      CategoryType = "asset"
+	CategoryTypeAccessory  CategoryType = "accessory"
+	CategoryTypeConsumable CategoryType = "consumable"
+	CategoryTypeComponent  CategoryType = "component"
+	CategoryTypeLicense    CategoryType = "license"
+)
+
+// Valid reports whether t is one of the category types Snipe-IT knows.
+// The API reports types capitalized ("License"), so case is ignored.
+func (t CategoryType) Valid() bool {
+	switch t.normalize() {
+	case CategoryTypeAsset, CategoryTypeAccessory, CategoryTypeConsumable, CategoryTypeComponent, CategoryTypeLicense:
+		return true
+	}
+	return false
+}
+
+// Is reports whether t is other, ignoring case.
+func (t CategoryType) Is(other CategoryType) bool {
+	return t.normalize() == other.normalize()
+}
+
+// normalize returns t in lower case.
+func (t CategoryType) normalize() CategoryType {
+	return CategoryType(strings.ToLower(strings.TrimSpace(string(t))))
+}
+
+// DepreciationType is how the minimum value of a depreciation is expressed.
+type DepreciationType string
+
+// Depreciation types.
+const (
+	// DepreciationTypeAmount minimums are amounts of money
+	DepreciationTypeAmount DepreciationType = "amount"
+
+	// DepreciationTypePercent minimums are percentages of the purchase cost
+	DepreciationTypePercent DepreciationType = "percent"
+)
+
+// AssignedType is the type of entity an asset is checked out to.
+type AssignedType string
+
+// Assigned types.
+const (
+	AssignedTypeUser     AssignedType = "user"
+	AssignedTypeLocation AssignedType = "location"
+	AssignedTypeAsset    AssignedType = "asset"
+)
+
+// Valid reports whether t is one of the assigned types Snipe-IT knows.
+func (t AssignedType) Valid() bool {
+	switch t.Kind() {
+	case AssignedTypeUser, AssignedTypeLocation, AssignedTypeAsset:
+		return true
+	}
+	return false
+}
+
+// Is reports whether t is other, ignoring case and the model class form
+// ("App\\Models\\User") some endpoints report.
+func (t AssignedType) Is(other AssignedType) bool {
+	return t.Kind() == other.Kind()
+}
+
+// Kind returns t as one of the AssignedType constants, converting the
+// model class form ("App\\Models\\User") some endpoints report. An empty
+// type is returned unchanged.
+func (t AssignedType) Kind() AssignedType {
+	s := strings.TrimSpace(string(t))
+	if i := strings.LastIndex(s, `\`); i >= 0 {
+		s = s[i+1:]
+	}
+	return AssignedType(strings.ToLower(s))
+}
+
+// IsDeployable reports whether assets with the label can be checked out.
+func (s StatusLabel) IsDeployable() bool {
+	return s.statusType().Is(StatusTypeDeployable)
+}
+
+// IsPending reports whether the label is for assets not yet ready to be
+// checked out.
+func (s StatusLabel) IsPending() bool {
+	return s.statusType().Is(StatusTypePending)
+}
+
+// IsUndeployable reports whether assets with the label cannot be checked out.
+func (s StatusLabel) IsUndeployable() bool {
+	return s.statusType().Is(StatusTypeUndeployable)
+}
+
+// IsArchived reports whether the label is for retired assets.
+func (s StatusLabel) IsArchived() bool {
+	return s.statusType().Is(StatusTypeArchived)
+}
+
+// IsDeployed reports whether the asset the label was reported for is
+// checked out. Only labels nested in an asset carry this state.
+func (s StatusLabel) IsDeployed() bool {
+	return s.StatusMeta.Is(StatusMetaDeployed)
+}
+
+// statusType returns the label's type, which the API reports as type for
+// status labels and as status_type for labels nested in assets.
+func (s StatusLabel) statusType() StatusType {
+	if s.Type != "" {
+		return s.Type
+	}
+	return s.StatusType
+}
@@ -0,0 +1,42 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatusLabelHelpers(t *testing.T) {
+	var asset Asset
+	data := `{"id": 1, "status_label": {"id": 2, "name": "Deployed", "status_type": "deployable", "status_meta": "deployed"}}`
+	if err := json.Unmarshal([]byte(data), &asset); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	label := asset.StatusLabel
+	if !label.IsDeployable() || !label.IsDeployed() || label.IsArchived() || label.IsPending() || label.IsUndeployable() {
+		t.Errorf("StatusLabel %+v reported the wrong state", label)
+	}
+
+	archived := StatusLabel{Type: "Archived"}
+	if !archived.IsArchived() || archived.IsDeployable() {
+		t.Errorf("StatusLabel %+v IsArchived = %v, expected true", archived, archived.IsArchived())
+	}
+}
+
+func TestEnumValid(t *testing.T) {
+	if !StatusTypePending.Valid() || StatusType("ready").Valid() {
+		t.Error("StatusType.Valid accepted or rejected the wrong types")
+	}
+	if !CategoryType("License").Valid() || CategoryType("licence").Valid() {
+		t.Error("CategoryType.Valid accepted or rejected the wrong types")
+	}
+	if !CategoryType("License").Is(CategoryTypeLicense) {
+		t.Errorf("CategoryType(%q).Is(%q) = false, expected true", "License", CategoryTypeLicense)
+	}
+	if !AssignedType(`App\Models\Location`).Is(AssignedTypeLocation) || !AssignedType(`App\Models\Asset`).Valid() {
+		t.Errorf("AssignedType did not accept the model class form")
+	}
+	if AssignedType("component").Valid() {
+		t.Errorf("AssignedType(%q).Valid() = true, expected false", "component")
+	}
+}
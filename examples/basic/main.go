@@ -2,52 +2,41 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
-	"os"
 	"time"
 
 	"github.com/michellepellon/go-snipeit"
 )
 
 func main() {
-	// Get Snipe-IT URL and API token from environment variables
-	snipeURL := os.Getenv("SNIPEIT_URL")
-	apiToken := os.Getenv("SNIPEIT_API_TOKEN")
-
-	if snipeURL == "" || apiToken == "" {
-		log.Fatal("SNIPEIT_URL and SNIPEIT_API_TOKEN environment variables must be set")
-	}
-
-	// Create a new client with rate limiting and retry capabilities
-	client, err := snipeit.NewClientWithOptions(
-		snipeURL,
-		apiToken,
-		&snipeit.ClientOptions{
-			// Enable rate limiting - 5 requests per second with burst of 10
-			RateLimiter: snipeit.NewTokenBucketRateLimiter(5, 10),
-			
-			// Custom retry policy
-			RetryPolicy: &snipeit.RetryPolicy{
-				MaxRetries:          3,
-				RetryableStatusCodes: map[int]bool{
-					429: true, // Too Many Requests
-					500: true, // Internal Server Error
-					502: true, // Bad Gateway
-					503: true, // Service Unavailable
-					504: true, // Gateway Timeout
-				},
-				InitialBackoff:    500 * time.Millisecond,
-				MaxBackoff:        10 * time.Second,
-				BackoffMultiplier: 2.0,
-				Jitter:            0.2,
-			},
-		},
-	)
+	// Create a client from the SNIPEIT_URL and SNIPEIT_API_TOKEN environment
+	// variables. SNIPEIT_RATE_LIMIT, SNIPEIT_RATE_BURST, SNIPEIT_MAX_RETRIES and
+	// SNIPEIT_TIMEOUT optionally configure rate limiting, retries and timeouts.
+	client, err := snipeit.NewClientFromEnv()
 	if err != nil {
 		log.Fatalf("Error creating client: %v", err)
 	}
 	
+	// Example of configuring rate limiting and retries in code:
+	// client, err := snipeit.NewClientWithOptions(snipeURL, apiToken, &snipeit.ClientOptions{
+	//     // Enable rate limiting - 5 requests per second with burst of 10
+	//     RateLimiter: snipeit.NewTokenBucketRateLimiter(5, 10),
+	//     // Custom retry policy
+	//     RetryPolicy: &snipeit.RetryPolicy{
+	//         MaxRetries:           3,
+	//         RetryableStatusCodes: map[int]bool{429: true, 500: true, 502: true, 503: true, 504: true},
+	//         InitialBackoff:       500 * time.Millisecond,
+	//         MaxBackoff:           10 * time.Second,
+	//         BackoffMultiplier:    2.0,
+	//         Jitter:               0.2,
+	//     },
+	// })
+	
+	// Example of loading a named instance from a JSON config file:
+	// client, err := snipeit.NewClientFromFile("snipeit.json", "production")
+	
 	// Example of using a basic client without rate limiting or retries:
 	// client, err := snipeit.NewClient(snipeURL, apiToken)
 	// if err != nil {
@@ -104,8 +93,8 @@ func main() {
 		fmt.Printf("  Category: %s\n", asset.Category.Name)
 		fmt.Printf("  Status: %s\n", asset.StatusLabel.Name)
 		
-		if asset.User != nil {
-			fmt.Printf("  Assigned to: %s\n", asset.User.Name)
+		if asset.AssignedTo != nil {
+			fmt.Printf("  Assigned to: %s (%s)\n", asset.AssignedTo.Name(), asset.AssignedTo.Kind())
 		} else {
 			fmt.Printf("  Assigned to: Not assigned\n")
 		}
@@ -115,10 +104,10 @@ func main() {
 	fmt.Printf("\nSearching for asset by serial number...\n")
 	// Note: Replace "DWDFN73" with an actual serial number from your Snipe-IT instance
 	serialToSearch := "DWDFN73"
-	assetsBySerial, resp, err := client.Assets.GetAssetBySerial(serialToSearch)
+	assetsBySerial, _, err := client.Assets.GetAssetBySerial(serialToSearch)
 	if err != nil {
 		// Check if it's a 404 not found error
-		if resp != nil && resp.StatusCode == 404 {
+		if errors.Is(err, snipeit.ErrNotFound) {
 			fmt.Printf("Asset with serial number %s not found\n", serialToSearch)
 		} else {
 			fmt.Printf("Error searching for asset by serial: %v\n", err)
@@ -0,0 +1,215 @@
+// Package export writes the Snipe-IT asset inventory to CSV or JSON.
+//
+// Assets are streamed page by page from the API to the writer, so that
+// inventories of any size are exported without being held in memory.
+//
+// Usage:
+//
+//	file, _ := os.Create("inventory.csv")
+//	defer file.Close()
+//	err := export.Assets(ctx, client, file, export.ExportOptions{
+//	    Format: export.CSV,
+//	    Fields: []string{"asset_tag", "name", "model", "assigned_to", "MAC Address"},
+//	})
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// Format is the format of an export.
+type Format int
+
+const (
+	// CSV writes a header row with the field names, then one row per asset.
+	CSV Format = iota
+
+	// JSON writes an array with one object per asset.
+	JSON
+)
+
+// DefaultFields are the fields exported when ExportOptions.Fields is nil,
+// followed by every custom field.
+var DefaultFields = []string{
+	"id", "asset_tag", "name", "serial", "model", "model_number", "category",
+	"manufacturer", "status", "location", "assigned_to", "assigned_type",
+	"company", "supplier", "order_number", "purchase_date", "purchase_cost",
+	"warranty_months", "notes", "created_at", "updated_at",
+}
+
+// ExportOptions configures an export.
+type ExportOptions struct {
+	// Format of the export. Default: CSV.
+	Format Format
+
+	// Fields are the fields to export, in order: the names of standard
+	// fields (see DefaultFields and Fields for the full list), or the
+	// names (e.g., "MAC Address") or database columns
+	// (e.g., "_snipeit_mac_address_1") of custom fields. Assets without a
+	// custom field export it as empty.
+	//
+	// If nil, DefaultFields and every custom field defined in Snipe-IT
+	// are exported; for JSON, each asset is then written whole, as the
+	// API returns it.
+	Fields []string
+
+	// List filters and sorts the assets on the server, such as by search
+	// term. Its pagination fields are managed by the export.
+	List *snipeit.ListOptions
+
+	// Filter, if set, is called with each asset, which is exported only if
+	// it returns true.
+	Filter func(asset snipeit.Asset) bool
+}
+
+// Assets writes every asset to w in the format of opts.
+//
+// The export stops at the first error, from the API or from w; what was
+// written so far is left in w. Output is buffered and flushed before
+// Assets returns.
+func Assets(ctx context.Context, client *snipeit.Client, w io.Writer, opts ExportOptions) error {
+	fields := opts.Fields
+	whole := fields == nil && opts.Format == JSON
+	if fields == nil && !whole {
+		custom, err := customFieldNames(ctx, client)
+		if err != nil {
+			return err
+		}
+		fields = append(append([]string(nil), DefaultFields...), custom...)
+	}
+
+	var out writer
+	switch opts.Format {
+	case CSV:
+		out = newCSVWriter(w, fields)
+	case JSON:
+		out = newJSONWriter(w, fields, whole)
+	default:
+		return fmt.Errorf("export: unknown format %d", opts.Format)
+	}
+
+	if err := out.begin(); err != nil {
+		return err
+	}
+	err := client.Assets.StreamContext(ctx, opts.List, func(asset snipeit.Asset) error {
+		if opts.Filter != nil && !opts.Filter(asset) {
+			return nil
+		}
+		return out.write(asset)
+	})
+	if err != nil {
+		return err
+	}
+	return out.end()
+}
+
+// customFieldNames returns the names of the custom fields defined in
+// Snipe-IT.
+func customFieldNames(ctx context.Context, client *snipeit.Client) ([]string, error) {
+	var names []string
+	for field, err := range client.Fields.IterateContext(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, field.Name)
+	}
+	return names, nil
+}
+
+// writer writes assets in a format.
+type writer interface {
+	begin() error
+	write(asset snipeit.Asset) error
+	end() error
+}
+
+// csvWriter writes assets as CSV rows.
+type csvWriter struct {
+	w      *csv.Writer
+	fields []string
+	row    []string
+}
+
+func newCSVWriter(w io.Writer, fields []string) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w), fields: fields, row: make([]string, len(fields))}
+}
+
+func (c *csvWriter) begin() error {
+	return c.w.Write(c.fields)
+}
+
+func (c *csvWriter) write(asset snipeit.Asset) error {
+	for i, field := range c.fields {
+		c.row[i] = Value(asset, field)
+	}
+	return c.w.Write(c.row)
+}
+
+func (c *csvWriter) end() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonWriter writes assets as the elements of a JSON array, either whole
+// or as objects holding the selected fields in order.
+type jsonWriter struct {
+	w      *bufio.Writer
+	fields []string
+	whole  bool
+	count  int
+}
+
+func newJSONWriter(w io.Writer, fields []string, whole bool) *jsonWriter {
+	return &jsonWriter{w: bufio.NewWriter(w), fields: fields, whole: whole}
+}
+
+func (j *jsonWriter) begin() error {
+	_, err := j.w.WriteString("[")
+	return err
+}
+
+func (j *jsonWriter) write(asset snipeit.Asset) error {
+	if j.count > 0 {
+		j.w.WriteString(",")
+	}
+	j.w.WriteString("\n")
+	j.count++
+
+	if j.whole {
+		data, err := json.Marshal(asset)
+		if err != nil {
+			return err
+		}
+		_, err = j.w.Write(data)
+		return err
+	}
+
+	j.w.WriteString("{")
+	for i, field := range j.fields {
+		if i > 0 {
+			j.w.WriteString(",")
+		}
+		key, _ := json.Marshal(field)
+		value, _ := json.Marshal(Value(asset, field))
+		j.w.Write(key)
+		j.w.WriteString(":")
+		j.w.Write(value)
+	}
+	_, err := j.w.WriteString("}")
+	return err
+}
+
+func (j *jsonWriter) end() error {
+	if j.count > 0 {
+		j.w.WriteString("\n")
+	}
+	j.w.WriteString("]\n")
+	return j.w.Flush()
+}
@@ -0,0 +1,207 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// assetRows are the assets served by the test server, one per page.
+var assetRows = []string{
+	`{
+		"id": 1, "asset_tag": "AT-1", "name": "Alice's laptop", "serial": "SN1",
+		"model": {"id": 7, "name": "Latitude 5520"},
+		"status_label": {"id": 2, "name": "Deployed", "status_meta": "deployed"},
+		"assigned_to": {"id": 3, "username": "alice", "name": "Alice Smith", "type": "user"},
+		"purchase_date": {"date": "2024-03-01", "formatted": "2024-03-01"},
+		"purchase_cost": "1,299.50",
+		"created_at": {"datetime": "2024-03-02 09:30:00", "formatted": "2024-03-02 09:30AM"},
+		"custom_fields": {"MAC Address": {"field": "_snipeit_mac_address_1", "value": "00:1B:44:11:3A:B7"}}
+	}`,
+	`{
+		"id": 2, "asset_tag": "AT-2", "name": "Spare, unassigned",
+		"model": {"id": 8, "name": "ThinkPad X1"},
+		"status_label": {"id": 1, "name": "Ready to Deploy"}
+	}`,
+}
+
+func setup(t *testing.T) *snipeit.Client {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		var offset int
+		fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+		if offset >= len(assetRows) {
+			fmt.Fprintf(w, `{"total": %d, "rows": []}`, len(assetRows))
+			return
+		}
+		fmt.Fprintf(w, `{"total": %d, "rows": [%s]}`, len(assetRows), assetRows[offset])
+	})
+	mux.HandleFunc("/api/v1/fields", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 1, "name": "MAC Address", "db_column_name": "_snipeit_mac_address_1"}]}`)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := snipeit.NewClient(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	return client
+}
+
+func TestAssetsCSV(t *testing.T) {
+	client := setup(t)
+
+	var out bytes.Buffer
+	err := Assets(context.Background(), client, &out, ExportOptions{
+		Fields: []string{"asset_tag", "name", "model", "status", "assigned_to", "purchase_date", "purchase_cost", "created_at", "MAC Address"},
+		List:   &snipeit.ListOptions{Limit: 1},
+	})
+	if err != nil {
+		t.Fatalf("Assets returned error: %v", err)
+	}
+
+	expected := "asset_tag,name,model,status,assigned_to,purchase_date,purchase_cost,created_at,MAC Address\n" +
+		"AT-1,Alice's laptop,Latitude 5520,Deployed,Alice Smith,2024-03-01,1299.50,2024-03-02 09:30:00,00:1B:44:11:3A:B7\n" +
+		"AT-2,\"Spare, unassigned\",ThinkPad X1,Ready to Deploy,,,,,\n"
+	if out.String() != expected {
+		t.Errorf("Assets wrote\n%s\nexpected\n%s", out.String(), expected)
+	}
+}
+
+func TestAssetsCSVDefaultFields(t *testing.T) {
+	client := setup(t)
+
+	var out bytes.Buffer
+	if err := Assets(context.Background(), client, &out, ExportOptions{}); err != nil {
+		t.Fatalf("Assets returned error: %v", err)
+	}
+
+	header, _, _ := strings.Cut(out.String(), "\n")
+	expected := strings.Join(DefaultFields, ",") + ",MAC Address"
+	if header != expected {
+		t.Errorf("Assets wrote header %q, expected %q", header, expected)
+	}
+
+	if lines := strings.Count(out.String(), "\n"); lines != 3 {
+		t.Errorf("Assets wrote %d lines, expected %d", lines, 3)
+	}
+}
+
+func TestAssetsJSON(t *testing.T) {
+	client := setup(t)
+
+	var out bytes.Buffer
+	err := Assets(context.Background(), client, &out, ExportOptions{
+		Format: JSON,
+		Fields: []string{"asset_tag", "_snipeit_mac_address_1"},
+	})
+	if err != nil {
+		t.Fatalf("Assets returned error: %v", err)
+	}
+
+	expected := "[\n" +
+		`{"asset_tag":"AT-1","_snipeit_mac_address_1":"00:1B:44:11:3A:B7"},` + "\n" +
+		`{"asset_tag":"AT-2","_snipeit_mac_address_1":""}` + "\n]\n"
+	if out.String() != expected {
+		t.Errorf("Assets wrote\n%s\nexpected\n%s", out.String(), expected)
+	}
+}
+
+func TestAssetsJSONWhole(t *testing.T) {
+	client := setup(t)
+
+	var out bytes.Buffer
+	if err := Assets(context.Background(), client, &out, ExportOptions{Format: JSON}); err != nil {
+		t.Fatalf("Assets returned error: %v", err)
+	}
+
+	var assets []snipeit.Asset
+	if err := json.Unmarshal(out.Bytes(), &assets); err != nil {
+		t.Fatalf("Assets wrote invalid JSON: %v\n%s", err, out.String())
+	}
+
+	if len(assets) != 2 || assets[0].AssetTag != "AT-1" || assets[1].AssetTag != "AT-2" {
+		t.Fatalf("Assets wrote %+v, expected assets AT-1 and AT-2", assets)
+	}
+
+	if mac, _ := assets[0].CustomFields.Get("MAC Address"); mac != "00:1B:44:11:3A:B7" {
+		t.Errorf("Assets wrote MAC Address %q, expected %q", mac, "00:1B:44:11:3A:B7")
+	}
+}
+
+func TestAssetsJSONEmpty(t *testing.T) {
+	client := setup(t)
+
+	var out bytes.Buffer
+	err := Assets(context.Background(), client, &out, ExportOptions{
+		Format: JSON,
+		Filter: func(snipeit.Asset) bool { return false },
+	})
+	if err != nil {
+		t.Fatalf("Assets returned error: %v", err)
+	}
+
+	if out.String() != "[]\n" {
+		t.Errorf("Assets wrote %q, expected %q", out.String(), "[]\n")
+	}
+}
+
+func TestAssetsFilter(t *testing.T) {
+	client := setup(t)
+
+	var out bytes.Buffer
+	err := Assets(context.Background(), client, &out, ExportOptions{
+		Fields: []string{"asset_tag"},
+		Filter: func(asset snipeit.Asset) bool { return asset.AssignedTo == nil },
+	})
+	if err != nil {
+		t.Fatalf("Assets returned error: %v", err)
+	}
+
+	if out.String() != "asset_tag\nAT-2\n" {
+		t.Errorf("Assets wrote %q, expected %q", out.String(), "asset_tag\nAT-2\n")
+	}
+}
+
+func TestValue(t *testing.T) {
+	asset := snipeit.Asset{AssetTag: "AT-5"}
+	asset.ID = 5
+	asset.CustomFields = snipeit.CustomFields{"RAM": {Field: "_snipeit_ram_2", Value: "16"}}
+
+	tests := []struct {
+		field    string
+		expected string
+	}{
+		{"id", "5"},
+		{"asset_tag", "AT-5"},
+		{"location", ""},
+		{"warranty_months", ""},
+		{"RAM", "16"},
+		{"_snipeit_ram_2", "16"},
+		{"Unknown", ""},
+	}
+
+	for _, tt := range tests {
+		if value := Value(asset, tt.field); value != tt.expected {
+			t.Errorf("Value(%q) = %q, expected %q", tt.field, value, tt.expected)
+		}
+	}
+}
+
+func TestAssetsUnknownFormat(t *testing.T) {
+	client := setup(t)
+
+	err := Assets(context.Background(), client, &bytes.Buffer{}, ExportOptions{Format: Format(9), Fields: []string{"id"}})
+	if err == nil {
+		t.Error("Assets returned no error for an unknown format")
+	}
+}
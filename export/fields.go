@@ -0,0 +1,142 @@
+package export
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// dateTimeLayout is the layout of exported timestamps.
+const dateTimeLayout = "2006-01-02 15:04:05"
+
+// standardFields maps the names of standard fields to functions returning
+// their value for an asset.
+var standardFields = map[string]func(a snipeit.Asset) string{
+	"id":               func(a snipeit.Asset) string { return strconv.Itoa(a.ID) },
+	"asset_tag":        func(a snipeit.Asset) string { return a.AssetTag },
+	"name":             func(a snipeit.Asset) string { return a.Name },
+	"serial":           func(a snipeit.Asset) string { return a.Serial },
+	"model":            func(a snipeit.Asset) string { return a.Model.Name },
+	"model_id":         func(a snipeit.Asset) string { return id(a.Model.ID) },
+	"model_number":     func(a snipeit.Asset) string { return a.ModelNumber },
+	"category":         func(a snipeit.Asset) string { return a.Category.Name },
+	"manufacturer":     func(a snipeit.Asset) string { return a.Manufacturer.Name },
+	"status":           func(a snipeit.Asset) string { return a.StatusLabel.Name },
+	"status_id":        func(a snipeit.Asset) string { return id(a.StatusLabel.ID) },
+	"status_meta":      func(a snipeit.Asset) string { return string(a.StatusLabel.StatusMeta) },
+	"assigned_type":    func(a snipeit.Asset) string { return string(a.AssignedType.Kind()) },
+	"order_number":     func(a snipeit.Asset) string { return a.OrderNumber },
+	"notes":            func(a snipeit.Asset) string { return a.Notes },
+	"eol":              func(a snipeit.Asset) string { return a.EOL },
+	"byod":             func(a snipeit.Asset) string { return strconv.FormatBool(bool(a.BYOD)) },
+	"warranty_months":  func(a snipeit.Asset) string { return id(a.WarrantyMonths) },
+	"checkout_counter": func(a snipeit.Asset) string { return strconv.Itoa(a.CheckoutCounter) },
+	"checkin_counter":  func(a snipeit.Asset) string { return strconv.Itoa(a.CheckinCounter) },
+	"purchase_cost":    func(a snipeit.Asset) string { return money(a.PurchaseCost) },
+	"book_value":       func(a snipeit.Asset) string { return money(a.BookValue) },
+	"purchase_date":    func(a snipeit.Asset) string { return date(a.PurchaseDate) },
+	"expected_checkin": func(a snipeit.Asset) string { return date(a.ExpectedCheckin) },
+	"last_audit_date":  func(a snipeit.Asset) string { return date(a.LastAuditDate) },
+	"next_audit_date":  func(a snipeit.Asset) string { return date(a.NextAuditDate) },
+	"asset_eol_date":   func(a snipeit.Asset) string { return date(a.AssetEOLDate) },
+	"last_checkout":    func(a snipeit.Asset) string { return dateTime(a.LastCheckout) },
+	"created_at":       func(a snipeit.Asset) string { return dateTime(a.CreatedAt) },
+	"updated_at":       func(a snipeit.Asset) string { return dateTime(a.UpdatedAt) },
+	"location": func(a snipeit.Asset) string {
+		if a.Location == nil {
+			return ""
+		}
+		return a.Location.Name
+	},
+	"rtd_location": func(a snipeit.Asset) string {
+		if a.RTDLocation == nil {
+			return ""
+		}
+		return a.RTDLocation.Name
+	},
+	"company": func(a snipeit.Asset) string {
+		if a.Company == nil {
+			return ""
+		}
+		return a.Company.Name
+	},
+	"supplier": func(a snipeit.Asset) string {
+		if a.Supplier == nil {
+			return ""
+		}
+		return a.Supplier.Name
+	},
+	"assigned_to": func(a snipeit.Asset) string {
+		if a.AssignedTo == nil {
+			return ""
+		}
+		return a.AssignedTo.Name()
+	},
+	"assigned_to_id": func(a snipeit.Asset) string {
+		if a.AssignedTo == nil {
+			return ""
+		}
+		return id(a.AssignedTo.ID())
+	},
+}
+
+// Fields returns the names of the standard fields that can be exported,
+// sorted.
+func Fields() []string {
+	names := make([]string, 0, len(standardFields))
+	for name := range standardFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Value returns the exported value of field for asset: a standard field,
+// or a custom field by name or database column. Unset values, and custom
+// fields the asset lacks, are empty. Dates are formatted as YYYY-MM-DD,
+// timestamps as YYYY-MM-DD HH:MM:SS and amounts with two decimals.
+func Value(asset snipeit.Asset, field string) string {
+	if value, ok := standardFields[field]; ok {
+		return value(asset)
+	}
+	if value, ok := asset.CustomFields.Get(field); ok {
+		return value
+	}
+	if custom, ok := asset.CustomFields.Column(field); ok {
+		return custom.Value
+	}
+	return ""
+}
+
+// id formats an ID or count, leaving 0 empty.
+func id(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+// money formats an amount, leaving nil empty.
+func money(m *snipeit.Money) string {
+	if m == nil {
+		return ""
+	}
+	return m.String()
+}
+
+// date formats the date of a time, leaving nil and the zero time empty.
+func date(t *snipeit.SnipeTime) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return snipeit.DateOf(t.Time).String()
+}
+
+// dateTime formats a time, leaving nil and the zero time empty.
+func dateTime(t *snipeit.SnipeTime) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.Format(dateTimeLayout)
+}
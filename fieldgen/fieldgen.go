@@ -0,0 +1,386 @@
+// Package fieldgen generates type-safe Go structs for Snipe-IT custom fieldsets.
+//
+// Custom fields are defined per Snipe-IT deployment, so the client library
+// cannot model them statically. fieldgen reads the fieldset definitions of an
+// instance and emits one struct per fieldset, with a field for each custom
+// field tagged with its database column name:
+//
+//	type LaptopFields struct {
+//	    MACAddress snipeit.Nullable[string] `snipeit:"_snipeit_mac_address_1"`
+//	}
+//
+// The generated types decode the custom_fields object returned by the API and
+// encode the fields that are set to the flat "_snipeit_*" keys expected on
+// create and update, so that unset fields are left unchanged.
+//
+// Each fieldset also gets an asset type embedding snipeit.Asset, with a typed
+// accessor for each custom field:
+//
+//	laptop := customfields.LaptopAsset{Asset: asset.Asset}
+//	fmt.Println(laptop.AssetTag, laptop.MACAddress())
+package fieldgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"maps"
+	"reflect"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// Options configures code generation.
+type Options struct {
+	// Package is the package name of the generated file.
+	// If empty, "customfields" is used.
+	Package string
+}
+
+// Generate writes Go source declaring one struct per fieldset to w.
+//
+// Fields with the NUMERIC format are generated as snipeit.Nullable[float64],
+// fields with the BOOLEAN format or a checkbox element as
+// snipeit.Nullable[bool], and all others as snipeit.Nullable[string]. Fields
+// whose name is taken by a field or method of snipeit.Asset or of the
+// generated types get a "Field" suffix, such as CustomFieldsField for
+// "Custom Fields". The output is gofmt-formatted.
+func Generate(w io.Writer, fieldsets []snipeit.Fieldset, opts *Options) error {
+	pkg := "customfields"
+	if opts != nil && opts.Package != "" {
+		pkg = opts.Package
+	}
+
+	data := fileData{Package: pkg}
+	typeNames := make(map[string]bool)
+	for _, fs := range fieldsets {
+		t := typeData{
+			Name:      uniqueName(GoName(fs.Name)+"Fields", typeNames),
+			AssetName: uniqueName(GoName(fs.Name)+"Asset", typeNames),
+			Fieldset:  fs.Name,
+		}
+
+		fieldNames := maps.Clone(reservedNames)
+		for _, cf := range fs.Fields.Rows {
+			if cf.DBColumnName == "" {
+				return fmt.Errorf("fieldgen: field %q in fieldset %q has no db_column_name", cf.Name, fs.Name)
+			}
+			t.Fields = append(t.Fields, fieldData{
+				Name:   fieldName(cf.Name, fieldNames),
+				Label:  cf.Name,
+				Column: cf.DBColumnName,
+				Format: cf.Format,
+				Kind:   fieldKind(cf),
+			})
+		}
+		data.Types = append(data.Types, t)
+	}
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, data); err != nil {
+		return err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("fieldgen: formatting generated source: %w", err)
+	}
+
+	_, err = w.Write(src)
+	return err
+}
+
+// GoName converts a Snipe-IT field or fieldset name such as "MAC Address"
+// into an exported Go identifier such as "MACAddress".
+//
+// Words are split on any character that is not a letter or digit, and
+// common initialisms are upper-cased. Names that would start with a digit
+// are prefixed with "Field".
+func GoName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var b strings.Builder
+	for _, word := range words {
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		runes := []rune(word)
+		b.WriteRune(unicode.ToUpper(runes[0]))
+		b.WriteString(string(runes[1:]))
+	}
+
+	s := b.String()
+	if s == "" {
+		return "Field"
+	}
+	if unicode.IsDigit([]rune(s)[0]) {
+		s = "Field" + s
+	}
+	return s
+}
+
+// initialisms are words that are written in all capitals in Go identifiers.
+var initialisms = map[string]bool{
+	"API": true, "CPU": true, "DNS": true, "EOL": true, "GB": true, "GPU": true,
+	"HDD": true, "HTTP": true, "ID": true, "IMEI": true, "IP": true,
+	"MAC": true, "OS": true, "RAM": true, "SIM": true, "SKU": true,
+	"SSD": true, "SSID": true, "TB": true, "URL": true, "UUID": true, "VLAN": true,
+}
+
+// uniqueName returns name, or name with a numeric suffix if it is already in seen.
+func uniqueName(name string, seen map[string]bool) string {
+	candidate := name
+	for i := 2; seen[candidate]; i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	seen[candidate] = true
+	return candidate
+}
+
+// fieldName returns the Go name of the custom field labeled label, unique
+// in seen. Reserved names get a "Field" suffix.
+func fieldName(label string, seen map[string]bool) string {
+	name := GoName(label)
+	if reservedNames[name] {
+		name += "Field"
+	}
+	return uniqueName(name, seen)
+}
+
+// reservedNames are the names that fields cannot take, as they are taken by
+// the fields and methods of the generated types, including those promoted
+// from the snipeit.Asset embedded in the asset types.
+var reservedNames = assetNames("Asset", "Fields", "Values", "MarshalJSON", "UnmarshalJSON")
+
+// assetNames returns the names of the fields and methods of snipeit.Asset,
+// along with names.
+func assetNames(names ...string) map[string]bool {
+	reserved := make(map[string]bool)
+	for _, name := range names {
+		reserved[name] = true
+	}
+
+	asset := reflect.TypeOf(snipeit.Asset{})
+	for _, field := range reflect.VisibleFields(asset) {
+		reserved[field.Name] = true
+	}
+	methods := reflect.PointerTo(asset)
+	for i := 0; i < methods.NumMethod(); i++ {
+		reserved[methods.Method(i).Name] = true
+	}
+	return reserved
+}
+
+// fieldKind returns the Go type used for a custom field.
+func fieldKind(cf snipeit.CustomField) string {
+	switch {
+	case strings.EqualFold(cf.Format, "NUMERIC"):
+		return "float64"
+	case strings.EqualFold(cf.Format, "BOOLEAN"), strings.EqualFold(cf.Element, "checkbox"):
+		return "bool"
+	default:
+		return "string"
+	}
+}
+
+type fileData struct {
+	Package string
+	Types   []typeData
+}
+
+type typeData struct {
+	Name      string
+	AssetName string
+	Fieldset  string
+	Fields    []fieldData
+}
+
+type fieldData struct {
+	Name   string
+	Label  string
+	Column string
+	Format string
+	Kind   string
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by fieldgen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// customFieldValue is a single entry of the custom_fields object returned by the Snipe-IT API.
+type customFieldValue struct {
+	Field string          ` + "`json:\"field\"`" + `
+	Value json.RawMessage ` + "`json:\"value\"`" + `
+}
+
+// customFieldText returns the value of a custom field as text.
+func customFieldText(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err != nil {
+		return "", err
+	}
+	return n.String(), nil
+}
+
+// customFieldString returns the value of a custom field as a string,
+// or null if it is empty.
+func customFieldString(raw json.RawMessage) (snipeit.Nullable[string], error) {
+	s, err := customFieldText(raw)
+	if err != nil || s == "" {
+		return snipeit.Null[string](), err
+	}
+	return snipeit.NewNullable(s), nil
+}
+
+// customFieldFloat returns the value of a custom field as a float64,
+// or null if it is empty.
+func customFieldFloat(raw json.RawMessage) (snipeit.Nullable[float64], error) {
+	s, err := customFieldText(raw)
+	if err != nil || s == "" {
+		return snipeit.Null[float64](), err
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return snipeit.Null[float64](), err
+	}
+	return snipeit.NewNullable(f), nil
+}
+
+// customFieldBool returns the value of a custom field as a bool,
+// or null if it is empty.
+func customFieldBool(raw json.RawMessage) (snipeit.Nullable[bool], error) {
+	s, err := customFieldText(raw)
+	if err != nil || s == "" {
+		return snipeit.Null[bool](), err
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return snipeit.Null[bool](), err
+	}
+	return snipeit.NewNullable(b), nil
+}
+
+// setValue stores the value of a field in values under column, or nil if it
+// is null. Unset fields are left out.
+func setValue[T any](values map[string]interface{}, column string, field snipeit.Nullable[T]) {
+	if value, ok := field.Get(); ok {
+		values[column] = value
+	} else if field.IsNull() {
+		values[column] = nil
+	}
+}
+
+// assetFieldString returns the value of the custom field in column, or "" if fields lack it.
+func assetFieldString(fields snipeit.CustomFields, column string) string {
+	field, _ := fields.Column(column)
+	return field.Value
+}
+
+// assetFieldFloat returns the value of the custom field in column as a float64,
+// or 0 if fields lack it or it is not a number.
+func assetFieldFloat(fields snipeit.CustomFields, column string) float64 {
+	f, _ := strconv.ParseFloat(assetFieldString(fields, column), 64)
+	return f
+}
+
+// assetFieldBool returns the value of the custom field in column as a bool,
+// or false if fields lack it or it is not a boolean.
+func assetFieldBool(fields snipeit.CustomFields, column string) bool {
+	b, _ := strconv.ParseBool(assetFieldString(fields, column))
+	return b
+}
+{{range $t := .Types}}
+// {{$t.Name}} holds the custom fields of the {{printf "%q" $t.Fieldset}} fieldset.
+type {{$t.Name}} struct {
+{{- range $t.Fields}}
+	// {{.Name}} is the {{printf "%q" .Label}} field{{if .Format}} (format {{.Format}}){{end}}.
+	{{.Name}} snipeit.Nullable[{{.Kind}}] ` + "`snipeit:\"{{.Column}}\"`" + `
+{{- end}}
+}
+
+// UnmarshalJSON decodes the custom_fields object returned by the Snipe-IT API,
+// which is keyed by field label and carries each field's column name and value.
+func (f *{{$t.Name}}) UnmarshalJSON(data []byte) error {
+	var fields map[string]customFieldValue
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for label, v := range fields {
+		var err error
+		switch v.Field {
+{{- range $t.Fields}}
+		case {{printf "%q" .Column}}:
+			{{if eq .Kind "float64"}}f.{{.Name}}, err = customFieldFloat(v.Value){{else if eq .Kind "bool"}}f.{{.Name}}, err = customFieldBool(v.Value){{else}}f.{{.Name}}, err = customFieldString(v.Value){{end}}
+{{- end}}
+		}
+		if err != nil {
+			return fmt.Errorf("custom field %q: %w", label, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON encodes the fields that are set as the flat "_snipeit_*" keys
+// the Snipe-IT API expects on create and update. Null fields are encoded as
+// null, which clears them.
+func (f {{$t.Name}}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Values())
+}
+
+// Values returns the fields that are set keyed by database column name,
+// with a nil value for null fields.
+func (f {{$t.Name}}) Values() map[string]interface{} {
+	values := make(map[string]interface{})
+{{- range $t.Fields}}
+	setValue(values, {{printf "%q" .Column}}, f.{{.Name}})
+{{- end}}
+	return values
+}
+
+// {{$t.AssetName}} is an asset with the {{printf "%q" $t.Fieldset}} fieldset, with typed
+// accessors for its custom fields.
+type {{$t.AssetName}} struct {
+	snipeit.Asset
+}
+
+// Fields returns the custom fields of the asset, or an error if a value
+// does not match the type of its field.
+func (a {{$t.AssetName}}) Fields() ({{$t.Name}}, error) {
+	var f {{$t.Name}}
+	data, err := json.Marshal(a.CustomFields)
+	if err != nil {
+		return f, err
+	}
+	err = json.Unmarshal(data, &f)
+	return f, err
+}
+{{range $t.Fields}}
+// {{.Name}} returns the {{printf "%q" .Label}} field of the asset, or the zero
+// value if the asset lacks it or its value is invalid.
+func (a {{$t.AssetName}}) {{.Name}}() {{.Kind}} {
+	return {{if eq .Kind "float64"}}assetFieldFloat{{else if eq .Kind "bool"}}assetFieldBool{{else}}assetFieldString{{end}}(a.CustomFields, {{printf "%q" .Column}})
+}
+{{end}}
+{{- end}}`))
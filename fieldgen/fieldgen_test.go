@@ -0,0 +1,256 @@
+package fieldgen
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+func TestGoName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"MAC Address", "MACAddress"},
+		{"ram (gb)", "RAMGB"},
+		{"imei", "IMEI"},
+		{"Purchase-order number", "PurchaseOrderNumber"},
+		{"2nd Monitor", "Field2ndMonitor"},
+		{"", "Field"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GoName(tt.name); got != tt.expected {
+				t.Errorf("GoName(%q) = %q, expected %q", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	fieldsets := []snipeit.Fieldset{
+		{
+			CommonFields: snipeit.CommonFields{ID: 1, Name: "Laptop"},
+			Fields: snipeit.FieldsetFields{
+				Total: 3,
+				Rows: []snipeit.CustomField{
+					{CommonFields: snipeit.CommonFields{Name: "MAC Address"}, DBColumnName: "_snipeit_mac_address_1", Format: "MAC"},
+					{CommonFields: snipeit.CommonFields{Name: "RAM"}, DBColumnName: "_snipeit_ram_2", Format: "NUMERIC"},
+					{CommonFields: snipeit.CommonFields{Name: "Encrypted"}, DBColumnName: "_snipeit_encrypted_3", Element: "checkbox"},
+				},
+			},
+		},
+		{
+			CommonFields: snipeit.CommonFields{ID: 2, Name: "Phone"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Generate(&buf, fieldsets, &Options{Package: "inventory"}); err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+	src := buf.String()
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "generated.go", src, 0); err != nil {
+		t.Fatalf("Generate produced invalid Go source: %v\n%s", err, src)
+	}
+
+	for _, want := range []string{
+		"package inventory",
+		"type LaptopFields struct",
+		"MACAddress snipeit.Nullable[string] `snipeit:\"_snipeit_mac_address_1\"`",
+		"RAM snipeit.Nullable[float64] `snipeit:\"_snipeit_ram_2\"`",
+		"Encrypted snipeit.Nullable[bool] `snipeit:\"_snipeit_encrypted_3\"`",
+		"type PhoneFields struct",
+		"func (f *LaptopFields) UnmarshalJSON(data []byte) error",
+		"func (f LaptopFields) MarshalJSON() ([]byte, error)",
+		"type LaptopAsset struct",
+		"func (a LaptopAsset) Fields() (LaptopFields, error)",
+		"func (a LaptopAsset) MACAddress() string",
+		"func (a LaptopAsset) RAM() float64",
+		"func (a LaptopAsset) Encrypted() bool",
+		"type PhoneAsset struct",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("Generate output does not contain %q:\n%s", want, src)
+		}
+	}
+}
+
+func TestGenerateMissingColumn(t *testing.T) {
+	fieldsets := []snipeit.Fieldset{
+		{
+			CommonFields: snipeit.CommonFields{Name: "Laptop"},
+			Fields: snipeit.FieldsetFields{
+				Rows: []snipeit.CustomField{
+					{CommonFields: snipeit.CommonFields{Name: "MAC Address"}},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Generate(&buf, fieldsets, nil); err == nil {
+		t.Error("Generate expected error for field without db_column_name, got none")
+	}
+}
+
+func TestGenerateReservedNames(t *testing.T) {
+	fieldsets := []snipeit.Fieldset{
+		{
+			CommonFields: snipeit.CommonFields{Name: "Laptop"},
+			Fields: snipeit.FieldsetFields{
+				Rows: []snipeit.CustomField{
+					{CommonFields: snipeit.CommonFields{Name: "Asset"}, DBColumnName: "_snipeit_asset_1"},
+					{CommonFields: snipeit.CommonFields{Name: "Fields"}, DBColumnName: "_snipeit_fields_2"},
+					{CommonFields: snipeit.CommonFields{Name: "Values"}, DBColumnName: "_snipeit_values_3"},
+					{CommonFields: snipeit.CommonFields{Name: "Custom Fields"}, DBColumnName: "_snipeit_custom_fields_4"},
+					{CommonFields: snipeit.CommonFields{Name: "Asset Tag"}, DBColumnName: "_snipeit_asset_tag_5"},
+					{CommonFields: snipeit.CommonFields{Name: "ID"}, DBColumnName: "_snipeit_id_6"},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Generate(&buf, fieldsets, nil); err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+
+	for _, want := range []string{
+		"func (a LaptopAsset) AssetField() string",
+		"func (a LaptopAsset) FieldsField() string",
+		"func (a LaptopAsset) ValuesField() string",
+		"func (a LaptopAsset) CustomFieldsField() string",
+		"func (a LaptopAsset) AssetTagField() string",
+		"func (a LaptopAsset) IDField() string",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Generate output does not contain %q:\n%s", want, buf.String())
+		}
+	}
+}
+
+// generatedProgram uses the code generated for generatedFieldsets, printing
+// the JSON of partially set fields and the values read through accessors
+// whose names collide with members of snipeit.Asset.
+const generatedProgram = `package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+func main() {
+	fields := LaptopFields{MACAddress: snipeit.NewNullable("00:1B"), RAM: snipeit.Null[float64]()}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(data))
+
+	var asset snipeit.Asset
+	if err := json.Unmarshal([]byte(` + "`" + `{
+		"id": 7,
+		"name": "Laptop 7",
+		"custom_fields": {
+			"Name": {"field": "_snipeit_name_4", "value": "Ada's laptop"},
+			"Custom Fields": {"field": "_snipeit_custom_fields_5", "value": "yes"},
+			"RAM": {"field": "_snipeit_ram_2", "value": "16"}
+		}
+	}` + "`" + `), &asset); err != nil {
+		panic(err)
+	}
+	laptop := LaptopAsset{Asset: asset}
+	fmt.Println(laptop.ID, laptop.Name, laptop.NameField(), laptop.CustomFieldsField(), len(laptop.CustomFields), laptop.RAM())
+
+	decoded, err := laptop.Fields()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(len(decoded.Values()), decoded.ValuesField.IsSet())
+}
+`
+
+var generatedFieldsets = []snipeit.Fieldset{
+	{
+		CommonFields: snipeit.CommonFields{Name: "Laptop"},
+		Fields: snipeit.FieldsetFields{
+			Rows: []snipeit.CustomField{
+				{CommonFields: snipeit.CommonFields{Name: "MAC Address"}, DBColumnName: "_snipeit_mac_address_1", Format: "MAC"},
+				{CommonFields: snipeit.CommonFields{Name: "RAM"}, DBColumnName: "_snipeit_ram_2", Format: "NUMERIC"},
+				{CommonFields: snipeit.CommonFields{Name: "Encrypted"}, DBColumnName: "_snipeit_encrypted_3", Element: "checkbox"},
+				{CommonFields: snipeit.CommonFields{Name: "Name"}, DBColumnName: "_snipeit_name_4"},
+				{CommonFields: snipeit.CommonFields{Name: "Custom Fields"}, DBColumnName: "_snipeit_custom_fields_5"},
+				{CommonFields: snipeit.CommonFields{Name: "Values"}, DBColumnName: "_snipeit_values_6"},
+				{CommonFields: snipeit.CommonFields{Name: "Serial"}, DBColumnName: "_snipeit_serial_7"},
+			},
+		},
+	},
+	{
+		CommonFields: snipeit.CommonFields{Name: "Phone"},
+	},
+}
+
+func TestGenerateBuilds(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping build of generated code in short mode")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	var buf bytes.Buffer
+	if err := Generate(&buf, generatedFieldsets, &Options{Package: "main"}); err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+
+	// Build the generated code in a module using this checkout of the client
+	root, err := filepath.Abs("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":       "module generated\n\ngo 1.24\n\nrequire github.com/michellepellon/go-snipeit v0.0.0\n\nreplace github.com/michellepellon/go-snipeit => " + root + "\n",
+		"go.sum":       string(sum),
+		"generated.go": buf.String(),
+		"main.go":      generatedProgram,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command(goTool, "run", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off", "GOWORK=off")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("generated code does not build: %v\n%s\n%s", err, out, buf.String())
+	}
+
+	expected := `{"_snipeit_mac_address_1":"00:1B","_snipeit_ram_2":null}
+7 Laptop 7 Ada's laptop yes 3 16
+3 false
+`
+	if string(out) != expected {
+		t.Errorf("generated code printed:\n%s\nexpected:\n%s", out, expected)
+	}
+}
@@ -0,0 +1,302 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// CustomFieldsService handles communication with the custom field endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fields
+type CustomFieldsService struct {
+	client *Client
+}
+
+// CustomFieldResponse represents the API response for a single custom field.
+// The single field endpoint returns the field data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded CustomField.
+type CustomFieldResponse struct {
+	Response
+	// Payload contains the custom field as returned in the payload field, if any
+	Payload *CustomField `json:"payload,omitempty"`
+	CustomField
+}
+
+// UnmarshalJSON implements json.Unmarshaler for CustomFieldResponse.
+func (r *CustomFieldResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.CustomField)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.CustomField
+	}
+	return nil
+}
+
+// List returns a list of custom fields with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fields
+func (s *CustomFieldsService) List(opts *ListOptions) (*CustomFieldsResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of custom fields with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fields
+func (s *CustomFieldsService) ListContext(ctx context.Context, opts *ListOptions) (*CustomFieldsResponse, *http.Response, error) {
+	u := "api/v1/fields"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fields CustomFieldsResponse
+	resp, err := s.client.Do(req, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &fields, resp, nil
+}
+
+// Iterate returns an iterator over every custom field, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero CustomField.
+func (s *CustomFieldsService) Iterate(opts *ListOptions) iter.Seq2[CustomField, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every custom field with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero CustomField.
+func (s *CustomFieldsService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[CustomField, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]CustomField, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single custom field by its ID.
+//
+// id is the unique identifier of the custom field to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsid
+func (s *CustomFieldsService) Get(id int) (*CustomFieldResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single custom field by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the custom field to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsid
+func (s *CustomFieldsService) GetContext(ctx context.Context, id int) (*CustomFieldResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/fields/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var field CustomFieldResponse
+	resp, err := s.client.Do(req, &field)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &field, resp, nil
+}
+
+// Create creates a new custom field in Snipe-IT.
+//
+// field must contain the required fields:
+// - Name: The name of the custom field
+// - Element: The form element ("text", "textarea", "listbox", "checkbox" or "radio")
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fields-1
+func (s *CustomFieldsService) Create(field CustomField) (*CustomFieldResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), field)
+}
+
+// CreateContext creates a new custom field in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// field must contain the required fields:
+// - Name: The name of the custom field
+// - Element: The form element ("text", "textarea", "listbox", "checkbox" or "radio")
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fields-1
+func (s *CustomFieldsService) CreateContext(ctx context.Context, field CustomField) (*CustomFieldResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/fields", field)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response CustomFieldResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing custom field in Snipe-IT.
+//
+// id is the unique identifier of the custom field to update.
+// field contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsid-2
+func (s *CustomFieldsService) Update(id int, field CustomField) (*CustomFieldResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, field)
+}
+
+// UpdateContext updates an existing custom field in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the custom field to update.
+// field contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsid-2
+func (s *CustomFieldsService) UpdateContext(ctx context.Context, id int, field CustomField) (*CustomFieldResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/fields/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, field)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response CustomFieldResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a custom field from Snipe-IT.
+//
+// id is the unique identifier of the custom field to delete.
+// Snipe-IT refuses to delete fields that are still associated with a fieldset.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsid-1
+func (s *CustomFieldsService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a custom field from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the custom field to delete.
+// Snipe-IT refuses to delete fields that are still associated with a fieldset.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsid-1
+func (s *CustomFieldsService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/fields/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Associate adds a custom field to a fieldset.
+//
+// id is the unique identifier of the custom field.
+// fieldsetID is the unique identifier of the fieldset to add the field to.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsidassociate
+func (s *CustomFieldsService) Associate(id, fieldsetID int) (*FieldsetResponse, *http.Response, error) {
+	return s.AssociateContext(context.Background(), id, fieldsetID)
+}
+
+// AssociateContext adds a custom field to a fieldset with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the custom field.
+// fieldsetID is the unique identifier of the fieldset to add the field to.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsidassociate
+func (s *CustomFieldsService) AssociateContext(ctx context.Context, id, fieldsetID int) (*FieldsetResponse, *http.Response, error) {
+	return s.setAssociation(ctx, "associate", id, fieldsetID)
+}
+
+// Disassociate removes a custom field from a fieldset.
+//
+// id is the unique identifier of the custom field.
+// fieldsetID is the unique identifier of the fieldset to remove the field from.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsiddisassociate
+func (s *CustomFieldsService) Disassociate(id, fieldsetID int) (*FieldsetResponse, *http.Response, error) {
+	return s.DisassociateContext(context.Background(), id, fieldsetID)
+}
+
+// DisassociateContext removes a custom field from a fieldset with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the custom field.
+// fieldsetID is the unique identifier of the fieldset to remove the field from.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsiddisassociate
+func (s *CustomFieldsService) DisassociateContext(ctx context.Context, id, fieldsetID int) (*FieldsetResponse, *http.Response, error) {
+	return s.setAssociation(ctx, "disassociate", id, fieldsetID)
+}
+
+// setAssociation posts a fieldset ID to the associate or disassociate
+// endpoint of a custom field.
+func (s *CustomFieldsService) setAssociation(ctx context.Context, action string, id, fieldsetID int) (*FieldsetResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/fields/%d/%s", id, action)
+	body := map[string]interface{}{
+		"fieldset_id": fieldsetID,
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response FieldsetResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
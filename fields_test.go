@@ -0,0 +1,123 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCustomFieldsList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{"id": 4, "name": "MAC Address", "db_column_name": "_snipeit_mac_address_1", "format": "MAC", "type": "text"}
+			]
+		}`)
+	})
+
+	fields, _, err := client.Fields.List(nil)
+	if err != nil {
+		t.Fatalf("Fields.List returned error: %v", err)
+	}
+
+	if len(fields.Rows) != 1 {
+		t.Fatalf("Fields.List returned %d fields, expected %d", len(fields.Rows), 1)
+	}
+
+	field := fields.Rows[0]
+	if field.DBColumnName != "_snipeit_mac_address_1" || field.Format != "MAC" || field.Element != "text" {
+		t.Errorf("Fields.List returned %+v, expected the MAC address text field", field)
+	}
+}
+
+func TestCustomFieldsCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["element"] != "listbox" {
+			t.Errorf("Request body element = %v, expected %v", requestBody["element"], "listbox")
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 7, "name": "OS", "type": "listbox"}}`)
+	})
+
+	field, _, err := client.Fields.Create(CustomField{
+		CommonFields: CommonFields{Name: "OS"},
+		Element:      "listbox",
+		FieldValues:  "macOS\nWindows\nLinux",
+	})
+	if err != nil {
+		t.Fatalf("Fields.Create returned error: %v", err)
+	}
+
+	if field.Payload == nil || field.Payload.ID != 7 {
+		t.Errorf("Fields.Create returned Payload = %+v, expected ID %d", field.Payload, 7)
+	}
+}
+
+func TestCustomFieldsDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/fields/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success"}`)
+	})
+
+	if _, err := client.Fields.Delete(7); err != nil {
+		t.Fatalf("Fields.Delete returned error: %v", err)
+	}
+}
+
+func TestCustomFieldsAssociate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/fields/7/associate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["fieldset_id"] != float64(2) {
+			t.Errorf("Request body fieldset_id = %v, expected %v", requestBody["fieldset_id"], 2)
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 2, "name": "Laptop"}}`)
+	})
+
+	fieldset, _, err := client.Fields.Associate(7, 2)
+	if err != nil {
+		t.Fatalf("Fields.Associate returned error: %v", err)
+	}
+
+	if fieldset.ID != 2 {
+		t.Errorf("Fields.Associate returned fieldset ID = %d, expected %d", fieldset.ID, 2)
+	}
+}
+
+func TestCustomFieldsDisassociate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/fields/7/disassociate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 2, "name": "Laptop"}}`)
+	})
+
+	if _, _, err := client.Fields.Disassociate(7, 2); err != nil {
+		t.Fatalf("Fields.Disassociate returned error: %v", err)
+	}
+}
@@ -0,0 +1,287 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// FieldsetsService handles communication with the custom fieldset endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsets
+type FieldsetsService struct {
+	client *Client
+}
+
+// FieldsetResponse represents the API response for a single fieldset.
+// The single fieldset endpoint returns the fieldset data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Fieldset.
+type FieldsetResponse struct {
+	Response
+	// Payload contains the fieldset as returned in the payload field, if any
+	Payload *Fieldset `json:"payload,omitempty"`
+	Fieldset
+}
+
+// UnmarshalJSON implements json.Unmarshaler for FieldsetResponse.
+func (r *FieldsetResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Fieldset)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Fieldset
+	}
+	return nil
+}
+
+// FieldsetsResponse represents the API response for multiple fieldsets.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Fieldsets.
+type FieldsetsResponse struct {
+	Response
+	// Rows contains the list of Fieldset objects
+	Rows []Fieldset `json:"rows"`
+}
+
+// CustomFieldsResponse represents the API response for multiple custom fields.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of CustomFields.
+type CustomFieldsResponse struct {
+	Response
+	// Rows contains the list of CustomField objects
+	Rows []CustomField `json:"rows"`
+}
+
+// List returns a list of custom fieldsets, including the fields they contain.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsets
+func (s *FieldsetsService) List(opts *ListOptions) (*FieldsetsResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of custom fieldsets with the provided context.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsets
+func (s *FieldsetsService) ListContext(ctx context.Context, opts *ListOptions) (*FieldsetsResponse, *http.Response, error) {
+	u := "api/v1/fieldsets"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fieldsets FieldsetsResponse
+	resp, err := s.client.Do(req, &fieldsets)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &fieldsets, resp, nil
+}
+
+// Iterate returns an iterator over every fieldset, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Fieldset.
+func (s *FieldsetsService) Iterate(opts *ListOptions) iter.Seq2[Fieldset, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every fieldset with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Fieldset.
+func (s *FieldsetsService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Fieldset, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]Fieldset, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single fieldset by its ID.
+//
+// id is the unique identifier of the fieldset to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsetsid
+func (s *FieldsetsService) Get(id int) (*FieldsetResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single fieldset by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the fieldset to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsetsid
+func (s *FieldsetsService) GetContext(ctx context.Context, id int) (*FieldsetResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/fieldsets/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fieldset FieldsetResponse
+	resp, err := s.client.Do(req, &fieldset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &fieldset, resp, nil
+}
+
+// Create creates a new custom fieldset in Snipe-IT.
+//
+// fieldset must contain the required fields:
+// - Name: The name of the fieldset
+//
+// Fields are added to a fieldset separately, by associating them with it.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsets-1
+func (s *FieldsetsService) Create(fieldset Fieldset) (*FieldsetResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), fieldset)
+}
+
+// CreateContext creates a new custom fieldset in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// fieldset must contain the required fields:
+// - Name: The name of the fieldset
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsets-1
+func (s *FieldsetsService) CreateContext(ctx context.Context, fieldset Fieldset) (*FieldsetResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/fieldsets", fieldset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response FieldsetResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing custom fieldset in Snipe-IT.
+//
+// id is the unique identifier of the fieldset to update.
+// fieldset contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsetsid-2
+func (s *FieldsetsService) Update(id int, fieldset Fieldset) (*FieldsetResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, fieldset)
+}
+
+// UpdateContext updates an existing custom fieldset in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the fieldset to update.
+// fieldset contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsetsid-2
+func (s *FieldsetsService) UpdateContext(ctx context.Context, id int, fieldset Fieldset) (*FieldsetResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/fieldsets/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, fieldset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response FieldsetResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a custom fieldset from Snipe-IT.
+//
+// id is the unique identifier of the fieldset to delete.
+// Snipe-IT refuses to delete fieldsets that are still attached to models.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsetsid-1
+func (s *FieldsetsService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a custom fieldset from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the fieldset to delete.
+// Snipe-IT refuses to delete fieldsets that are still attached to models.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsetsid-1
+func (s *FieldsetsService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/fieldsets/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Fields returns the custom fields that belong to a fieldset.
+//
+// id is the unique identifier of the fieldset.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsetsidfields
+func (s *FieldsetsService) Fields(id int) (*CustomFieldsResponse, *http.Response, error) {
+	return s.FieldsContext(context.Background(), id)
+}
+
+// FieldsContext returns the custom fields that belong to a fieldset with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the fieldset.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/fieldsetsidfields
+func (s *FieldsetsService) FieldsContext(ctx context.Context, id int) (*CustomFieldsResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/fieldsets/%d/fields", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fields CustomFieldsResponse
+	resp, err := s.client.Do(req, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &fields, resp, nil
+}
@@ -0,0 +1,163 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestFieldsetsList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/fieldsets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "Authorization", "Bearer test-token")
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{
+					"id": 1,
+					"name": "Laptop",
+					"fields": {
+						"total": 1,
+						"rows": [
+							{
+								"id": 4,
+								"name": "MAC Address",
+								"db_column_name": "_snipeit_mac_address_1",
+								"format": "MAC",
+								"type": "text",
+								"required": false
+							}
+						]
+					},
+					"models": {
+						"total": 1,
+						"rows": [{"id": 2, "name": "MacBook Pro 16"}]
+					}
+				}
+			]
+		}`)
+	})
+
+	fieldsets, _, err := client.Fieldsets.List(nil)
+	if err != nil {
+		t.Fatalf("Fieldsets.List returned error: %v", err)
+	}
+
+	if len(fieldsets.Rows) != 1 {
+		t.Fatalf("Fieldsets.List returned %d fieldsets, expected %d", len(fieldsets.Rows), 1)
+	}
+
+	fields := fieldsets.Rows[0].Fields.Rows
+	if len(fields) != 1 {
+		t.Fatalf("Fieldsets.List returned %d fields, expected %d", len(fields), 1)
+	}
+
+	if fields[0].DBColumnName != "_snipeit_mac_address_1" {
+		t.Errorf("Fieldsets.List field DBColumnName = %q, expected %q", fields[0].DBColumnName, "_snipeit_mac_address_1")
+	}
+
+	if fields[0].Element != "text" {
+		t.Errorf("Fieldsets.List field Element = %q, expected %q", fields[0].Element, "text")
+	}
+
+	if fieldsets.Rows[0].Models.Rows[0].Name != "MacBook Pro 16" {
+		t.Errorf("Fieldsets.List model Name = %q, expected %q", fieldsets.Rows[0].Models.Rows[0].Name, "MacBook Pro 16")
+	}
+}
+
+func TestFieldsetsGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/fieldsets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"name": "Laptop",
+			"fields": {"total": 0, "rows": []}
+		}`)
+	})
+
+	fieldset, _, err := client.Fieldsets.Get(1)
+	if err != nil {
+		t.Fatalf("Fieldsets.Get returned error: %v", err)
+	}
+
+	if fieldset.ID != 1 || fieldset.Name != "Laptop" {
+		t.Errorf("Fieldsets.Get returned ID = %d, Name = %q, expected %d, %q", fieldset.ID, fieldset.Name, 1, "Laptop")
+	}
+}
+
+func TestFieldsetsCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/fieldsets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["name"] != "Servers" {
+			t.Errorf("Request body name = %v, expected %v", requestBody["name"], "Servers")
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 5, "name": "Servers"}}`)
+	})
+
+	fieldset, _, err := client.Fieldsets.Create(Fieldset{CommonFields: CommonFields{Name: "Servers"}})
+	if err != nil {
+		t.Fatalf("Fieldsets.Create returned error: %v", err)
+	}
+
+	if fieldset.Payload == nil || fieldset.Payload.ID != 5 {
+		t.Errorf("Fieldsets.Create returned Payload = %+v, expected ID %d", fieldset.Payload, 5)
+	}
+}
+
+func TestFieldsetsDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/fieldsets/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success"}`)
+	})
+
+	if _, err := client.Fieldsets.Delete(5); err != nil {
+		t.Fatalf("Fieldsets.Delete returned error: %v", err)
+	}
+}
+
+func TestFieldsetsFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/fieldsets/1/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 4, "name": "MAC Address", "db_column_name": "_snipeit_mac_address_1", "format": "MAC", "type": "text"},
+				{"id": 5, "name": "RAM", "db_column_name": "_snipeit_ram_2", "format": "NUMERIC", "type": "text"}
+			]
+		}`)
+	})
+
+	fields, _, err := client.Fieldsets.Fields(1)
+	if err != nil {
+		t.Fatalf("Fieldsets.Fields returned error: %v", err)
+	}
+
+	if fields.Total != 2 || len(fields.Rows) != 2 {
+		t.Fatalf("Fieldsets.Fields returned Total = %d with %d rows, expected 2 and 2", fields.Total, len(fields.Rows))
+	}
+
+	if fields.Rows[1].Format != "NUMERIC" {
+		t.Errorf("Fieldsets.Fields returned Format = %q, expected %q", fields.Rows[1].Format, "NUMERIC")
+	}
+}
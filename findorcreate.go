@@ -0,0 +1,235 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+)
+
+// FindOrCreate returns the ID of the model named name, creating it if
+// there is none.
+//
+// template holds the other fields of the model to create, which Snipe-IT
+// requires to include CategoryID and ManufacturerID; its Name is set to
+// name. It is not used if the model exists.
+//
+// Names are matched ignoring case and surrounding spaces. Calls for the
+// same name through a client, or the clients derived from it, are
+// serialized, so that concurrent calls create the model once.
+func (s *ModelsService) FindOrCreate(name string, template Model) (int, error) {
+	return s.FindOrCreateContext(context.Background(), name, template)
+}
+
+// FindOrCreateContext returns the ID of the model named name with the
+// provided context, creating it if there is none.
+//
+// ctx is the context for the requests.
+// template holds the other fields of the model to create, which Snipe-IT
+// requires to include CategoryID and ManufacturerID; its Name is set to
+// name. It is not used if the model exists.
+func (s *ModelsService) FindOrCreateContext(ctx context.Context, name string, template Model) (int, error) {
+	find := func(ctx context.Context) (int, error) {
+		models := s.IterateContext(ctx, &ListOptions{Search: name})
+		return findByName(namedIDs(models, func(m Model) CommonFields { return m.CommonFields }), name)
+	}
+	create := func(ctx context.Context) (int, error) {
+		template.Name = name
+		model, _, err := s.CreateContext(ctx, template)
+		if err != nil {
+			return 0, err
+		}
+		return model.ID, nil
+	}
+	return s.client.findOrCreate(ctx, "model", name, find, create)
+}
+
+// FindOrCreate returns the ID of the manufacturer named name, creating it
+// if there is none.
+//
+// template holds the other fields of the manufacturer to create; its Name
+// is set to name. It is not used if the manufacturer exists.
+//
+// Names are matched ignoring case and surrounding spaces. Calls for the
+// same name through a client, or the clients derived from it, are
+// serialized, so that concurrent calls create the manufacturer once.
+func (s *ManufacturersService) FindOrCreate(name string, template Manufacturer) (int, error) {
+	return s.FindOrCreateContext(context.Background(), name, template)
+}
+
+// FindOrCreateContext returns the ID of the manufacturer named name with
+// the provided context, creating it if there is none.
+//
+// ctx is the context for the requests.
+// template holds the other fields of the manufacturer to create; its Name
+// is set to name. It is not used if the manufacturer exists.
+func (s *ManufacturersService) FindOrCreateContext(ctx context.Context, name string, template Manufacturer) (int, error) {
+	find := func(ctx context.Context) (int, error) {
+		manufacturers := s.IterateContext(ctx, &ListOptions{Search: name})
+		return findByName(namedIDs(manufacturers, func(m Manufacturer) CommonFields { return m.CommonFields }), name)
+	}
+	create := func(ctx context.Context) (int, error) {
+		template.Name = name
+		manufacturer, _, err := s.CreateContext(ctx, template)
+		if err != nil {
+			return 0, err
+		}
+		return manufacturer.ID, nil
+	}
+	return s.client.findOrCreate(ctx, "manufacturer", name, find, create)
+}
+
+// FindOrCreate returns the ID of the category named name, creating it if
+// there is none.
+//
+// template holds the other fields of the category to create, which
+// Snipe-IT requires to include CategoryType; its Name is set to name. It
+// is not used if the category exists. Categories of any type match name.
+//
+// Names are matched ignoring case and surrounding spaces. Calls for the
+// same name through a client, or the clients derived from it, are
+// serialized, so that concurrent calls create the category once.
+func (s *CategoriesService) FindOrCreate(name string, template Category) (int, error) {
+	return s.FindOrCreateContext(context.Background(), name, template)
+}
+
+// FindOrCreateContext returns the ID of the category named name with the
+// provided context, creating it if there is none.
+//
+// ctx is the context for the requests.
+// template holds the other fields of the category to create, which
+// Snipe-IT requires to include CategoryType; its Name is set to name. It
+// is not used if the category exists. Categories of any type match name.
+func (s *CategoriesService) FindOrCreateContext(ctx context.Context, name string, template Category) (int, error) {
+	find := func(ctx context.Context) (int, error) {
+		categories := s.IterateContext(ctx, &CategoryListOptions{ListOptions: ListOptions{Search: name}})
+		return findByName(namedIDs(categories, func(c Category) CommonFields { return c.CommonFields }), name)
+	}
+	create := func(ctx context.Context) (int, error) {
+		template.Name = name
+		category, _, err := s.CreateContext(ctx, template)
+		if err != nil {
+			return 0, err
+		}
+		return category.ID, nil
+	}
+	return s.client.findOrCreate(ctx, "category", name, find, create)
+}
+
+// FindOrCreate returns the ID of the location named name, creating it if
+// there is none.
+//
+// template holds the other fields of the location to create; its Name is
+// set to name. It is not used if the location exists.
+//
+// Names are matched ignoring case and surrounding spaces. Calls for the
+// same name through a client, or the clients derived from it, are
+// serialized, so that concurrent calls create the location once.
+func (s *LocationsService) FindOrCreate(name string, template Location) (int, error) {
+	return s.FindOrCreateContext(context.Background(), name, template)
+}
+
+// FindOrCreateContext returns the ID of the location named name with the
+// provided context, creating it if there is none.
+//
+// ctx is the context for the requests.
+// template holds the other fields of the location to create; its Name is
+// set to name. It is not used if the location exists.
+func (s *LocationsService) FindOrCreateContext(ctx context.Context, name string, template Location) (int, error) {
+	find := func(ctx context.Context) (int, error) {
+		locations := s.IterateContext(ctx, &ListOptions{Search: name})
+		return findByName(namedIDs(locations, func(l Location) CommonFields { return l.CommonFields }), name)
+	}
+	create := func(ctx context.Context) (int, error) {
+		template.Name = name
+		location, _, err := s.CreateContext(ctx, template)
+		if err != nil {
+			return 0, err
+		}
+		return location.ID, nil
+	}
+	return s.client.findOrCreate(ctx, "location", name, find, create)
+}
+
+// findOrCreate returns the ID of the resource of the given kind named
+// name that find returns, calling create if find returns 0.
+//
+// Calls for the same kind and name are serialized. If create fails with
+// an API error, such as Snipe-IT rejecting the name as already taken
+// because another program created the resource meanwhile, find is tried
+// again.
+func (c *Client) findOrCreate(ctx context.Context, kind, name string, find, create func(ctx context.Context) (int, error)) (int, error) {
+	unlock := c.nameLocks.lock(kind + "\x00" + normalizeName(name))
+	defer unlock()
+
+	id, err := find(ctx)
+	if err != nil || id != 0 {
+		return id, err
+	}
+
+	id, err = create(ctx)
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) {
+		if found, findErr := find(ctx); findErr == nil && found != 0 {
+			return found, nil
+		}
+	}
+	return id, err
+}
+
+// findByName returns the ID of the first resource of seq named name,
+// ignoring case and surrounding spaces, or 0 if there is none.
+func findByName(seq iter.Seq2[namedID, error], name string) (int, error) {
+	key := normalizeName(name)
+	for item, err := range seq {
+		if err != nil {
+			return 0, err
+		}
+		if normalizeName(item.name) == key {
+			return item.id, nil
+		}
+	}
+	return 0, nil
+}
+
+// nameLocks is a set of mutexes by key, each existing while it is held
+// or waited for.
+type nameLocks struct {
+	mu    sync.Mutex
+	locks map[string]*nameLock
+}
+
+// nameLock is a mutex of a nameLocks, with the number of its holders and
+// waiters.
+type nameLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lock locks the mutex for key and returns the function unlocking it.
+func (l *nameLocks) lock(key string) (unlock func()) {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*nameLock)
+	}
+	lock, ok := l.locks[key]
+	if !ok {
+		lock = &nameLock{}
+		l.locks[key] = lock
+	}
+	lock.refs++
+	l.mu.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+
+		l.mu.Lock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(l.locks, key)
+		}
+		l.mu.Unlock()
+	}
+}
@@ -0,0 +1,125 @@
+package snipeit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestModelsFindOrCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var models []string
+	creates := 0
+	mux.HandleFunc("/api/v1/models", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == http.MethodPost {
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			if body["name"] != "MacBook Pro 16" || body["category_id"] != float64(2) {
+				t.Errorf("Request body = %v, expected the MacBook Pro 16 in category 2", body)
+			}
+			creates++
+			models = append(models, "MacBook Pro 16")
+			fmt.Fprintf(w, `{"status": "success", "payload": {"id": %d, "name": "MacBook Pro 16"}}`, 10+len(models))
+			return
+		}
+
+		// Search matches names containing the term, like Snipe-IT
+		rows := `{"id": 5, "name": "MacBook Pro 16 (2019)"}`
+		for i, name := range models {
+			rows += fmt.Sprintf(`, {"id": %d, "name": %q}`, 11+i, name)
+		}
+		fmt.Fprintf(w, `{"total": %d, "rows": [%s]}`, 1+len(models), rows)
+	})
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := client.Models.FindOrCreateContext(context.Background(), "MacBook Pro 16", Model{CategoryID: 2, ManufacturerID: 1})
+			if err != nil || id != 11 {
+				t.Errorf("Models.FindOrCreate returned %d, %v, expected %d", id, err, 11)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if creates != 1 {
+		t.Errorf("Models.FindOrCreate created %d models, expected %d", creates, 1)
+	}
+
+	if id, err := client.Models.FindOrCreate(" macbook pro 16 (2019) ", Model{}); err != nil || id != 5 {
+		t.Errorf("Models.FindOrCreate returned %d, %v, expected %d", id, err, 5)
+	}
+}
+
+func TestFindOrCreateCreatedMeanwhile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	searches := 0
+	mux.HandleFunc("/api/v1/locations", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprint(w, `{"status": "error", "messages": {"name": ["The name has already been taken."]}}`)
+			return
+		}
+		searches++
+		if searches == 1 {
+			fmt.Fprint(w, `{"total": 0, "rows": []}`)
+			return
+		}
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 4, "name": "HQ"}]}`)
+	})
+
+	id, err := client.Locations.FindOrCreate("HQ", Location{})
+	if err != nil || id != 4 {
+		t.Errorf("Locations.FindOrCreate returned %d, %v, expected %d", id, err, 4)
+	}
+}
+
+func TestFindOrCreateError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/categories", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprint(w, `{"status": "error", "messages": {"category_type": ["The category type field is required."]}}`)
+			return
+		}
+		fmt.Fprint(w, `{"total": 0, "rows": []}`)
+	})
+
+	if _, err := client.Categories.FindOrCreate("Laptops", Category{}); err == nil {
+		t.Error("Categories.FindOrCreate returned no error, expected the validation error")
+	}
+}
+
+func TestManufacturersFindOrCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/manufacturers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprint(w, `{"status": "success", "payload": {"id": 9, "name": "Framework"}}`)
+			return
+		}
+		if r.URL.Query().Get("search") != "Framework" {
+			t.Errorf("Request search = %q, expected %q", r.URL.Query().Get("search"), "Framework")
+		}
+		fmt.Fprint(w, `{"total": 0, "rows": []}`)
+	})
+
+	id, err := client.Manufacturers.FindOrCreate("Framework", Manufacturer{URL: "https://frame.work"})
+	if err != nil || id != 9 {
+		t.Errorf("Manufacturers.FindOrCreate returned %d, %v, expected %d", id, err, 9)
+	}
+}
@@ -0,0 +1,575 @@
+// Package importer creates and updates Snipe-IT assets from a CSV file.
+//
+// Each row of the file describes one asset. Columns are mapped to asset
+// fields by name, related resources (model, status label, location and
+// supplier) can be given by name and are resolved to their IDs, and custom
+// fields are set from columns mapped to their database column names.
+// Rows are imported concurrently under the client's rate limiter, and
+// the outcome of every row is reported.
+//
+// Usage:
+//
+//	file, _ := os.Open("assets.csv")
+//	report, err := importer.Import(ctx, client, file, &importer.Options{
+//	    Mapping: importer.Mapping{
+//	        "Tag":    importer.FieldAssetTag,
+//	        "Model":  importer.FieldModel,
+//	        "Status": importer.FieldStatus,
+//	        "MAC":    importer.CustomField("_snipeit_mac_address_1"),
+//	    },
+//	})
+//	if err != nil {
+//	    log.Fatal(err) // the file could not be read
+//	}
+//	for _, row := range report.Failed() {
+//	    log.Printf("line %d: %v", row.Line, row.Err)
+//	}
+package importer
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// Asset fields that columns can be mapped to. Fields ending in _id take an
+// ID, while FieldModel, FieldStatus, FieldLocation, FieldRTDLocation and
+// FieldSupplier take a name that is resolved to an ID.
+const (
+	FieldAssetTag       = "asset_tag"
+	FieldName           = "name"
+	FieldSerial         = "serial"
+	FieldModel          = "model"
+	FieldModelID        = "model_id"
+	FieldStatus         = "status"
+	FieldStatusID       = "status_id"
+	FieldLocation       = "location"
+	FieldLocationID     = "location_id"
+	FieldRTDLocation    = "rtd_location"
+	FieldRTDLocationID  = "rtd_location_id"
+	FieldSupplier       = "supplier"
+	FieldSupplierID     = "supplier_id"
+	FieldCompanyID      = "company_id"
+	FieldOrderNumber    = "order_number"
+	FieldPurchaseDate   = "purchase_date"
+	FieldPurchaseCost   = "purchase_cost"
+	FieldWarrantyMonths = "warranty_months"
+	FieldNextAuditDate  = "next_audit_date"
+	FieldRequestable    = "requestable"
+	FieldNotes          = "notes"
+)
+
+// customFieldPrefix marks a mapped field as a custom field.
+const customFieldPrefix = "custom:"
+
+// CustomField returns the field name mapping a column to the custom field
+// stored in column (e.g., "_snipeit_mac_address_1"; the "_snipeit_" prefix
+// may be omitted).
+func CustomField(column string) string {
+	return customFieldPrefix + column
+}
+
+// defaultConcurrency is the number of rows imported at once by default.
+const defaultConcurrency = 4
+
+// Mapping maps the headers of CSV columns to the asset fields they hold:
+// one of the Field constants, or a custom field named with CustomField.
+// Columns that are not mapped are ignored.
+type Mapping map[string]string
+
+// Mode selects whether rows create new assets, update existing ones, or both.
+type Mode int
+
+const (
+	// CreateOrUpdate updates the asset with the row's asset tag if there is
+	// one, and creates an asset otherwise. It is the default.
+	CreateOrUpdate Mode = iota
+
+	// CreateOnly creates an asset for every row. Rows whose asset tag
+	// already exists fail.
+	CreateOnly
+
+	// UpdateOnly updates the asset with the row's asset tag. Rows without
+	// an asset tag, or whose tag does not exist, fail.
+	UpdateOnly
+)
+
+// Options configures an import.
+type Options struct {
+	// Mapping maps column headers to asset fields. If nil, headers are
+	// matched to the Field constants ignoring case, with spaces read as
+	// underscores ("Asset Tag" is FieldAssetTag), and headers starting
+	// with "_snipeit_" are custom fields.
+	Mapping Mapping
+
+	// Mode selects whether rows create or update assets.
+	// Default: CreateOrUpdate.
+	Mode Mode
+
+	// Concurrency is the number of rows imported at once. Requests are
+	// additionally subject to the client's rate limiter. Default: 4.
+	Concurrency int
+
+	// Comma is the field delimiter of the file. Default: ','.
+	Comma rune
+}
+
+// Action is what was done with a row.
+type Action string
+
+// Actions reported for rows.
+const (
+	ActionCreated Action = "created"
+	ActionUpdated Action = "updated"
+	ActionFailed  Action = "failed"
+)
+
+// RowResult is the outcome of importing one row.
+type RowResult struct {
+	// Line is the line number of the row in the file, counting the header
+	// as line 1
+	Line int
+
+	// AssetTag is the asset tag of the row, if it has one
+	AssetTag string
+
+	// Action is what was done with the row
+	Action Action
+
+	// AssetID is the ID of the created or updated asset, or 0 if the row failed
+	AssetID int
+
+	// Err is the reason the row failed, or nil
+	Err error
+}
+
+// Report is the outcome of an import, with one result per row in the
+// order of the file.
+type Report struct {
+	Rows []RowResult
+}
+
+// Count returns the number of rows with the given action.
+func (r *Report) Count(action Action) int {
+	n := 0
+	for _, row := range r.Rows {
+		if row.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// Failed returns the results of the rows that failed.
+func (r *Report) Failed() []RowResult {
+	var failed []RowResult
+	for _, row := range r.Rows {
+		if row.Action == ActionFailed {
+			failed = append(failed, row)
+		}
+	}
+	return failed
+}
+
+// Err returns an error joining the errors of the failed rows, or nil if
+// every row was imported.
+func (r *Report) Err() error {
+	var errs []error
+	for _, row := range r.Failed() {
+		errs = append(errs, fmt.Errorf("line %d: %w", row.Line, row.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// Import creates or updates an asset for each row of the CSV file read
+// from r, whose first row holds the column headers.
+//
+// The returned error reports a file that cannot be read, such as a
+// malformed row or a mapping naming an unknown field; nothing is imported
+// then. Failures of individual rows, including names that cannot be
+// resolved and requests rejected by Snipe-IT, are reported in the Report,
+// and do not stop the import. Rows not yet started when ctx is canceled
+// fail with the context's error.
+func Import(ctx context.Context, client *snipeit.Client, r io.Reader, opts *Options) (*Report, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	reader := csv.NewReader(r)
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("importer: reading CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return &Report{}, nil
+	}
+
+	columns, err := mapColumns(records[0], opts.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	imp := &importer{client: client, mode: opts.Mode, resolver: snipeit.NewResolver(client, 0)}
+	rows := records[1:]
+	report := &Report{Rows: make([]RowResult, len(rows))}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, record := range rows {
+		row := parseRow(columns, record)
+		result := &report.Rows[i]
+		result.Line = i + 2
+		result.AssetTag = row.values[FieldAssetTag]
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			result.Action, result.Err = ActionFailed, ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			result.Action, result.AssetID, result.Err = imp.importRow(ctx, row)
+		}()
+	}
+	wg.Wait()
+
+	return report, nil
+}
+
+// column is a mapped column of the file.
+type column struct {
+	index int
+	field string
+}
+
+// mapColumns returns the mapped columns of the file from its header.
+func mapColumns(header []string, mapping Mapping) ([]column, error) {
+	var columns []column
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+
+		var field string
+		if mapping != nil {
+			field = mapping[name]
+		} else {
+			field = strings.ToLower(strings.ReplaceAll(name, " ", "_"))
+			if strings.HasPrefix(field, "_snipeit_") {
+				field = CustomField(name)
+			}
+		}
+		if field == "" {
+			continue
+		}
+
+		if !knownField(field) {
+			if mapping != nil {
+				return nil, fmt.Errorf("importer: column %q is mapped to unknown field %q", name, field)
+			}
+			continue
+		}
+		columns = append(columns, column{index: i, field: field})
+	}
+	return columns, nil
+}
+
+// knownField reports whether field is a Field constant or a custom field.
+func knownField(field string) bool {
+	if strings.HasPrefix(field, customFieldPrefix) {
+		return len(field) > len(customFieldPrefix)
+	}
+	switch field {
+	case FieldAssetTag, FieldName, FieldSerial, FieldModel, FieldModelID,
+		FieldStatus, FieldStatusID, FieldLocation, FieldLocationID,
+		FieldRTDLocation, FieldRTDLocationID, FieldSupplier, FieldSupplierID,
+		FieldCompanyID, FieldOrderNumber, FieldPurchaseDate, FieldPurchaseCost,
+		FieldWarrantyMonths, FieldNextAuditDate, FieldRequestable, FieldNotes:
+		return true
+	}
+	return false
+}
+
+// row holds the non-empty values of a row, keyed by field, and the values
+// of its custom fields, keyed by database column.
+type row struct {
+	values map[string]string
+	custom map[string]string
+}
+
+// parseRow returns the mapped values of record.
+func parseRow(columns []column, record []string) row {
+	r := row{values: map[string]string{}, custom: map[string]string{}}
+	for _, col := range columns {
+		if col.index >= len(record) {
+			continue
+		}
+		value := strings.TrimSpace(record[col.index])
+		if value == "" {
+			continue
+		}
+		if name, ok := strings.CutPrefix(col.field, customFieldPrefix); ok {
+			r.custom[name] = value
+		} else {
+			r.values[col.field] = value
+		}
+	}
+	return r
+}
+
+// importer imports the rows of a file.
+type importer struct {
+	client   *snipeit.Client
+	mode     Mode
+	resolver *snipeit.Resolver
+}
+
+// importRow creates or updates the asset of a row.
+func (imp *importer) importRow(ctx context.Context, r row) (Action, int, error) {
+	fields, err := imp.resolve(ctx, r)
+	if err != nil {
+		return ActionFailed, 0, err
+	}
+
+	existing, err := imp.existing(ctx, r.values[FieldAssetTag])
+	if err != nil {
+		return ActionFailed, 0, err
+	}
+
+	switch {
+	case existing != 0 && imp.mode == CreateOnly:
+		return ActionFailed, 0, fmt.Errorf("asset tag %q already exists", r.values[FieldAssetTag])
+	case existing == 0 && imp.mode == UpdateOnly:
+		return ActionFailed, 0, fmt.Errorf("no asset with tag %q", r.values[FieldAssetTag])
+	case existing != 0:
+		update, err := updateRequest(fields, r.custom)
+		if err != nil {
+			return ActionFailed, 0, err
+		}
+		if _, _, err := imp.client.Assets.UpdateContext(ctx, existing, update); err != nil {
+			return ActionFailed, 0, err
+		}
+		return ActionUpdated, existing, nil
+	}
+
+	create, err := createRequest(fields, r.custom)
+	if err != nil {
+		return ActionFailed, 0, err
+	}
+	asset, _, err := imp.client.Assets.CreateContext(ctx, create)
+	if err != nil {
+		return ActionFailed, 0, err
+	}
+	return ActionCreated, asset.ID, nil
+}
+
+// resolve returns the values of a row with the names of related resources
+// replaced by their IDs.
+func (imp *importer) resolve(ctx context.Context, r row) (map[string]string, error) {
+	fields := make(map[string]string, len(r.values))
+	for field, value := range r.values {
+		fields[field] = value
+	}
+
+	for _, ref := range []struct {
+		field, idField string
+		resolve        func(*snipeit.Resolver, context.Context, string) (int, error)
+	}{
+		{FieldModel, FieldModelID, (*snipeit.Resolver).ModelID},
+		{FieldStatus, FieldStatusID, (*snipeit.Resolver).StatusLabelID},
+		{FieldLocation, FieldLocationID, (*snipeit.Resolver).LocationID},
+		{FieldRTDLocation, FieldRTDLocationID, (*snipeit.Resolver).LocationID},
+		{FieldSupplier, FieldSupplierID, (*snipeit.Resolver).SupplierID},
+	} {
+		name, ok := fields[ref.field]
+		if !ok {
+			continue
+		}
+		delete(fields, ref.field)
+		if _, ok := fields[ref.idField]; ok {
+			continue
+		}
+		id, err := ref.resolve(imp.resolver, ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		fields[ref.idField] = strconv.Itoa(id)
+	}
+	return fields, nil
+}
+
+// existing returns the ID of the asset with tag, or 0 if there is none
+// or tag is empty.
+func (imp *importer) existing(ctx context.Context, tag string) (int, error) {
+	if tag == "" {
+		return 0, nil
+	}
+
+	asset, _, err := imp.client.Assets.GetAssetByTagContext(ctx, tag)
+	var errorResponse *snipeit.ErrorResponse
+	switch {
+	case err == nil:
+		return asset.ID, nil
+	case errors.Is(err, snipeit.ErrNotFound):
+		return 0, nil
+	case errors.As(err, &errorResponse) && errorResponse.Response != nil && errorResponse.Response.StatusCode == http.StatusOK:
+		// Snipe-IT reports unknown tags with a 200 response whose status
+		// is "error"
+		return 0, nil
+	}
+	return 0, err
+}
+
+// createRequest builds the request creating an asset from the resolved
+// fields of a row.
+func createRequest(fields, custom map[string]string) (snipeit.AssetCreateRequest, error) {
+	var req snipeit.AssetCreateRequest
+	var err error
+	set := func(field string, parse func(string) error) {
+		if value, ok := fields[field]; ok && err == nil {
+			if perr := parse(value); perr != nil {
+				err = fmt.Errorf("%s: %w", field, perr)
+			}
+		}
+	}
+	str := func(dst *string) func(string) error {
+		return func(value string) error { *dst = value; return nil }
+	}
+	num := func(dst *int) func(string) error {
+		return func(value string) (err error) { *dst, err = parseInt(value); return err }
+	}
+
+	set(FieldAssetTag, str(&req.AssetTag))
+	set(FieldName, str(&req.Name))
+	set(FieldSerial, str(&req.Serial))
+	set(FieldOrderNumber, str(&req.OrderNumber))
+	set(FieldNotes, str(&req.Notes))
+	set(FieldModelID, num(&req.ModelID))
+	set(FieldStatusID, num(&req.StatusID))
+	set(FieldLocationID, num(&req.LocationID))
+	set(FieldRTDLocationID, num(&req.RTDLocationID))
+	set(FieldSupplierID, num(&req.SupplierID))
+	set(FieldCompanyID, num(&req.CompanyID))
+	set(FieldWarrantyMonths, num(&req.WarrantyMonths))
+	set(FieldPurchaseDate, func(value string) error {
+		d, err := snipeit.ParseDate(value)
+		req.PurchaseDate = &d
+		return err
+	})
+	set(FieldNextAuditDate, func(value string) error {
+		d, err := snipeit.ParseDate(value)
+		req.NextAuditDate = &d
+		return err
+	})
+	set(FieldPurchaseCost, func(value string) error {
+		m, err := snipeit.ParseMoney(value)
+		req.PurchaseCost = &m
+		return err
+	})
+	set(FieldRequestable, func(value string) (err error) {
+		req.Requestable, err = parseBool(value)
+		return err
+	})
+	if len(custom) > 0 {
+		req.CustomFieldValues = custom
+	}
+	return req, err
+}
+
+// updateRequest builds the request updating an asset from the resolved
+// fields of a row. Only the fields the row has a value for are changed.
+func updateRequest(fields, custom map[string]string) (snipeit.AssetUpdateRequest, error) {
+	create, err := createRequest(fields, custom)
+	if err != nil {
+		return snipeit.AssetUpdateRequest{}, err
+	}
+
+	var req snipeit.AssetUpdateRequest
+	has := func(field string) bool {
+		_, ok := fields[field]
+		return ok
+	}
+	if has(FieldAssetTag) {
+		req.AssetTag = snipeit.NewNullable(create.AssetTag)
+	}
+	if has(FieldName) {
+		req.Name = snipeit.NewNullable(create.Name)
+	}
+	if has(FieldSerial) {
+		req.Serial = snipeit.NewNullable(create.Serial)
+	}
+	if has(FieldOrderNumber) {
+		req.OrderNumber = snipeit.NewNullable(create.OrderNumber)
+	}
+	if has(FieldNotes) {
+		req.Notes = snipeit.NewNullable(create.Notes)
+	}
+	if has(FieldModelID) {
+		req.ModelID = snipeit.NewNullable(create.ModelID)
+	}
+	if has(FieldStatusID) {
+		req.StatusID = snipeit.NewNullable(create.StatusID)
+	}
+	if has(FieldLocationID) {
+		req.LocationID = snipeit.NewNullable(create.LocationID)
+	}
+	if has(FieldRTDLocationID) {
+		req.RTDLocationID = snipeit.NewNullable(create.RTDLocationID)
+	}
+	if has(FieldSupplierID) {
+		req.SupplierID = snipeit.NewNullable(create.SupplierID)
+	}
+	if has(FieldCompanyID) {
+		req.CompanyID = snipeit.NewNullable(create.CompanyID)
+	}
+	if has(FieldWarrantyMonths) {
+		req.WarrantyMonths = snipeit.NewNullable(create.WarrantyMonths)
+	}
+	if has(FieldPurchaseDate) {
+		req.PurchaseDate = snipeit.NewNullable(*create.PurchaseDate)
+	}
+	if has(FieldNextAuditDate) {
+		req.NextAuditDate = snipeit.NewNullable(*create.NextAuditDate)
+	}
+	if has(FieldPurchaseCost) {
+		req.PurchaseCost = snipeit.NewNullable(*create.PurchaseCost)
+	}
+	if has(FieldRequestable) {
+		req.Requestable = snipeit.NewNullable(create.Requestable)
+	}
+	req.CustomFieldValues = create.CustomFieldValues
+	return req, nil
+}
+
+// parseInt parses an integer, allowing a trailing unit such as "36 months".
+func parseInt(value string) (int, error) {
+	if fields := strings.Fields(value); len(fields) > 0 {
+		value = fields[0]
+	}
+	return strconv.Atoi(value)
+}
+
+// parseBool parses a flag in any of the forms snipeit.Bool accepts.
+func parseBool(value string) (bool, error) {
+	var b snipeit.Bool
+	err := b.UnmarshalJSON([]byte(strconv.Quote(value)))
+	return bool(b), err
+}
@@ -0,0 +1,207 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// server is a fake Snipe-IT server recording the assets it is sent.
+type server struct {
+	mu       sync.Mutex
+	created  []map[string]interface{}
+	updated  map[string]map[string]interface{}
+	searches map[string]int
+}
+
+func setup(t *testing.T) (*snipeit.Client, *server) {
+	t.Helper()
+	s := &server{updated: map[string]map[string]interface{}{}, searches: map[string]int{}}
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/v1/statuslabels", func(w http.ResponseWriter, r *http.Request) {
+		s.count("statuslabels")
+		fmt.Fprint(w, `{"total": 2, "rows": [{"id": 1, "name": "Ready to Deploy"}, {"id": 2, "name": "Pending"}]}`)
+	})
+	mux.HandleFunc("/api/v1/models", func(w http.ResponseWriter, r *http.Request) {
+		s.count("models")
+		fmt.Fprint(w, `{"total": 2, "rows": [{"id": 8, "name": "Latitude 5520 Rugged"}, {"id": 7, "name": "Latitude 5520"}]}`)
+	})
+	mux.HandleFunc("/api/v1/locations", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 4, "name": "HQ"}]}`)
+	})
+	mux.HandleFunc("/api/v1/hardware/bytag/", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/AT-1") {
+			fmt.Fprint(w, `{"id": 10, "asset_tag": "AT-1"}`)
+			return
+		}
+		fmt.Fprint(w, `{"status": "error", "messages": "Asset does not exist."}`)
+	})
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		s.mu.Lock()
+		s.created = append(s.created, body)
+		id := 100 + len(s.created)
+		s.mu.Unlock()
+		fmt.Fprintf(w, `{"status": "success", "payload": {"id": %d}}`, id)
+	})
+	mux.HandleFunc("/api/v1/hardware/10", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		s.mu.Lock()
+		s.updated[r.Method] = body
+		s.mu.Unlock()
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 10}}`)
+	})
+
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	client, err := snipeit.NewClientWithOptions(ts.URL, "test-token", &snipeit.ClientOptions{DisableRetries: true})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	return client, s
+}
+
+func (s *server) count(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.searches[key]++
+}
+
+func TestImport(t *testing.T) {
+	client, s := setup(t)
+
+	file := "Tag,Model,Status,Location,Cost,Purchased,MAC,Ignored\n" +
+		"AT-1,Latitude 5520,Ready to Deploy,HQ,,,,x\n" +
+		"AT-2,latitude 5520,Pending,,\"1,299.00\",2024-05-01,00:11:22:33:44:55,x\n" +
+		"AT-3,Unknown Model,Pending,,,,,x\n"
+
+	report, err := Import(context.Background(), client, strings.NewReader(file), &Options{
+		Mapping: Mapping{
+			"Tag":       FieldAssetTag,
+			"Model":     FieldModel,
+			"Status":    FieldStatus,
+			"Location":  FieldLocation,
+			"Cost":      FieldPurchaseCost,
+			"Purchased": FieldPurchaseDate,
+			"MAC":       CustomField("mac_address_1"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Import returned error: %v", err)
+	}
+
+	if len(report.Rows) != 3 {
+		t.Fatalf("Import reported %d rows, expected %d", len(report.Rows), 3)
+	}
+	expected := []struct {
+		line    int
+		action  Action
+		assetID int
+	}{
+		{2, ActionUpdated, 10},
+		{3, ActionCreated, 101},
+		{4, ActionFailed, 0},
+	}
+	for i, e := range expected {
+		row := report.Rows[i]
+		if row.Line != e.line || row.Action != e.action || row.AssetID != e.assetID {
+			t.Errorf("Rows[%d] = %+v, expected line %d %s with asset %d", i, row, e.line, e.action, e.assetID)
+		}
+	}
+	if err := report.Rows[2].Err; !errors.Is(err, snipeit.ErrNotFound) || !strings.Contains(err.Error(), `model "Unknown Model"`) {
+		t.Errorf("Rows[2].Err = %v, expected an unknown model error", err)
+	}
+	if report.Count(ActionFailed) != 1 || report.Err() == nil {
+		t.Errorf("Report counts %d failed rows with error %v, expected 1", report.Count(ActionFailed), report.Err())
+	}
+
+	update := s.updated[http.MethodPut]
+	if update["model_id"] != 7.0 || update["status_id"] != 1.0 || update["location_id"] != 4.0 {
+		t.Errorf("Update body = %v, expected model 7, status 1 and location 4", update)
+	}
+	if _, ok := update["purchase_cost"]; ok {
+		t.Errorf("Update body = %v, expected empty columns to be left unchanged", update)
+	}
+
+	if len(s.created) != 1 {
+		t.Fatalf("Server received %d creates, expected %d", len(s.created), 1)
+	}
+	create := s.created[0]
+	if create["asset_tag"] != "AT-2" || create["model_id"] != 7.0 || create["status_id"] != 2.0 ||
+		create["purchase_cost"] != 1299.0 || create["purchase_date"] != "2024-05-01" ||
+		create["_snipeit_mac_address_1"] != "00:11:22:33:44:55" {
+		t.Errorf("Create body = %v, expected the resolved and parsed row", create)
+	}
+
+	// Each kind of resource is listed once per import
+	if s.searches["statuslabels"] != 1 || s.searches["models"] != 1 {
+		t.Errorf("Server received lookups %v, expected one of each", s.searches)
+	}
+}
+
+func TestImportDefaultMapping(t *testing.T) {
+	client, s := setup(t)
+
+	file := "Asset Tag,Model ID,Status ID,_snipeit_mac_address_1\nAT-5,7,1,aa:bb\n"
+	report, err := Import(context.Background(), client, strings.NewReader(file), &Options{Mode: CreateOnly})
+	if err != nil {
+		t.Fatalf("Import returned error: %v", err)
+	}
+	if err := report.Err(); err != nil {
+		t.Fatalf("Import reported error: %v", err)
+	}
+
+	create := s.created[0]
+	if create["asset_tag"] != "AT-5" || create["model_id"] != 7.0 || create["_snipeit_mac_address_1"] != "aa:bb" {
+		t.Errorf("Create body = %v, expected the row mapped by header", create)
+	}
+}
+
+func TestImportModes(t *testing.T) {
+	client, _ := setup(t)
+
+	file := "asset_tag,model_id,status_id\nAT-1,7,1\nAT-2,7,1\n"
+	report, err := Import(context.Background(), client, strings.NewReader(file), &Options{Mode: CreateOnly})
+	if err != nil {
+		t.Fatalf("Import returned error: %v", err)
+	}
+	if report.Rows[0].Action != ActionFailed || report.Rows[1].Action != ActionCreated {
+		t.Errorf("CreateOnly import reported %+v, expected AT-1 to fail and AT-2 to be created", report.Rows)
+	}
+
+	report, err = Import(context.Background(), client, strings.NewReader(file), &Options{Mode: UpdateOnly})
+	if err != nil {
+		t.Fatalf("Import returned error: %v", err)
+	}
+	if report.Rows[0].Action != ActionUpdated || report.Rows[1].Action != ActionFailed {
+		t.Errorf("UpdateOnly import reported %+v, expected AT-1 to be updated and AT-2 to fail", report.Rows)
+	}
+}
+
+func TestImportInvalidFile(t *testing.T) {
+	client, _ := setup(t)
+
+	_, err := Import(context.Background(), client, strings.NewReader("Tag\nAT-1\n"), &Options{
+		Mapping: Mapping{"Tag": "asset_tags"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "unknown field") {
+		t.Errorf("Import returned error %v, expected an unknown field error", err)
+	}
+
+	_, err = Import(context.Background(), client, strings.NewReader("a,b\n\"unterminated\n"), nil)
+	if err == nil {
+		t.Error("Import of a malformed file returned nil error")
+	}
+}
@@ -0,0 +1,1087 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/http"
+	"time"
+)
+
+// ClientInterface is the interface of Client, so that code using the client
+// can be tested with a mock. Each service is returned as the interface it
+// implements:
+//
+//	func countAssets(ctx context.Context, client snipeit.ClientInterface) (int, error) {
+//	    assets, _, err := client.AssetsAPI().ListContext(ctx, &snipeit.ListOptions{Limit: 1})
+//	    if err != nil {
+//	        return 0, err
+//	    }
+//	    return assets.Total, nil
+//	}
+type ClientInterface interface {
+	// AccessoriesAPI returns the Accessories service
+	AccessoriesAPI() AccessoriesAPI
+
+	// AssetsAPI returns the Assets service
+	AssetsAPI() AssetsAPI
+
+	// CategoriesAPI returns the Categories service
+	CategoriesAPI() CategoriesAPI
+
+	// CompaniesAPI returns the Companies service
+	CompaniesAPI() CompaniesAPI
+
+	// ConsumablesAPI returns the Consumables service
+	ConsumablesAPI() ConsumablesAPI
+
+	// DepartmentsAPI returns the Departments service
+	DepartmentsAPI() DepartmentsAPI
+
+	// DepreciationsAPI returns the Depreciations service
+	DepreciationsAPI() DepreciationsAPI
+
+	// CustomFieldsAPI returns the Fields service
+	CustomFieldsAPI() CustomFieldsAPI
+
+	// FieldsetsAPI returns the Fieldsets service
+	FieldsetsAPI() FieldsetsAPI
+
+	// KitsAPI returns the Kits service
+	KitsAPI() KitsAPI
+
+	// LicensesAPI returns the Licenses service
+	LicensesAPI() LicensesAPI
+
+	// LocationsAPI returns the Locations service
+	LocationsAPI() LocationsAPI
+
+	// MaintenancesAPI returns the Maintenances service
+	MaintenancesAPI() MaintenancesAPI
+
+	// ManufacturersAPI returns the Manufacturers service
+	ManufacturersAPI() ManufacturersAPI
+
+	// ModelsAPI returns the Models service
+	ModelsAPI() ModelsAPI
+
+	// ReportsAPI returns the Reports service
+	ReportsAPI() ReportsAPI
+
+	// SettingsAPI returns the Settings service
+	SettingsAPI() SettingsAPI
+
+	// StatusLabelsAPI returns the StatusLabels service
+	StatusLabelsAPI() StatusLabelsAPI
+
+	// SuppliersAPI returns the Suppliers service
+	SuppliersAPI() SuppliersAPI
+
+	// UsersAPI returns the Users service
+	UsersAPI() UsersAPI
+
+	// Ping performs a cheap authenticated request
+	Ping() (*PingResult, error)
+	PingContext(ctx context.Context) (*PingResult, error)
+
+	// Version returns the version of the Snipe-IT instance
+	Version() (*VersionInfo, error)
+	VersionContext(ctx context.Context) (*VersionInfo, error)
+}
+
+// Interfaces implemented by the client and its services.
+var (
+	_ ClientInterface  = (*Client)(nil)
+	_ AccessoriesAPI   = (*AccessoriesService)(nil)
+	_ AssetsAPI        = (*AssetsService)(nil)
+	_ CategoriesAPI    = (*CategoriesService)(nil)
+	_ CompaniesAPI     = (*CompaniesService)(nil)
+	_ ConsumablesAPI   = (*ConsumablesService)(nil)
+	_ DepartmentsAPI   = (*DepartmentsService)(nil)
+	_ DepreciationsAPI = (*DepreciationsService)(nil)
+	_ CustomFieldsAPI  = (*CustomFieldsService)(nil)
+	_ FieldsetsAPI     = (*FieldsetsService)(nil)
+	_ KitsAPI          = (*KitsService)(nil)
+	_ LicensesAPI      = (*LicensesService)(nil)
+	_ LocationsAPI     = (*LocationsService)(nil)
+	_ MaintenancesAPI  = (*MaintenancesService)(nil)
+	_ ManufacturersAPI = (*ManufacturersService)(nil)
+	_ ModelsAPI        = (*ModelsService)(nil)
+	_ ReportsAPI       = (*ReportsService)(nil)
+	_ SettingsAPI      = (*SettingsService)(nil)
+	_ StatusLabelsAPI  = (*StatusLabelsService)(nil)
+	_ SuppliersAPI     = (*SuppliersService)(nil)
+	_ UsersAPI         = (*UsersService)(nil)
+)
+
+// AccessoriesAPI returns c.Accessories.
+func (c *Client) AccessoriesAPI() AccessoriesAPI {
+	return c.Accessories
+}
+
+// AssetsAPI returns c.Assets.
+func (c *Client) AssetsAPI() AssetsAPI {
+	return c.Assets
+}
+
+// CategoriesAPI returns c.Categories.
+func (c *Client) CategoriesAPI() CategoriesAPI {
+	return c.Categories
+}
+
+// CompaniesAPI returns c.Companies.
+func (c *Client) CompaniesAPI() CompaniesAPI {
+	return c.Companies
+}
+
+// ConsumablesAPI returns c.Consumables.
+func (c *Client) ConsumablesAPI() ConsumablesAPI {
+	return c.Consumables
+}
+
+// DepartmentsAPI returns c.Departments.
+func (c *Client) DepartmentsAPI() DepartmentsAPI {
+	return c.Departments
+}
+
+// DepreciationsAPI returns c.Depreciations.
+func (c *Client) DepreciationsAPI() DepreciationsAPI {
+	return c.Depreciations
+}
+
+// CustomFieldsAPI returns c.Fields.
+func (c *Client) CustomFieldsAPI() CustomFieldsAPI {
+	return c.Fields
+}
+
+// FieldsetsAPI returns c.Fieldsets.
+func (c *Client) FieldsetsAPI() FieldsetsAPI {
+	return c.Fieldsets
+}
+
+// KitsAPI returns c.Kits.
+func (c *Client) KitsAPI() KitsAPI {
+	return c.Kits
+}
+
+// LicensesAPI returns c.Licenses.
+func (c *Client) LicensesAPI() LicensesAPI {
+	return c.Licenses
+}
+
+// LocationsAPI returns c.Locations.
+func (c *Client) LocationsAPI() LocationsAPI {
+	return c.Locations
+}
+
+// MaintenancesAPI returns c.Maintenances.
+func (c *Client) MaintenancesAPI() MaintenancesAPI {
+	return c.Maintenances
+}
+
+// ManufacturersAPI returns c.Manufacturers.
+func (c *Client) ManufacturersAPI() ManufacturersAPI {
+	return c.Manufacturers
+}
+
+// ModelsAPI returns c.Models.
+func (c *Client) ModelsAPI() ModelsAPI {
+	return c.Models
+}
+
+// ReportsAPI returns c.Reports.
+func (c *Client) ReportsAPI() ReportsAPI {
+	return c.Reports
+}
+
+// SettingsAPI returns c.Settings.
+func (c *Client) SettingsAPI() SettingsAPI {
+	return c.Settings
+}
+
+// StatusLabelsAPI returns c.StatusLabels.
+func (c *Client) StatusLabelsAPI() StatusLabelsAPI {
+	return c.StatusLabels
+}
+
+// SuppliersAPI returns c.Suppliers.
+func (c *Client) SuppliersAPI() SuppliersAPI {
+	return c.Suppliers
+}
+
+// UsersAPI returns c.Users.
+func (c *Client) UsersAPI() UsersAPI {
+	return c.Users
+}
+
+// AccessoriesAPI is the interface of AccessoriesService, so that code using the
+// service can be tested with a mock.
+type AccessoriesAPI interface {
+	// List returns a list of accessories with pagination options.
+	List(opts *ListOptions) (*AccessoriesResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*AccessoriesResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every accessory, fetching pages lazily as the
+	// caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[Accessory, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Accessory, error]
+
+	// Get fetches a single accessory by its ID.
+	Get(id int) (*AccessoryResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*AccessoryResponse, *http.Response, error)
+
+	// Create creates a new accessory in Snipe-IT.
+	Create(accessory Accessory) (*AccessoryResponse, *http.Response, error)
+	CreateContext(ctx context.Context, accessory Accessory) (*AccessoryResponse, *http.Response, error)
+
+	// Update updates an existing accessory in Snipe-IT.
+	Update(id int, accessory Accessory) (*AccessoryResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, accessory Accessory) (*AccessoryResponse, *http.Response, error)
+
+	// Delete deletes an accessory from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+
+	// Checkout checks out one unit of an accessory to a user.
+	Checkout(id, userID int, note string) (*AccessoryResponse, *http.Response, error)
+	CheckoutContext(ctx context.Context, id, userID int, note string) (*AccessoryResponse, *http.Response, error)
+
+	// Checkin checks a single checked-out unit of an accessory back in.
+	Checkin(pivotID int) (*http.Response, error)
+	CheckinContext(ctx context.Context, pivotID int) (*http.Response, error)
+
+	// CheckedOut returns the users an accessory is currently checked out to.
+	CheckedOut(id int) (*AccessoryCheckoutsResponse, *http.Response, error)
+	CheckedOutContext(ctx context.Context, id int) (*AccessoryCheckoutsResponse, *http.Response, error)
+}
+
+// AssetsAPI is the interface of AssetsService, so that code using the service
+// can be tested with a mock.
+type AssetsAPI interface {
+	// List returns a list of assets with pagination options.
+	List(opts *ListOptions) (*AssetsResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every asset, fetching pages lazily as the
+	// caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[Asset, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Asset, error]
+
+	// Query returns an iterator over the assets matching q, fetching pages
+	// lazily as the caller ranges over it.
+	Query(q AssetQuery) iter.Seq2[Asset, error]
+	QueryContext(ctx context.Context, q AssetQuery) iter.Seq2[Asset, error]
+
+	// Get fetches a single asset by its ID.
+	Get(id int) (*AssetResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*AssetResponse, *http.Response, error)
+
+	// Create creates a new asset in Snipe-IT.
+	Create(asset AssetCreateRequest) (*AssetResponse, *http.Response, error)
+	CreateContext(ctx context.Context, asset AssetCreateRequest) (*AssetResponse, *http.Response, error)
+
+	// Update updates an existing asset in Snipe-IT.
+	Update(id int, asset AssetUpdateRequest) (*AssetResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, asset AssetUpdateRequest) (*AssetResponse, *http.Response, error)
+
+	// Patch partially updates an existing asset in Snipe-IT.
+	Patch(id int, fields map[string]interface{}) (*AssetResponse, *http.Response, error)
+	PatchContext(ctx context.Context, id int, fields map[string]interface{}) (*AssetResponse, *http.Response, error)
+
+	// SetCustomField sets the value of a single custom field of an asset, leaving
+	// its other fields unchanged.
+	SetCustomField(id int, column, value string) (*AssetResponse, *http.Response, error)
+	SetCustomFieldContext(ctx context.Context, id int, column, value string) (*AssetResponse, *http.Response, error)
+
+	// Delete deletes an asset from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+
+	// Checkout assigns an asset to a user, location, or another asset.
+	Checkout(id int, checkout map[string]interface{}) (*AssetResponse, *http.Response, error)
+	CheckoutContext(ctx context.Context, id int, checkout map[string]interface{}) (*AssetResponse, *http.Response, error)
+
+	// CheckoutWithOptions assigns an asset to a user, location, or another asset.
+	CheckoutWithOptions(id int, opts CheckoutOptions) (*AssetResponse, *http.Response, error)
+	CheckoutWithOptionsContext(ctx context.Context, id int, opts CheckoutOptions) (*AssetResponse, *http.Response, error)
+
+	// Checkin returns an asset from a user, location, or asset it was assigned to.
+	Checkin(id int, checkin map[string]interface{}) (*AssetResponse, *http.Response, error)
+	CheckinContext(ctx context.Context, id int, checkin map[string]interface{}) (*AssetResponse, *http.Response, error)
+
+	// CheckinWithOptions returns an asset from a user, location, or asset it was
+	// assigned to.
+	CheckinWithOptions(id int, opts CheckinOptions) (*AssetResponse, *http.Response, error)
+	CheckinWithOptionsContext(ctx context.Context, id int, opts CheckinOptions) (*AssetResponse, *http.Response, error)
+
+	// GetAssetBySerial fetches assets by serial number.
+	GetAssetBySerial(serial string) (*AssetsResponse, *http.Response, error)
+	GetAssetBySerialContext(ctx context.Context, serial string) (*AssetsResponse, *http.Response, error)
+
+	// GetAssetByTag fetches a single asset by its asset tag.
+	GetAssetByTag(tag string) (*AssetResponse, *http.Response, error)
+	GetAssetByTagContext(ctx context.Context, tag string) (*AssetResponse, *http.Response, error)
+
+	// Audit records a physical audit of an asset.
+	Audit(tag string, locationID int, nextAuditDate time.Time) (*AssetAuditResponse, *http.Response, error)
+	AuditContext(ctx context.Context, tag string, locationID int, nextAuditDate time.Time) (*AssetAuditResponse, *http.Response, error)
+
+	// AuditDue returns the assets that are due for audit within the configured
+	// warning period.
+	AuditDue(opts *ListOptions) (*AssetsResponse, *http.Response, error)
+	AuditDueContext(ctx context.Context, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+
+	// AuditOverdue returns the assets whose next audit date has passed.
+	AuditOverdue(opts *ListOptions) (*AssetsResponse, *http.Response, error)
+	AuditOverdueContext(ctx context.Context, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+
+	// Requestable returns the assets that users are allowed to request.
+	Requestable(opts *ListOptions) (*AssetsResponse, *http.Response, error)
+	RequestableContext(ctx context.Context, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+
+	// Request places a checkout request for an asset on behalf of the user that
+	// owns the API token.
+	Request(id int) (*Response, *http.Response, error)
+	RequestContext(ctx context.Context, id int) (*Response, *http.Response, error)
+
+	// CancelRequest cancels a pending checkout request for an asset made by the
+	// user that owns the API token.
+	CancelRequest(id int) (*Response, *http.Response, error)
+	CancelRequestContext(ctx context.Context, id int) (*Response, *http.Response, error)
+
+	// DueForCheckin returns the checked-out assets whose expected checkin date
+	// falls within the given duration from now, including assets already overdue.
+	DueForCheckin(within time.Duration, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+	DueForCheckinContext(ctx context.Context, within time.Duration, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+
+	// Maintenances returns the maintenance records of an asset.
+	Maintenances(id int, opts *ListOptions) (*MaintenancesResponse, *http.Response, error)
+	MaintenancesContext(ctx context.Context, id int, opts *ListOptions) (*MaintenancesResponse, *http.Response, error)
+
+	// ListDeleted returns soft-deleted assets.
+	ListDeleted(opts *ListOptions) (*AssetsResponse, *http.Response, error)
+	ListDeletedContext(ctx context.Context, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+
+	// Purge permanently removes a soft-deleted asset from Snipe-IT.
+	Purge(id int) (*http.Response, error)
+	PurgeContext(ctx context.Context, id int) (*http.Response, error)
+
+	// BulkCheckout checks out several assets with the same options.
+	BulkCheckout(ids []int, opts CheckoutOptions) ([]BulkResult, error)
+	BulkCheckoutContext(ctx context.Context, ids []int, opts CheckoutOptions) ([]BulkResult, error)
+
+	// BulkDelete deletes several assets.
+	BulkDelete(ids []int) ([]BulkResult, error)
+	BulkDeleteContext(ctx context.Context, ids []int) ([]BulkResult, error)
+
+	// BulkUpdate applies the same partial update to several assets.
+	BulkUpdate(ids []int, fields map[string]interface{}) ([]BulkResult, error)
+	BulkUpdateContext(ctx context.Context, ids []int, fields map[string]interface{}) ([]BulkResult, error)
+
+	// ListPages calls fn with each page of assets, following pagination until
+	// every asset has been visited.
+	ListPages(opts *ListOptions, fn func(page *AssetsResponse) error) error
+	ListPagesContext(ctx context.Context, opts *ListOptions, fn func(page *AssetsResponse) error) error
+
+	// ListPagesFrom calls fn with each page of assets from cursor onwards,
+	// following pagination until every asset has been visited.
+	ListPagesFrom(cursor PageCursor, opts *ListOptions, fn func(page *AssetsResponse, next PageCursor) error) error
+	ListPagesFromContext(ctx context.Context, cursor PageCursor, opts *ListOptions, fn func(page *AssetsResponse, next PageCursor) error) error
+
+	// ListAll returns every asset, following pagination.
+	ListAll(opts *ListOptions) ([]Asset, error)
+	ListAllContext(ctx context.Context, opts *ListOptions) ([]Asset, error)
+
+	// Stream calls fn with every asset, following pagination.
+	Stream(opts *ListOptions, fn func(asset Asset) error) error
+	StreamContext(ctx context.Context, opts *ListOptions, fn func(asset Asset) error) error
+
+	// ListUpdatedSince returns the assets updated at or after t.
+	ListUpdatedSince(t time.Time, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+	ListUpdatedSinceContext(ctx context.Context, t time.Time, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+
+	// Sync calls fn with each asset updated since the cursor's watermark, oldest
+	// first, and advances the cursor past it.
+	Sync(cursor *Cursor, fn func(asset Asset) error) error
+	SyncContext(ctx context.Context, cursor *Cursor, fn func(asset Asset) error) error
+
+	// UpsertBySerial creates an asset, or updates the asset with the same serial
+	// number.
+	UpsertBySerial(asset AssetCreateRequest) (*UpsertResult, error)
+	UpsertBySerialContext(ctx context.Context, asset AssetCreateRequest) (*UpsertResult, error)
+
+	// UpsertByTag creates an asset, or updates the asset with the same asset tag.
+	UpsertByTag(asset AssetCreateRequest) (*UpsertResult, error)
+	UpsertByTagContext(ctx context.Context, asset AssetCreateRequest) (*UpsertResult, error)
+}
+
+// CategoriesAPI is the interface of CategoriesService, so that code using the
+// service can be tested with a mock.
+type CategoriesAPI interface {
+	// List returns a list of categories with pagination options.
+	List(opts *CategoryListOptions) (*CategoriesResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *CategoryListOptions) (*CategoriesResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every category, fetching pages lazily as
+	// the caller ranges over it.
+	Iterate(opts *CategoryListOptions) iter.Seq2[Category, error]
+	IterateContext(ctx context.Context, opts *CategoryListOptions) iter.Seq2[Category, error]
+
+	// Get fetches a single category by its ID.
+	Get(id int) (*CategoryResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*CategoryResponse, *http.Response, error)
+
+	// Create creates a new category in Snipe-IT.
+	Create(category Category) (*CategoryResponse, *http.Response, error)
+	CreateContext(ctx context.Context, category Category) (*CategoryResponse, *http.Response, error)
+
+	// Update updates an existing category in Snipe-IT.
+	Update(id int, category Category) (*CategoryResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, category Category) (*CategoryResponse, *http.Response, error)
+
+	// Delete deletes a category from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+
+	// FindOrCreate returns the ID of the category named name, creating it if there
+	// is none.
+	FindOrCreate(name string, template Category) (int, error)
+	FindOrCreateContext(ctx context.Context, name string, template Category) (int, error)
+}
+
+// CompaniesAPI is the interface of CompaniesService, so that code using the
+// service can be tested with a mock.
+type CompaniesAPI interface {
+	// List returns a list of companies with pagination options.
+	List(opts *ListOptions) (*CompaniesResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*CompaniesResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every company, fetching pages lazily as the
+	// caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[Company, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Company, error]
+
+	// Get fetches a single company by its ID.
+	Get(id int) (*CompanyResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*CompanyResponse, *http.Response, error)
+
+	// Create creates a new company in Snipe-IT.
+	Create(company Company) (*CompanyResponse, *http.Response, error)
+	CreateContext(ctx context.Context, company Company) (*CompanyResponse, *http.Response, error)
+
+	// Update updates an existing company in Snipe-IT.
+	Update(id int, company Company) (*CompanyResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, company Company) (*CompanyResponse, *http.Response, error)
+
+	// Delete deletes a company from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+}
+
+// ConsumablesAPI is the interface of ConsumablesService, so that code using the
+// service can be tested with a mock.
+type ConsumablesAPI interface {
+	// List returns a list of consumables with pagination options.
+	List(opts *ListOptions) (*ConsumablesResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*ConsumablesResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every consumable, fetching pages lazily as
+	// the caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[Consumable, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Consumable, error]
+
+	// Get fetches a single consumable by its ID.
+	Get(id int) (*ConsumableResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*ConsumableResponse, *http.Response, error)
+
+	// Create creates a new consumable in Snipe-IT.
+	Create(consumable Consumable) (*ConsumableResponse, *http.Response, error)
+	CreateContext(ctx context.Context, consumable Consumable) (*ConsumableResponse, *http.Response, error)
+
+	// Update updates an existing consumable in Snipe-IT.
+	Update(id int, consumable Consumable) (*ConsumableResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, consumable Consumable) (*ConsumableResponse, *http.Response, error)
+
+	// Delete deletes a consumable from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+
+	// Checkout checks out one unit of a consumable to a user.
+	Checkout(id, userID int) (*ConsumableResponse, *http.Response, error)
+	CheckoutContext(ctx context.Context, id, userID int) (*ConsumableResponse, *http.Response, error)
+
+	// Users returns the checkouts of a consumable, listing who each unit was
+	// checked out to.
+	Users(id int) (*ConsumableCheckoutsResponse, *http.Response, error)
+	UsersContext(ctx context.Context, id int) (*ConsumableCheckoutsResponse, *http.Response, error)
+}
+
+// DepartmentsAPI is the interface of DepartmentsService, so that code using the
+// service can be tested with a mock.
+type DepartmentsAPI interface {
+	// List returns a list of departments with pagination options.
+	List(opts *ListOptions) (*DepartmentsResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*DepartmentsResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every department, fetching pages lazily as
+	// the caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[Department, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Department, error]
+
+	// Get fetches a single department by its ID.
+	Get(id int) (*DepartmentResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*DepartmentResponse, *http.Response, error)
+
+	// Create creates a new department in Snipe-IT.
+	Create(department Department) (*DepartmentResponse, *http.Response, error)
+	CreateContext(ctx context.Context, department Department) (*DepartmentResponse, *http.Response, error)
+
+	// Update updates an existing department in Snipe-IT.
+	Update(id int, department Department) (*DepartmentResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, department Department) (*DepartmentResponse, *http.Response, error)
+
+	// Delete deletes a department from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+
+	// Users returns the users in a department.
+	Users(id int, opts *ListOptions) (*UsersResponse, *http.Response, error)
+	UsersContext(ctx context.Context, id int, opts *ListOptions) (*UsersResponse, *http.Response, error)
+}
+
+// DepreciationsAPI is the interface of DepreciationsService, so that code using
+// the service can be tested with a mock.
+type DepreciationsAPI interface {
+	// List returns a list of depreciations with pagination options.
+	List(opts *ListOptions) (*DepreciationsResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*DepreciationsResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every depreciation, fetching pages lazily
+	// as the caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[Depreciation, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Depreciation, error]
+
+	// Get fetches a single depreciation by its ID.
+	Get(id int) (*DepreciationResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*DepreciationResponse, *http.Response, error)
+
+	// Create creates a new depreciation in Snipe-IT.
+	Create(depreciation Depreciation) (*DepreciationResponse, *http.Response, error)
+	CreateContext(ctx context.Context, depreciation Depreciation) (*DepreciationResponse, *http.Response, error)
+
+	// Update updates an existing depreciation in Snipe-IT.
+	Update(id int, depreciation Depreciation) (*DepreciationResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, depreciation Depreciation) (*DepreciationResponse, *http.Response, error)
+
+	// Delete deletes a depreciation from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+}
+
+// CustomFieldsAPI is the interface of CustomFieldsService, so that code using
+// the service can be tested with a mock.
+type CustomFieldsAPI interface {
+	// List returns a list of custom fields with pagination options.
+	List(opts *ListOptions) (*CustomFieldsResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*CustomFieldsResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every custom field, fetching pages lazily
+	// as the caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[CustomField, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[CustomField, error]
+
+	// Get fetches a single custom field by its ID.
+	Get(id int) (*CustomFieldResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*CustomFieldResponse, *http.Response, error)
+
+	// Create creates a new custom field in Snipe-IT.
+	Create(field CustomField) (*CustomFieldResponse, *http.Response, error)
+	CreateContext(ctx context.Context, field CustomField) (*CustomFieldResponse, *http.Response, error)
+
+	// Update updates an existing custom field in Snipe-IT.
+	Update(id int, field CustomField) (*CustomFieldResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, field CustomField) (*CustomFieldResponse, *http.Response, error)
+
+	// Delete deletes a custom field from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+
+	// Associate adds a custom field to a fieldset.
+	Associate(id, fieldsetID int) (*FieldsetResponse, *http.Response, error)
+	AssociateContext(ctx context.Context, id, fieldsetID int) (*FieldsetResponse, *http.Response, error)
+
+	// Disassociate removes a custom field from a fieldset.
+	Disassociate(id, fieldsetID int) (*FieldsetResponse, *http.Response, error)
+	DisassociateContext(ctx context.Context, id, fieldsetID int) (*FieldsetResponse, *http.Response, error)
+}
+
+// FieldsetsAPI is the interface of FieldsetsService, so that code using the
+// service can be tested with a mock.
+type FieldsetsAPI interface {
+	// List returns a list of custom fieldsets, including the fields they contain.
+	List(opts *ListOptions) (*FieldsetsResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*FieldsetsResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every fieldset, fetching pages lazily as
+	// the caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[Fieldset, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Fieldset, error]
+
+	// Get fetches a single fieldset by its ID.
+	Get(id int) (*FieldsetResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*FieldsetResponse, *http.Response, error)
+
+	// Create creates a new custom fieldset in Snipe-IT.
+	Create(fieldset Fieldset) (*FieldsetResponse, *http.Response, error)
+	CreateContext(ctx context.Context, fieldset Fieldset) (*FieldsetResponse, *http.Response, error)
+
+	// Update updates an existing custom fieldset in Snipe-IT.
+	Update(id int, fieldset Fieldset) (*FieldsetResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, fieldset Fieldset) (*FieldsetResponse, *http.Response, error)
+
+	// Delete deletes a custom fieldset from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+
+	// Fields returns the custom fields that belong to a fieldset.
+	Fields(id int) (*CustomFieldsResponse, *http.Response, error)
+	FieldsContext(ctx context.Context, id int) (*CustomFieldsResponse, *http.Response, error)
+}
+
+// KitsAPI is the interface of KitsService, so that code using the service can
+// be tested with a mock.
+type KitsAPI interface {
+	// List returns a list of kits with pagination options.
+	List(opts *ListOptions) (*KitsResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*KitsResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every kit, fetching pages lazily as the
+	// caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[Kit, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Kit, error]
+
+	// Get fetches a single kit by its ID.
+	Get(id int) (*KitResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*KitResponse, *http.Response, error)
+
+	// Create creates a new kit in Snipe-IT.
+	Create(kit Kit) (*KitResponse, *http.Response, error)
+	CreateContext(ctx context.Context, kit Kit) (*KitResponse, *http.Response, error)
+
+	// Update updates an existing kit in Snipe-IT.
+	Update(id int, kit Kit) (*KitResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, kit Kit) (*KitResponse, *http.Response, error)
+
+	// Delete deletes a kit from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+
+	// Checkout checks out the contents of a kit to a user.
+	Checkout(id, userID int, note string) (*KitResponse, *http.Response, error)
+	CheckoutContext(ctx context.Context, id, userID int, note string) (*KitResponse, *http.Response, error)
+
+	// Models returns the models in a kit.
+	Models(id int) (*KitItemsResponse, *http.Response, error)
+	ModelsContext(ctx context.Context, id int) (*KitItemsResponse, *http.Response, error)
+
+	// AddModel adds a model to a kit.
+	AddModel(id, modelID, quantity int) (*KitResponse, *http.Response, error)
+	AddModelContext(ctx context.Context, id, modelID, quantity int) (*KitResponse, *http.Response, error)
+
+	// UpdateModel changes the quantity of a model in a kit.
+	UpdateModel(id, modelID, quantity int) (*KitResponse, *http.Response, error)
+	UpdateModelContext(ctx context.Context, id, modelID, quantity int) (*KitResponse, *http.Response, error)
+
+	// RemoveModel removes a model from a kit.
+	RemoveModel(id, modelID int) (*http.Response, error)
+	RemoveModelContext(ctx context.Context, id, modelID int) (*http.Response, error)
+
+	// Licenses returns the licenses in a kit.
+	Licenses(id int) (*KitItemsResponse, *http.Response, error)
+	LicensesContext(ctx context.Context, id int) (*KitItemsResponse, *http.Response, error)
+
+	// AddLicense adds a license to a kit.
+	AddLicense(id, licenseID, quantity int) (*KitResponse, *http.Response, error)
+	AddLicenseContext(ctx context.Context, id, licenseID, quantity int) (*KitResponse, *http.Response, error)
+
+	// UpdateLicense changes the quantity of a license in a kit.
+	UpdateLicense(id, licenseID, quantity int) (*KitResponse, *http.Response, error)
+	UpdateLicenseContext(ctx context.Context, id, licenseID, quantity int) (*KitResponse, *http.Response, error)
+
+	// RemoveLicense removes a license from a kit.
+	RemoveLicense(id, licenseID int) (*http.Response, error)
+	RemoveLicenseContext(ctx context.Context, id, licenseID int) (*http.Response, error)
+
+	// Accessories returns the accessories in a kit.
+	Accessories(id int) (*KitItemsResponse, *http.Response, error)
+	AccessoriesContext(ctx context.Context, id int) (*KitItemsResponse, *http.Response, error)
+
+	// AddAccessory adds an accessory to a kit.
+	AddAccessory(id, accessoryID, quantity int) (*KitResponse, *http.Response, error)
+	AddAccessoryContext(ctx context.Context, id, accessoryID, quantity int) (*KitResponse, *http.Response, error)
+
+	// UpdateAccessory changes the quantity of an accessory in a kit.
+	UpdateAccessory(id, accessoryID, quantity int) (*KitResponse, *http.Response, error)
+	UpdateAccessoryContext(ctx context.Context, id, accessoryID, quantity int) (*KitResponse, *http.Response, error)
+
+	// RemoveAccessory removes an accessory from a kit.
+	RemoveAccessory(id, accessoryID int) (*http.Response, error)
+	RemoveAccessoryContext(ctx context.Context, id, accessoryID int) (*http.Response, error)
+
+	// Consumables returns the consumables in a kit.
+	Consumables(id int) (*KitItemsResponse, *http.Response, error)
+	ConsumablesContext(ctx context.Context, id int) (*KitItemsResponse, *http.Response, error)
+
+	// AddConsumable adds a consumable to a kit.
+	AddConsumable(id, consumableID, quantity int) (*KitResponse, *http.Response, error)
+	AddConsumableContext(ctx context.Context, id, consumableID, quantity int) (*KitResponse, *http.Response, error)
+
+	// UpdateConsumable changes the quantity of a consumable in a kit.
+	UpdateConsumable(id, consumableID, quantity int) (*KitResponse, *http.Response, error)
+	UpdateConsumableContext(ctx context.Context, id, consumableID, quantity int) (*KitResponse, *http.Response, error)
+
+	// RemoveConsumable removes a consumable from a kit.
+	RemoveConsumable(id, consumableID int) (*http.Response, error)
+	RemoveConsumableContext(ctx context.Context, id, consumableID int) (*http.Response, error)
+}
+
+// LicensesAPI is the interface of LicensesService, so that code using the
+// service can be tested with a mock.
+type LicensesAPI interface {
+	// List returns a list of licenses with pagination and filter options.
+	List(opts *LicenseListOptions) (*LicensesResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *LicenseListOptions) (*LicensesResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every license, fetching pages lazily as the
+	// caller ranges over it.
+	Iterate(opts *LicenseListOptions) iter.Seq2[License, error]
+	IterateContext(ctx context.Context, opts *LicenseListOptions) iter.Seq2[License, error]
+
+	// Get fetches a single license by its ID.
+	Get(id int) (*LicenseResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*LicenseResponse, *http.Response, error)
+
+	// Create creates a new license in Snipe-IT.
+	Create(license License) (*LicenseResponse, *http.Response, error)
+	CreateContext(ctx context.Context, license License) (*LicenseResponse, *http.Response, error)
+
+	// Update updates an existing license in Snipe-IT.
+	Update(id int, license License) (*LicenseResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, license License) (*LicenseResponse, *http.Response, error)
+
+	// Delete deletes a license from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+
+	// UploadFile attaches a file to a license.
+	UploadFile(id int, filename string, r io.Reader, note string) (*Response, *http.Response, error)
+	UploadFileContext(ctx context.Context, id int, filename string, r io.Reader, note string) (*Response, *http.Response, error)
+
+	// Files returns the files attached to a license.
+	Files(id int, opts *ListOptions) (*FilesResponse, *http.Response, error)
+	FilesContext(ctx context.Context, id int, opts *ListOptions) (*FilesResponse, *http.Response, error)
+
+	// DownloadFile streams a file attached to a license to w.
+	DownloadFile(id, fileID int, w io.Writer) (*http.Response, error)
+	DownloadFileContext(ctx context.Context, id, fileID int, w io.Writer) (*http.Response, error)
+
+	// Seats returns the seats of a license with pagination options.
+	Seats(id int, opts *ListOptions) (*LicenseSeatsResponse, *http.Response, error)
+	SeatsContext(ctx context.Context, id int, opts *ListOptions) (*LicenseSeatsResponse, *http.Response, error)
+
+	// Seat fetches a single seat of a license.
+	Seat(id, seatID int) (*LicenseSeatResponse, *http.Response, error)
+	SeatContext(ctx context.Context, id, seatID int) (*LicenseSeatResponse, *http.Response, error)
+
+	// UpdateSeat updates a seat of a license, such as to check it out to a
+	// user or an asset, or to check it in.
+	UpdateSeat(id, seatID int, seat LicenseSeatUpdate) (*LicenseSeatResponse, *http.Response, error)
+	UpdateSeatContext(ctx context.Context, id, seatID int, seat LicenseSeatUpdate) (*LicenseSeatResponse, *http.Response, error)
+
+	// BulkAssign checks out a free seat of a license to each of several users.
+	BulkAssign(id int, userIDs []int) ([]SeatAssignment, error)
+	BulkAssignContext(ctx context.Context, id int, userIDs []int) ([]SeatAssignment, error)
+}
+
+// LocationsAPI is the interface of LocationsService, so that code using the
+// service can be tested with a mock.
+type LocationsAPI interface {
+	// FindOrCreate returns the ID of the location named name, creating it if there
+	// is none.
+	FindOrCreate(name string, template Location) (int, error)
+	FindOrCreateContext(ctx context.Context, name string, template Location) (int, error)
+
+	// List returns a list of locations with pagination options.
+	List(opts *ListOptions) (*LocationsResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*LocationsResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every location, fetching pages lazily as
+	// the caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[Location, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Location, error]
+
+	// Get fetches a single location by its ID.
+	Get(id int) (*LocationResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*LocationResponse, *http.Response, error)
+
+	// Create creates a new location in Snipe-IT.
+	Create(location Location) (*LocationResponse, *http.Response, error)
+	CreateContext(ctx context.Context, location Location) (*LocationResponse, *http.Response, error)
+
+	// Update updates an existing location in Snipe-IT.
+	Update(id int, location Location) (*LocationResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, location Location) (*LocationResponse, *http.Response, error)
+
+	// Delete deletes a location from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+}
+
+// MaintenancesAPI is the interface of MaintenancesService, so that code using
+// the service can be tested with a mock.
+type MaintenancesAPI interface {
+	// List returns a list of asset maintenances with pagination and filter
+	// options.
+	List(opts *MaintenanceListOptions) (*MaintenancesResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *MaintenanceListOptions) (*MaintenancesResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every maintenance, fetching pages lazily as
+	// the caller ranges over it.
+	Iterate(opts *MaintenanceListOptions) iter.Seq2[Maintenance, error]
+	IterateContext(ctx context.Context, opts *MaintenanceListOptions) iter.Seq2[Maintenance, error]
+
+	// Get fetches a single asset maintenance by its ID.
+	Get(id int) (*MaintenanceResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*MaintenanceResponse, *http.Response, error)
+
+	// Create records a new asset maintenance in Snipe-IT.
+	Create(maintenance Maintenance) (*MaintenanceResponse, *http.Response, error)
+	CreateContext(ctx context.Context, maintenance Maintenance) (*MaintenanceResponse, *http.Response, error)
+
+	// Update updates an existing asset maintenance in Snipe-IT.
+	Update(id int, maintenance Maintenance) (*MaintenanceResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, maintenance Maintenance) (*MaintenanceResponse, *http.Response, error)
+
+	// Delete deletes an asset maintenance from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+}
+
+// ManufacturersAPI is the interface of ManufacturersService, so that code using
+// the service can be tested with a mock.
+type ManufacturersAPI interface {
+	// FindOrCreate returns the ID of the manufacturer named name, creating it if
+	// there is none.
+	FindOrCreate(name string, template Manufacturer) (int, error)
+	FindOrCreateContext(ctx context.Context, name string, template Manufacturer) (int, error)
+
+	// List returns a list of manufacturers with pagination options.
+	List(opts *ListOptions) (*ManufacturersResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*ManufacturersResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every manufacturer, fetching pages lazily
+	// as the caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[Manufacturer, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Manufacturer, error]
+
+	// Get fetches a single manufacturer by its ID.
+	Get(id int) (*ManufacturerResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*ManufacturerResponse, *http.Response, error)
+
+	// Create creates a new manufacturer in Snipe-IT.
+	Create(manufacturer Manufacturer) (*ManufacturerResponse, *http.Response, error)
+	CreateContext(ctx context.Context, manufacturer Manufacturer) (*ManufacturerResponse, *http.Response, error)
+
+	// Update updates an existing manufacturer in Snipe-IT.
+	Update(id int, manufacturer Manufacturer) (*ManufacturerResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, manufacturer Manufacturer) (*ManufacturerResponse, *http.Response, error)
+
+	// Delete deletes a manufacturer from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+}
+
+// ModelsAPI is the interface of ModelsService, so that code using the service
+// can be tested with a mock.
+type ModelsAPI interface {
+	// List returns a list of models with pagination options.
+	List(opts *ListOptions) (*ModelsResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*ModelsResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every model, fetching pages lazily as the
+	// caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[Model, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Model, error]
+
+	// Get fetches a single model by its ID.
+	Get(id int) (*ModelResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*ModelResponse, *http.Response, error)
+
+	// Create creates a new model in Snipe-IT.
+	Create(model Model) (*ModelResponse, *http.Response, error)
+	CreateContext(ctx context.Context, model Model) (*ModelResponse, *http.Response, error)
+
+	// Update updates an existing model in Snipe-IT.
+	Update(id int, model Model) (*ModelResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, model Model) (*ModelResponse, *http.Response, error)
+
+	// Delete deletes a model from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+
+	// FindOrCreate returns the ID of the model named name, creating it if there is
+	// none.
+	FindOrCreate(name string, template Model) (int, error)
+	FindOrCreateContext(ctx context.Context, name string, template Model) (int, error)
+}
+
+// ReportsAPI is the interface of ReportsService, so that code using the service
+// can be tested with a mock.
+type ReportsAPI interface {
+	// Activity returns entries from the activity log.
+	Activity(opts *ActivityListOptions) (*ActivityResponse, *http.Response, error)
+	ActivityContext(ctx context.Context, opts *ActivityListOptions) (*ActivityResponse, *http.Response, error)
+}
+
+// SettingsAPI is the interface of SettingsService, so that code using the
+// service can be tested with a mock.
+type SettingsAPI interface {
+	// Backups returns the backup archives stored on the Snipe-IT server.
+	Backups() (*BackupsResponse, *http.Response, error)
+	BackupsContext(ctx context.Context) (*BackupsResponse, *http.Response, error)
+
+	// CreateBackup asks Snipe-IT to create a new backup archive.
+	CreateBackup() (*Response, *http.Response, error)
+	CreateBackupContext(ctx context.Context) (*Response, *http.Response, error)
+
+	// DownloadBackup streams a backup archive to w.
+	DownloadBackup(filename string, w io.Writer) (*http.Response, error)
+	DownloadBackupContext(ctx context.Context, filename string, w io.Writer) (*http.Response, error)
+
+	// TestLDAP checks that Snipe-IT can connect and bind to the configured LDAP
+	// server.
+	TestLDAP() (*SettingsTestResult, *http.Response, error)
+	TestLDAPContext(ctx context.Context) (*SettingsTestResult, *http.Response, error)
+
+	// TestSlack sends a test message to the configured Slack webhook.
+	TestSlack() (*SettingsTestResult, *http.Response, error)
+	TestSlackContext(ctx context.Context) (*SettingsTestResult, *http.Response, error)
+
+	// LoginAttempts returns the successful and failed login attempts recorded by
+	// Snipe-IT.
+	LoginAttempts(opts *ListOptions) (*LoginAttemptsResponse, *http.Response, error)
+	LoginAttemptsContext(ctx context.Context, opts *ListOptions) (*LoginAttemptsResponse, *http.Response, error)
+}
+
+// StatusLabelsAPI is the interface of StatusLabelsService, so that code using
+// the service can be tested with a mock.
+type StatusLabelsAPI interface {
+	// List returns a list of status labels with pagination options.
+	List(opts *ListOptions) (*StatusLabelsResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*StatusLabelsResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every status label, fetching pages lazily
+	// as the caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[StatusLabel, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[StatusLabel, error]
+
+	// Get fetches a single status label by its ID.
+	Get(id int) (*StatusLabelResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*StatusLabelResponse, *http.Response, error)
+
+	// Create creates a new status label in Snipe-IT.
+	Create(statusLabel StatusLabel) (*StatusLabelResponse, *http.Response, error)
+	CreateContext(ctx context.Context, statusLabel StatusLabel) (*StatusLabelResponse, *http.Response, error)
+
+	// Update updates an existing status label in Snipe-IT.
+	Update(id int, statusLabel StatusLabel) (*StatusLabelResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, statusLabel StatusLabel) (*StatusLabelResponse, *http.Response, error)
+
+	// Delete deletes a status label from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+
+	// Assets returns the assets that have a status label.
+	Assets(id int, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+	AssetsContext(ctx context.Context, id int, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+}
+
+// SuppliersAPI is the interface of SuppliersService, so that code using the
+// service can be tested with a mock.
+type SuppliersAPI interface {
+	// List returns a list of suppliers with pagination options.
+	List(opts *ListOptions) (*SuppliersResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*SuppliersResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every supplier, fetching pages lazily as
+	// the caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[Supplier, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Supplier, error]
+
+	// Get fetches a single supplier by its ID.
+	Get(id int) (*SupplierResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*SupplierResponse, *http.Response, error)
+
+	// Create creates a new supplier in Snipe-IT.
+	Create(supplier Supplier) (*SupplierResponse, *http.Response, error)
+	CreateContext(ctx context.Context, supplier Supplier) (*SupplierResponse, *http.Response, error)
+
+	// Update updates an existing supplier in Snipe-IT.
+	Update(id int, supplier Supplier) (*SupplierResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, supplier Supplier) (*SupplierResponse, *http.Response, error)
+
+	// Delete deletes a supplier from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+}
+
+// UsersAPI is the interface of UsersService, so that code using the service can
+// be tested with a mock.
+type UsersAPI interface {
+	// List returns a list of users with pagination options.
+	List(opts *ListOptions) (*UsersResponse, *http.Response, error)
+	ListContext(ctx context.Context, opts *ListOptions) (*UsersResponse, *http.Response, error)
+
+	// Iterate returns an iterator over every user, fetching pages lazily as the
+	// caller ranges over it.
+	Iterate(opts *ListOptions) iter.Seq2[User, error]
+	IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[User, error]
+
+	// Get fetches a single user by its ID.
+	Get(id int) (*UserResponse, *http.Response, error)
+	GetContext(ctx context.Context, id int) (*UserResponse, *http.Response, error)
+
+	// Create creates a new user in Snipe-IT.
+	Create(user User) (*UserResponse, *http.Response, error)
+	CreateContext(ctx context.Context, user User) (*UserResponse, *http.Response, error)
+
+	// Update updates an existing user in Snipe-IT.
+	Update(id int, user User) (*UserResponse, *http.Response, error)
+	UpdateContext(ctx context.Context, id int, user User) (*UserResponse, *http.Response, error)
+
+	// Delete deletes a user from Snipe-IT.
+	Delete(id int) (*http.Response, error)
+	DeleteContext(ctx context.Context, id int) (*http.Response, error)
+
+	// Assets returns the assets checked out to a user.
+	Assets(id int, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+	AssetsContext(ctx context.Context, id int, opts *ListOptions) (*AssetsResponse, *http.Response, error)
+
+	// Accessories returns the accessories checked out to a user.
+	Accessories(id int) (*AccessoriesResponse, *http.Response, error)
+	AccessoriesContext(ctx context.Context, id int) (*AccessoriesResponse, *http.Response, error)
+
+	// Licenses returns the licenses checked out to a user.
+	Licenses(id int) (*LicensesResponse, *http.Response, error)
+	LicensesContext(ctx context.Context, id int) (*LicensesResponse, *http.Response, error)
+
+	// Restore restores a soft-deleted user.
+	Restore(id int) (*UserResponse, *http.Response, error)
+	RestoreContext(ctx context.Context, id int) (*UserResponse, *http.Response, error)
+
+	// ResetTwoFactor resets the two-factor authentication enrollment of a user, so
+	// that they are asked to enroll again on their next login.
+	ResetTwoFactor(id int) (*http.Response, error)
+	ResetTwoFactorContext(ctx context.Context, id int) (*http.Response, error)
+}
@@ -0,0 +1,62 @@
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// mockAssets is an AssetsAPI whose Get is stubbed, as a mock generated
+// from the interface would be.
+type mockAssets struct {
+	AssetsAPI
+	get func(id int) (*AssetResponse, *http.Response, error)
+}
+
+func (m *mockAssets) GetContext(ctx context.Context, id int) (*AssetResponse, *http.Response, error) {
+	return m.get(id)
+}
+
+// mockClient is a ClientInterface whose assets service is mocked.
+type mockClient struct {
+	ClientInterface
+	assets AssetsAPI
+}
+
+func (m *mockClient) AssetsAPI() AssetsAPI {
+	return m.assets
+}
+
+func TestClientInterfaceServices(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	if client.AssetsAPI() != client.Assets || client.CustomFieldsAPI() != client.Fields || client.UsersAPI() != client.Users {
+		t.Error("Client service accessors did not return the client's services")
+	}
+}
+
+func TestClientInterfaceMock(t *testing.T) {
+	assetTag := func(ctx context.Context, client ClientInterface, id int) (string, error) {
+		asset, _, err := client.AssetsAPI().GetContext(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return asset.AssetTag, nil
+	}
+
+	mock := &mockClient{assets: &mockAssets{get: func(id int) (*AssetResponse, *http.Response, error) {
+		response := &AssetResponse{}
+		response.AssetTag = fmt.Sprintf("AT-%d", id)
+		return response, nil, nil
+	}}}
+
+	tag, err := assetTag(context.Background(), mock, 7)
+	if err != nil {
+		t.Fatalf("assetTag returned error: %v", err)
+	}
+	if tag != "AT-7" {
+		t.Errorf("assetTag returned %q, expected %q", tag, "AT-7")
+	}
+}
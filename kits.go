@@ -0,0 +1,719 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// KitsService handles communication with the predefined kit endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kits
+type KitsService struct {
+	client *Client
+}
+
+// KitResponse represents the API response for a single kit.
+// The single kit endpoint returns the kit data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Kit.
+type KitResponse struct {
+	Response
+	// Payload contains the kit as returned in the payload field, if any
+	Payload *Kit `json:"payload,omitempty"`
+	Kit
+}
+
+// UnmarshalJSON implements json.Unmarshaler for KitResponse.
+func (r *KitResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Kit)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Kit
+	}
+	return nil
+}
+
+// KitsResponse represents the API response for multiple kits.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Kits.
+type KitsResponse struct {
+	Response
+	// Rows contains the list of Kit objects
+	Rows []Kit `json:"rows"`
+}
+
+// KitItemsResponse represents the API response for the contents of a kit.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of KitItems.
+type KitItemsResponse struct {
+	Response
+	// Rows contains the list of KitItem objects
+	Rows []KitItem `json:"rows"`
+}
+
+// List returns a list of kits with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kits
+func (s *KitsService) List(opts *ListOptions) (*KitsResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of kits with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kits
+func (s *KitsService) ListContext(ctx context.Context, opts *ListOptions) (*KitsResponse, *http.Response, error) {
+	u := "api/v1/kits"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var kits KitsResponse
+	resp, err := s.client.Do(req, &kits)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &kits, resp, nil
+}
+
+// Iterate returns an iterator over every kit, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Kit.
+func (s *KitsService) Iterate(opts *ListOptions) iter.Seq2[Kit, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every kit with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Kit.
+func (s *KitsService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Kit, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]Kit, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single kit by its ID.
+//
+// id is the unique identifier of the kit to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsid
+func (s *KitsService) Get(id int) (*KitResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single kit by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsid
+func (s *KitsService) GetContext(ctx context.Context, id int) (*KitResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var kit KitResponse
+	resp, err := s.client.Do(req, &kit)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &kit, resp, nil
+}
+
+// Create creates a new kit in Snipe-IT.
+//
+// kit must contain the required fields:
+// - Name: The name of the kit
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kits-1
+func (s *KitsService) Create(kit Kit) (*KitResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), kit)
+}
+
+// CreateContext creates a new kit in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// kit must contain the required fields:
+// - Name: The name of the kit
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kits-1
+func (s *KitsService) CreateContext(ctx context.Context, kit Kit) (*KitResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/kits", kit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response KitResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing kit in Snipe-IT.
+//
+// id is the unique identifier of the kit to update.
+// kit contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsid-2
+func (s *KitsService) Update(id int, kit Kit) (*KitResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, kit)
+}
+
+// UpdateContext updates an existing kit in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit to update.
+// kit contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsid-2
+func (s *KitsService) UpdateContext(ctx context.Context, id int, kit Kit) (*KitResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, kit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response KitResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a kit from Snipe-IT.
+//
+// id is the unique identifier of the kit to delete.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsid-1
+func (s *KitsService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a kit from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit to delete.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsid-1
+func (s *KitsService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Checkout checks out the contents of a kit to a user.
+//
+// id is the unique identifier of the kit to check out.
+// userID is the unique identifier of the user to check the kit out to.
+// note is an optional note about the checkout.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidcheckout
+func (s *KitsService) Checkout(id, userID int, note string) (*KitResponse, *http.Response, error) {
+	return s.CheckoutContext(context.Background(), id, userID, note)
+}
+
+// CheckoutContext checks out the contents of a kit to a user with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit to check out.
+// userID is the unique identifier of the user to check the kit out to.
+// note is an optional note about the checkout.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidcheckout
+func (s *KitsService) CheckoutContext(ctx context.Context, id, userID int, note string) (*KitResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/checkout", id)
+	body := map[string]interface{}{
+		"user_id": userID,
+	}
+	if note != "" {
+		body["note"] = note
+	}
+
+	return s.changeItem(ctx, http.MethodPost, u, body)
+}
+
+// Models returns the models in a kit.
+//
+// id is the unique identifier of the kit.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidmodels
+func (s *KitsService) Models(id int) (*KitItemsResponse, *http.Response, error) {
+	return s.ModelsContext(context.Background(), id)
+}
+
+// ModelsContext returns the models in a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidmodels
+func (s *KitsService) ModelsContext(ctx context.Context, id int) (*KitItemsResponse, *http.Response, error) {
+	return s.listItems(ctx, id, "models")
+}
+
+// AddModel adds a model to a kit.
+//
+// id is the unique identifier of the kit.
+// modelID is the unique identifier of the model to add.
+// quantity is how many of the model the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidmodels-1
+func (s *KitsService) AddModel(id, modelID, quantity int) (*KitResponse, *http.Response, error) {
+	return s.AddModelContext(context.Background(), id, modelID, quantity)
+}
+
+// AddModelContext adds a model to a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+// modelID is the unique identifier of the model to add.
+// quantity is how many of the model the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidmodels-1
+func (s *KitsService) AddModelContext(ctx context.Context, id, modelID, quantity int) (*KitResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/models", id)
+	body := map[string]interface{}{
+		"model_id": modelID,
+		"quantity": quantity,
+	}
+
+	return s.changeItem(ctx, http.MethodPost, u, body)
+}
+
+// UpdateModel changes the quantity of a model in a kit.
+//
+// id is the unique identifier of the kit.
+// modelID is the unique identifier of the model to update.
+// quantity is how many of the model the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidmodelsmodelid
+func (s *KitsService) UpdateModel(id, modelID, quantity int) (*KitResponse, *http.Response, error) {
+	return s.UpdateModelContext(context.Background(), id, modelID, quantity)
+}
+
+// UpdateModelContext changes the quantity of a model in a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+// modelID is the unique identifier of the model to update.
+// quantity is how many of the model the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidmodelsmodelid
+func (s *KitsService) UpdateModelContext(ctx context.Context, id, modelID, quantity int) (*KitResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/models/%d", id, modelID)
+	body := map[string]interface{}{
+		"quantity": quantity,
+	}
+
+	return s.changeItem(ctx, http.MethodPut, u, body)
+}
+
+// RemoveModel removes a model from a kit.
+//
+// id is the unique identifier of the kit.
+// modelID is the unique identifier of the model to remove.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidmodelsmodelid-1
+func (s *KitsService) RemoveModel(id, modelID int) (*http.Response, error) {
+	return s.RemoveModelContext(context.Background(), id, modelID)
+}
+
+// RemoveModelContext removes a model from a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+// modelID is the unique identifier of the model to remove.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidmodelsmodelid-1
+func (s *KitsService) RemoveModelContext(ctx context.Context, id, modelID int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/models/%d", id, modelID)
+	return s.removeItem(ctx, u)
+}
+
+// Licenses returns the licenses in a kit.
+//
+// id is the unique identifier of the kit.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidlicenses
+func (s *KitsService) Licenses(id int) (*KitItemsResponse, *http.Response, error) {
+	return s.LicensesContext(context.Background(), id)
+}
+
+// LicensesContext returns the licenses in a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidlicenses
+func (s *KitsService) LicensesContext(ctx context.Context, id int) (*KitItemsResponse, *http.Response, error) {
+	return s.listItems(ctx, id, "licenses")
+}
+
+// AddLicense adds a license to a kit.
+//
+// id is the unique identifier of the kit.
+// licenseID is the unique identifier of the license to add.
+// quantity is how many of the license the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidlicenses-1
+func (s *KitsService) AddLicense(id, licenseID, quantity int) (*KitResponse, *http.Response, error) {
+	return s.AddLicenseContext(context.Background(), id, licenseID, quantity)
+}
+
+// AddLicenseContext adds a license to a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+// licenseID is the unique identifier of the license to add.
+// quantity is how many of the license the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidlicenses-1
+func (s *KitsService) AddLicenseContext(ctx context.Context, id, licenseID, quantity int) (*KitResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/licenses", id)
+	body := map[string]interface{}{
+		"license_id": licenseID,
+		"quantity":   quantity,
+	}
+
+	return s.changeItem(ctx, http.MethodPost, u, body)
+}
+
+// UpdateLicense changes the quantity of a license in a kit.
+//
+// id is the unique identifier of the kit.
+// licenseID is the unique identifier of the license to update.
+// quantity is how many of the license the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidlicenseslicenseid
+func (s *KitsService) UpdateLicense(id, licenseID, quantity int) (*KitResponse, *http.Response, error) {
+	return s.UpdateLicenseContext(context.Background(), id, licenseID, quantity)
+}
+
+// UpdateLicenseContext changes the quantity of a license in a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+// licenseID is the unique identifier of the license to update.
+// quantity is how many of the license the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidlicenseslicenseid
+func (s *KitsService) UpdateLicenseContext(ctx context.Context, id, licenseID, quantity int) (*KitResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/licenses/%d", id, licenseID)
+	body := map[string]interface{}{
+		"quantity": quantity,
+	}
+
+	return s.changeItem(ctx, http.MethodPut, u, body)
+}
+
+// RemoveLicense removes a license from a kit.
+//
+// id is the unique identifier of the kit.
+// licenseID is the unique identifier of the license to remove.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidlicenseslicenseid-1
+func (s *KitsService) RemoveLicense(id, licenseID int) (*http.Response, error) {
+	return s.RemoveLicenseContext(context.Background(), id, licenseID)
+}
+
+// RemoveLicenseContext removes a license from a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+// licenseID is the unique identifier of the license to remove.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidlicenseslicenseid-1
+func (s *KitsService) RemoveLicenseContext(ctx context.Context, id, licenseID int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/licenses/%d", id, licenseID)
+	return s.removeItem(ctx, u)
+}
+
+// Accessories returns the accessories in a kit.
+//
+// id is the unique identifier of the kit.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidaccessories
+func (s *KitsService) Accessories(id int) (*KitItemsResponse, *http.Response, error) {
+	return s.AccessoriesContext(context.Background(), id)
+}
+
+// AccessoriesContext returns the accessories in a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidaccessories
+func (s *KitsService) AccessoriesContext(ctx context.Context, id int) (*KitItemsResponse, *http.Response, error) {
+	return s.listItems(ctx, id, "accessories")
+}
+
+// AddAccessory adds an accessory to a kit.
+//
+// id is the unique identifier of the kit.
+// accessoryID is the unique identifier of the accessory to add.
+// quantity is how many of the accessory the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidaccessories-1
+func (s *KitsService) AddAccessory(id, accessoryID, quantity int) (*KitResponse, *http.Response, error) {
+	return s.AddAccessoryContext(context.Background(), id, accessoryID, quantity)
+}
+
+// AddAccessoryContext adds an accessory to a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+// accessoryID is the unique identifier of the accessory to add.
+// quantity is how many of the accessory the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidaccessories-1
+func (s *KitsService) AddAccessoryContext(ctx context.Context, id, accessoryID, quantity int) (*KitResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/accessories", id)
+	body := map[string]interface{}{
+		"accessory_id": accessoryID,
+		"quantity":     quantity,
+	}
+
+	return s.changeItem(ctx, http.MethodPost, u, body)
+}
+
+// UpdateAccessory changes the quantity of an accessory in a kit.
+//
+// id is the unique identifier of the kit.
+// accessoryID is the unique identifier of the accessory to update.
+// quantity is how many of the accessory the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidaccessoriesaccessoryid
+func (s *KitsService) UpdateAccessory(id, accessoryID, quantity int) (*KitResponse, *http.Response, error) {
+	return s.UpdateAccessoryContext(context.Background(), id, accessoryID, quantity)
+}
+
+// UpdateAccessoryContext changes the quantity of an accessory in a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+// accessoryID is the unique identifier of the accessory to update.
+// quantity is how many of the accessory the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidaccessoriesaccessoryid
+func (s *KitsService) UpdateAccessoryContext(ctx context.Context, id, accessoryID, quantity int) (*KitResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/accessories/%d", id, accessoryID)
+	body := map[string]interface{}{
+		"quantity": quantity,
+	}
+
+	return s.changeItem(ctx, http.MethodPut, u, body)
+}
+
+// RemoveAccessory removes an accessory from a kit.
+//
+// id is the unique identifier of the kit.
+// accessoryID is the unique identifier of the accessory to remove.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidaccessoriesaccessoryid-1
+func (s *KitsService) RemoveAccessory(id, accessoryID int) (*http.Response, error) {
+	return s.RemoveAccessoryContext(context.Background(), id, accessoryID)
+}
+
+// RemoveAccessoryContext removes an accessory from a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+// accessoryID is the unique identifier of the accessory to remove.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidaccessoriesaccessoryid-1
+func (s *KitsService) RemoveAccessoryContext(ctx context.Context, id, accessoryID int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/accessories/%d", id, accessoryID)
+	return s.removeItem(ctx, u)
+}
+
+// Consumables returns the consumables in a kit.
+//
+// id is the unique identifier of the kit.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidconsumables
+func (s *KitsService) Consumables(id int) (*KitItemsResponse, *http.Response, error) {
+	return s.ConsumablesContext(context.Background(), id)
+}
+
+// ConsumablesContext returns the consumables in a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidconsumables
+func (s *KitsService) ConsumablesContext(ctx context.Context, id int) (*KitItemsResponse, *http.Response, error) {
+	return s.listItems(ctx, id, "consumables")
+}
+
+// AddConsumable adds a consumable to a kit.
+//
+// id is the unique identifier of the kit.
+// consumableID is the unique identifier of the consumable to add.
+// quantity is how many of the consumable the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidconsumables-1
+func (s *KitsService) AddConsumable(id, consumableID, quantity int) (*KitResponse, *http.Response, error) {
+	return s.AddConsumableContext(context.Background(), id, consumableID, quantity)
+}
+
+// AddConsumableContext adds a consumable to a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+// consumableID is the unique identifier of the consumable to add.
+// quantity is how many of the consumable the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidconsumables-1
+func (s *KitsService) AddConsumableContext(ctx context.Context, id, consumableID, quantity int) (*KitResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/consumables", id)
+	body := map[string]interface{}{
+		"consumable_id": consumableID,
+		"quantity":      quantity,
+	}
+
+	return s.changeItem(ctx, http.MethodPost, u, body)
+}
+
+// UpdateConsumable changes the quantity of a consumable in a kit.
+//
+// id is the unique identifier of the kit.
+// consumableID is the unique identifier of the consumable to update.
+// quantity is how many of the consumable the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidconsumablesconsumableid
+func (s *KitsService) UpdateConsumable(id, consumableID, quantity int) (*KitResponse, *http.Response, error) {
+	return s.UpdateConsumableContext(context.Background(), id, consumableID, quantity)
+}
+
+// UpdateConsumableContext changes the quantity of a consumable in a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+// consumableID is the unique identifier of the consumable to update.
+// quantity is how many of the consumable the kit should contain.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidconsumablesconsumableid
+func (s *KitsService) UpdateConsumableContext(ctx context.Context, id, consumableID, quantity int) (*KitResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/consumables/%d", id, consumableID)
+	body := map[string]interface{}{
+		"quantity": quantity,
+	}
+
+	return s.changeItem(ctx, http.MethodPut, u, body)
+}
+
+// RemoveConsumable removes a consumable from a kit.
+//
+// id is the unique identifier of the kit.
+// consumableID is the unique identifier of the consumable to remove.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidconsumablesconsumableid-1
+func (s *KitsService) RemoveConsumable(id, consumableID int) (*http.Response, error) {
+	return s.RemoveConsumableContext(context.Background(), id, consumableID)
+}
+
+// RemoveConsumableContext removes a consumable from a kit with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the kit.
+// consumableID is the unique identifier of the consumable to remove.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/kitsidconsumablesconsumableid-1
+func (s *KitsService) RemoveConsumableContext(ctx context.Context, id, consumableID int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/consumables/%d", id, consumableID)
+	return s.removeItem(ctx, u)
+}
+
+// listItems fetches one kind of kit content, such as "models" or "licenses".
+func (s *KitsService) listItems(ctx context.Context, id int, kind string) (*KitItemsResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/kits/%d/%s", id, kind)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items KitItemsResponse
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &items, resp, nil
+}
+
+// changeItem sends a request that modifies a kit or its contents and
+// decodes the kit returned by the API.
+func (s *KitsService) changeItem(ctx context.Context, method, u string, body map[string]interface{}) (*KitResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response KitResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// removeItem deletes the kit content at u.
+func (s *KitsService) removeItem(ctx context.Context, u string) (*http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,151 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestKitsList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/kits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 1, "name": "New Hire"}]}`)
+	})
+
+	kits, _, err := client.Kits.List(nil)
+	if err != nil {
+		t.Fatalf("Kits.List returned error: %v", err)
+	}
+
+	if len(kits.Rows) != 1 || kits.Rows[0].Name != "New Hire" {
+		t.Errorf("Kits.List returned %+v, expected one kit named %q", kits.Rows, "New Hire")
+	}
+}
+
+func TestKitsCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/kits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 2, "name": "Engineering"}}`)
+	})
+
+	kit, _, err := client.Kits.Create(Kit{CommonFields: CommonFields{Name: "Engineering"}})
+	if err != nil {
+		t.Fatalf("Kits.Create returned error: %v", err)
+	}
+
+	if kit.Payload == nil || kit.Payload.ID != 2 {
+		t.Errorf("Kits.Create returned Payload = %+v, expected ID %d", kit.Payload, 2)
+	}
+}
+
+func TestKitsModels(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/kits/1/models", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 9, "name": "MacBook Pro 16", "quantity": 1, "pivot_id": 3}]}`)
+	})
+
+	models, _, err := client.Kits.Models(1)
+	if err != nil {
+		t.Fatalf("Kits.Models returned error: %v", err)
+	}
+
+	if len(models.Rows) != 1 || models.Rows[0].ID != 9 || models.Rows[0].Quantity != 1 {
+		t.Errorf("Kits.Models returned %+v, expected one model 9 with quantity 1", models.Rows)
+	}
+}
+
+func TestKitsAddLicense(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/kits/1/licenses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["license_id"] != float64(4) {
+			t.Errorf("Request body license_id = %v, expected %v", requestBody["license_id"], 4)
+		}
+		if requestBody["quantity"] != float64(2) {
+			t.Errorf("Request body quantity = %v, expected %v", requestBody["quantity"], 2)
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 1, "name": "New Hire"}}`)
+	})
+
+	if _, _, err := client.Kits.AddLicense(1, 4, 2); err != nil {
+		t.Fatalf("Kits.AddLicense returned error: %v", err)
+	}
+}
+
+func TestKitsUpdateAccessory(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/kits/1/accessories/6", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["quantity"] != float64(3) {
+			t.Errorf("Request body quantity = %v, expected %v", requestBody["quantity"], 3)
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 1, "name": "New Hire"}}`)
+	})
+
+	if _, _, err := client.Kits.UpdateAccessory(1, 6, 3); err != nil {
+		t.Fatalf("Kits.UpdateAccessory returned error: %v", err)
+	}
+}
+
+func TestKitsRemoveConsumable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/kits/1/consumables/8", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success"}`)
+	})
+
+	if _, err := client.Kits.RemoveConsumable(1, 8); err != nil {
+		t.Fatalf("Kits.RemoveConsumable returned error: %v", err)
+	}
+}
+
+func TestKitsCheckout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/kits/1/checkout", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["user_id"] != float64(12) {
+			t.Errorf("Request body user_id = %v, expected %v", requestBody["user_id"], 12)
+		}
+		if requestBody["note"] != "Welcome aboard" {
+			t.Errorf("Request body note = %v, expected %v", requestBody["note"], "Welcome aboard")
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 1, "name": "New Hire"}}`)
+	})
+
+	if _, _, err := client.Kits.Checkout(1, 12, "Welcome aboard"); err != nil {
+		t.Fatalf("Kits.Checkout returned error: %v", err)
+	}
+}
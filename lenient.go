@@ -0,0 +1,173 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// assignedToType is the type of AssignedTo, whose entity type depends on
+// the object decoded.
+var assignedToType = reflect.TypeOf(AssignedTo{})
+
+// leadingNumber matches the number at the start of a string such as
+// "36 months".
+var leadingNumber = regexp.MustCompile(`^-?\d+(\.\d+)?`)
+
+// retryLenient retries decoding data into v after err, the error of
+// decoding it as is, accepting numbers the server sent in a different form
+// than v expects. Depending on the Snipe-IT version and endpoint, IDs and
+// counts are sometimes sent as strings ("42"), floats (42.0) or strings
+// with a unit ("36 months").
+//
+// Only errors from mismatched types are retried, by coercing the numbers
+// in data to the types of their destinations. If that also fails, or err
+// is of another kind, err is returned.
+func retryLenient(data []byte, v interface{}, err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if err == nil || !errors.As(err, &typeErr) {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value interface{}
+	if dec.Decode(&value) != nil {
+		return err
+	}
+
+	coerced, merr := json.Marshal(coerceNumbers(value, reflect.TypeOf(v)))
+	if merr != nil {
+		return err
+	}
+	if json.Unmarshal(coerced, v) != nil {
+		return err
+	}
+	return nil
+}
+
+// coerceNumbers converts the numbers in value, a JSON value decoded with
+// UseNumber, to the form expected by a destination of type t. It follows
+// the same fields as the decoder; values it cannot convert are returned
+// unchanged for the decoder to report.
+func coerceNumbers(value interface{}, t reflect.Type) interface{} {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if value == nil {
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return coerceInt(value)
+	case reflect.Float32, reflect.Float64:
+		return coerceFloat(value)
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		if t == assignedToType {
+			return coerceNumbers(value, assignedEntityType(object))
+		}
+		if reflect.PointerTo(t).Implements(unmarshalerType) && !isEnvelope(t) {
+			return value
+		}
+		known := jsonFields(t)
+		for key, item := range object {
+			if field, ok := known[strings.ToLower(key)]; ok {
+				object[key] = coerceNumbers(item, field.Type)
+			}
+		}
+		return object
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok || reflect.PointerTo(t).Implements(unmarshalerType) {
+			return value
+		}
+		for key, item := range object {
+			object[key] = coerceNumbers(item, t.Elem())
+		}
+		return object
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]interface{})
+		if !ok || reflect.PointerTo(t).Implements(unmarshalerType) {
+			return value
+		}
+		for i, item := range items {
+			items[i] = coerceNumbers(item, t.Elem())
+		}
+		return items
+	}
+	return value
+}
+
+// coerceInt converts value to an integer JSON number if it is a string
+// starting with a number or an integral float. An empty string is
+// converted to null, leaving the destination zero.
+func coerceInt(value interface{}) interface{} {
+	var n json.Number
+	switch v := value.(type) {
+	case json.Number:
+		n = v
+	case string:
+		v = strings.TrimSpace(v)
+		if v == "" {
+			return nil
+		}
+		n = json.Number(leadingNumber.FindString(v))
+	default:
+		return value
+	}
+
+	if _, err := strconv.ParseInt(string(n), 10, 64); err == nil {
+		return n
+	}
+	f, err := strconv.ParseFloat(string(n), 64)
+	if err != nil || f != math.Trunc(f) || math.Abs(f) > 1<<53 {
+		return value
+	}
+	return json.Number(strconv.FormatInt(int64(f), 10))
+}
+
+// coerceFloat converts value to a JSON number if it is a string starting
+// with a number. An empty string is converted to null, leaving the
+// destination zero.
+func coerceFloat(value interface{}) interface{} {
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	if n := leadingNumber.FindString(s); n != "" {
+		return json.Number(n)
+	}
+	return value
+}
+
+// assignedEntityType returns the type of the entity an assigned_to object
+// decodes into.
+func assignedEntityType(object map[string]interface{}) reflect.Type {
+	discriminator, _ := object["type"].(string)
+	_, hasUsername := object["username"]
+	_, hasAssetTag := object["asset_tag"]
+
+	switch assignedKind(discriminator, hasUsername, hasAssetTag) {
+	case AssignedTypeLocation:
+		return reflect.TypeOf(Location{})
+	case AssignedTypeAsset:
+		return reflect.TypeOf(Asset{})
+	}
+	return reflect.TypeOf(User{})
+}
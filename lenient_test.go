@@ -0,0 +1,95 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAssetsListLenientNumbers(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	body := `{"total": "2", "rows": [
+		{"id": "42", "warranty_months": "36 months", "model": {"id": 7.0, "eol": "36 months"}, "assigned_to": {"id": "3", "username": "jdoe"}},
+		{"id": 43, "warranty_months": "", "assigned_to": {"id": "5", "name": "HQ", "type": "location"}}
+	]}`
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	})
+
+	assets, _, err := client.Assets.List(nil)
+	if err != nil {
+		t.Fatalf("Assets.List returned error: %v", err)
+	}
+
+	if assets.Total != 2 || len(assets.Rows) != 2 {
+		t.Fatalf("Assets.List returned Total = %d with %d rows, expected 2", assets.Total, len(assets.Rows))
+	}
+	asset := assets.Rows[0]
+	if asset.ID != 42 || asset.WarrantyMonths != 36 {
+		t.Errorf("Rows[0] ID = %d, WarrantyMonths = %d, expected 42, 36", asset.ID, asset.WarrantyMonths)
+	}
+	if asset.Model.ID != 7 || asset.Model.EOL != 36 {
+		t.Errorf("Rows[0].Model ID = %d, EOL = %d, expected 7, 36", asset.Model.ID, asset.Model.EOL)
+	}
+	if asset.AssignedTo.User() == nil || asset.AssignedTo.ID() != 3 {
+		t.Errorf("Rows[0].AssignedTo = %+v, expected user 3", asset.AssignedTo)
+	}
+	if assets.Rows[1].WarrantyMonths != 0 || assets.Rows[1].AssignedTo.Location() == nil || assets.Rows[1].AssignedTo.ID() != 5 {
+		t.Errorf("Rows[1] WarrantyMonths = %d, AssignedTo = %+v, expected 0 and location 5",
+			assets.Rows[1].WarrantyMonths, assets.Rows[1].AssignedTo)
+	}
+
+	// Streamed rows are decoded the same way
+	var ids []int
+	err = client.Assets.Stream(nil, func(asset Asset) error {
+		ids = append(ids, asset.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Assets.Stream returned error: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != 42 {
+		t.Errorf("Assets.Stream visited %v, expected [42 43]", ids)
+	}
+}
+
+func TestAssetsGetLenientPayload(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": "success", "messages": "ok", "payload": {"id": "1", "checkout_counter": "4"}}`)
+	})
+
+	asset, _, err := client.Assets.Patch(1, map[string]interface{}{"name": "x"})
+	if err != nil {
+		t.Fatalf("Assets.Patch returned error: %v", err)
+	}
+	if asset.ID != 1 || asset.CheckoutCounter != 4 {
+		t.Errorf("Assets.Patch returned ID = %d, CheckoutCounter = %d, expected 1, 4", asset.ID, asset.CheckoutCounter)
+	}
+}
+
+func TestRetryLenientErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"Not a number", `{"id": "abc"}`},
+		{"Fractional ID", `{"id": 1.5}`},
+		{"Object", `{"id": {"value": 1}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var asset Asset
+			err := retryLenient([]byte(tt.data), &asset, json.Unmarshal([]byte(tt.data), &asset))
+			if _, ok := err.(*json.UnmarshalTypeError); !ok {
+				t.Errorf("retryLenient returned error %v, expected a *json.UnmarshalTypeError", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,401 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+)
+
+// LicensesService handles communication with the license-related endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licenses
+type LicensesService struct {
+	client *Client
+}
+
+// LicenseResponse represents the API response for a single license.
+// The single license endpoint returns the license data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded License.
+type LicenseResponse struct {
+	Response
+	// Payload contains the license as returned in the payload field, if any
+	Payload *License `json:"payload,omitempty"`
+	License
+}
+
+// UnmarshalJSON implements json.Unmarshaler for LicenseResponse.
+func (r *LicenseResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.License)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.License
+	}
+	return nil
+}
+
+// LicensesResponse represents the API response for multiple licenses.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Licenses.
+type LicensesResponse struct {
+	Response
+	// Rows contains the list of License objects
+	Rows []License `json:"rows"`
+}
+
+// LicenseListOptions specifies the options for listing licenses.
+// It embeds ListOptions for pagination, search and sorting, and adds
+// license-specific filters.
+type LicenseListOptions struct {
+	ListOptions
+
+	// Expand includes the full related objects instead of ID and name only
+	Expand bool `url:"expand,omitempty"`
+
+	// SupplierID filters licenses by supplier
+	SupplierID int `url:"supplier_id,omitempty"`
+
+	// CategoryID filters licenses by category
+	CategoryID int `url:"category_id,omitempty"`
+
+	// ManufacturerID filters licenses by manufacturer
+	ManufacturerID int `url:"manufacturer_id,omitempty"`
+
+	// CompanyID filters licenses by company
+	CompanyID int `url:"company_id,omitempty"`
+}
+
+// List returns a list of licenses with pagination and filter options.
+//
+// opts can be used to customize the response with pagination, search, sorting
+// and license-specific filters. If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licenses
+func (s *LicensesService) List(opts *LicenseListOptions) (*LicensesResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of licenses with the provided context and options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, sorting
+// and license-specific filters. If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licenses
+func (s *LicensesService) ListContext(ctx context.Context, opts *LicenseListOptions) (*LicensesResponse, *http.Response, error) {
+	u := "api/v1/licenses"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var licenses LicensesResponse
+	resp, err := s.client.Do(req, &licenses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &licenses, resp, nil
+}
+
+// Iterate returns an iterator over every license, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero License.
+func (s *LicensesService) Iterate(opts *LicenseListOptions) iter.Seq2[License, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every license with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero License.
+func (s *LicensesService) IterateContext(ctx context.Context, opts *LicenseListOptions) iter.Seq2[License, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *LicenseListOptions) ([]License, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single license by its ID.
+//
+// id is the unique identifier of the license to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesid
+func (s *LicensesService) Get(id int) (*LicenseResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single license by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the license to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesid
+func (s *LicensesService) GetContext(ctx context.Context, id int) (*LicenseResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/licenses/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var license LicenseResponse
+	resp, err := s.client.Do(req, &license)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &license, resp, nil
+}
+
+// Create creates a new license in Snipe-IT.
+//
+// license must contain the required fields:
+// - Name: The name of the license
+// - Seats: The number of seats
+// - CategoryID: The ID of a license category
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licenses-2
+func (s *LicensesService) Create(license License) (*LicenseResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), license)
+}
+
+// CreateContext creates a new license in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// license must contain the required fields:
+// - Name: The name of the license
+// - Seats: The number of seats
+// - CategoryID: The ID of a license category
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licenses-2
+func (s *LicensesService) CreateContext(ctx context.Context, license License) (*LicenseResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/licenses", license)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response LicenseResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing license in Snipe-IT.
+//
+// id is the unique identifier of the license to update.
+// license contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesid-1
+func (s *LicensesService) Update(id int, license License) (*LicenseResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, license)
+}
+
+// UpdateContext updates an existing license in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the license to update.
+// license contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesid-1
+func (s *LicensesService) UpdateContext(ctx context.Context, id int, license License) (*LicenseResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/licenses/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, license)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response LicenseResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a license from Snipe-IT.
+//
+// id is the unique identifier of the license to delete.
+// Snipe-IT refuses to delete licenses that still have seats checked out.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesid-2
+func (s *LicensesService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a license from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the license to delete.
+// Snipe-IT refuses to delete licenses that still have seats checked out.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesid-2
+func (s *LicensesService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/licenses/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// FilesResponse represents the API response for the files attached to an item.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Files.
+type FilesResponse struct {
+	Response
+	// Rows contains the list of File objects
+	Rows []File `json:"rows"`
+}
+
+// UploadFile attaches a file to a license.
+//
+// id is the unique identifier of the license.
+// filename is the name the file is stored under (e.g., "agreement.pdf").
+// r supplies the file contents.
+// note is an optional note about the file.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidfiles-1
+func (s *LicensesService) UploadFile(id int, filename string, r io.Reader, note string) (*Response, *http.Response, error) {
+	return s.UploadFileContext(context.Background(), id, filename, r, note)
+}
+
+// UploadFileContext attaches a file to a license with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the license.
+// filename is the name the file is stored under (e.g., "agreement.pdf").
+// r supplies the file contents.
+// note is an optional note about the file.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidfiles-1
+func (s *LicensesService) UploadFileContext(ctx context.Context, id int, filename string, r io.Reader, note string) (*Response, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/licenses/%d/files", id)
+	params := map[string]string{}
+	if note != "" {
+		params["notes"] = note
+	}
+
+	req, err := s.client.newUploadRequest(ctx, u, "file[]", filename, r, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response Response
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Files returns the files attached to a license.
+//
+// id is the unique identifier of the license.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidfiles
+func (s *LicensesService) Files(id int, opts *ListOptions) (*FilesResponse, *http.Response, error) {
+	return s.FilesContext(context.Background(), id, opts)
+}
+
+// FilesContext returns the files attached to a license with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the license.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidfiles
+func (s *LicensesService) FilesContext(ctx context.Context, id int, opts *ListOptions) (*FilesResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/licenses/%d/files", id)
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var files FilesResponse
+	resp, err := s.client.Do(req, &files)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &files, resp, nil
+}
+
+// DownloadFile streams a file attached to a license to w.
+//
+// id is the unique identifier of the license.
+// fileID is the unique identifier of the file, as returned by Files.
+// w receives the raw file contents.
+//
+// The download is not retried, since a failed attempt may already have
+// written part of the file to w.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidfilesfile_id
+func (s *LicensesService) DownloadFile(id, fileID int, w io.Writer) (*http.Response, error) {
+	return s.DownloadFileContext(context.Background(), id, fileID, w)
+}
+
+// DownloadFileContext streams a file attached to a license to w with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the license.
+// fileID is the unique identifier of the file, as returned by Files.
+// w receives the raw file contents.
+//
+// The download is not retried, since a failed attempt may already have
+// written part of the file to w.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidfilesfile_id
+func (s *LicensesService) DownloadFileContext(ctx context.Context, id, fileID int, w io.Writer) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/licenses/%d/files/%d", id, fileID)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.DoWithOptions(req, w, &RequestOptions{Context: ctx, DisableRetries: true})
+}
@@ -0,0 +1,257 @@
+package snipeit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLicensesList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/licenses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "Authorization", "Bearer test-token")
+
+		query := r.URL.Query()
+		if query.Get("category_id") != "3" {
+			t.Errorf("Request URL query parameter 'category_id' = %v, expected %v", query.Get("category_id"), "3")
+		}
+		if query.Get("supplier_id") != "4" {
+			t.Errorf("Request URL query parameter 'supplier_id' = %v, expected %v", query.Get("supplier_id"), "4")
+		}
+		if query.Get("expand") != "true" {
+			t.Errorf("Request URL query parameter 'expand' = %v, expected %v", query.Get("expand"), "true")
+		}
+		if query.Get("limit") != "10" {
+			t.Errorf("Request URL query parameter 'limit' = %v, expected %v", query.Get("limit"), "10")
+		}
+
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{
+					"id": 1,
+					"name": "Office 365",
+					"product_key": "XXXX-YYYY",
+					"seats": 100,
+					"free_seats_count": 12,
+					"expiration_date": "2025-06-30 00:00:00",
+					"maintained": true,
+					"reassignable": true,
+					"category": {"id": 3, "name": "Productivity"}
+				}
+			]
+		}`)
+	})
+
+	opts := &LicenseListOptions{
+		ListOptions: ListOptions{Limit: 10},
+		Expand:      true,
+		SupplierID:  4,
+		CategoryID:  3,
+	}
+
+	licenses, _, err := client.Licenses.List(opts)
+	if err != nil {
+		t.Fatalf("Licenses.List returned error: %v", err)
+	}
+
+	if len(licenses.Rows) != 1 {
+		t.Fatalf("Licenses.List returned %d licenses, expected %d", len(licenses.Rows), 1)
+	}
+
+	license := licenses.Rows[0]
+	if license.Seats != 100 || !license.Maintained || !license.Reassignable {
+		t.Errorf("Licenses.List returned %+v, expected 100 maintained, reassignable seats", license)
+	}
+
+	expiration := time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC)
+	if license.ExpirationDate == nil || !license.ExpirationDate.Equal(expiration) {
+		t.Errorf("Licenses.List returned ExpirationDate = %v, expected %v", license.ExpirationDate, expiration)
+	}
+}
+
+func TestLicensesGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/licenses/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "name": "Office 365", "seats": 100}`)
+	})
+
+	license, _, err := client.Licenses.Get(1)
+	if err != nil {
+		t.Fatalf("Licenses.Get returned error: %v", err)
+	}
+
+	if license.ID != 1 || license.Name != "Office 365" || license.Seats != 100 {
+		t.Errorf("Licenses.Get returned %+v, expected Office 365 with 100 seats", license.License)
+	}
+}
+
+func TestLicensesCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/licenses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["category_id"] != float64(3) {
+			t.Errorf("Request body category_id = %v, expected %v", requestBody["category_id"], float64(3))
+		}
+		if requestBody["seats"] != float64(25) {
+			t.Errorf("Request body seats = %v, expected %v", requestBody["seats"], float64(25))
+		}
+
+		fmt.Fprint(w, `{
+			"status": "success",
+			"messages": "License created successfully.",
+			"payload": {"id": 2, "name": "Photoshop", "seats": 25}
+		}`)
+	})
+
+	newLicense := License{
+		CommonFields: CommonFields{Name: "Photoshop"},
+		Seats:        25,
+		CategoryID:   3,
+	}
+
+	license, _, err := client.Licenses.Create(newLicense)
+	if err != nil {
+		t.Fatalf("Licenses.Create returned error: %v", err)
+	}
+
+	if license.Status != "success" {
+		t.Errorf("Licenses.Create returned Status = %s, expected %s", license.Status, "success")
+	}
+
+	if license.Payload == nil || license.Payload.ID != 2 {
+		t.Errorf("Licenses.Create returned Payload = %+v, expected ID %d", license.Payload, 2)
+	}
+}
+
+func TestLicensesUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/licenses/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{
+			"status": "success",
+			"payload": {"id": 2, "name": "Photoshop", "seats": 30}
+		}`)
+	})
+
+	license, _, err := client.Licenses.Update(2, License{Seats: 30})
+	if err != nil {
+		t.Fatalf("Licenses.Update returned error: %v", err)
+	}
+
+	if license.Seats != 30 {
+		t.Errorf("Licenses.Update returned Seats = %d, expected %d", license.Seats, 30)
+	}
+}
+
+func TestLicensesDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/licenses/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success", "messages": "License deleted"}`)
+	})
+
+	resp, err := client.Licenses.Delete(2)
+	if err != nil {
+		t.Fatalf("Licenses.Delete returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Licenses.Delete returned status code = %d, expected %d", resp.StatusCode, http.StatusOK)
+	}
+}
+
+func TestLicensesUploadFile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/licenses/1/files", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, "Authorization", "Bearer test-token")
+
+		file, header, err := r.FormFile("file[]")
+		if err != nil {
+			t.Fatalf("Request did not include file[]: %v", err)
+		}
+		defer file.Close()
+
+		contents, _ := io.ReadAll(file)
+		if header.Filename != "agreement.pdf" || string(contents) != "%PDF-1.7" {
+			t.Errorf("Uploaded file = %q with %q, expected %q with %q", header.Filename, contents, "agreement.pdf", "%PDF-1.7")
+		}
+
+		if r.FormValue("notes") != "2024 renewal" {
+			t.Errorf("Request form value 'notes' = %q, expected %q", r.FormValue("notes"), "2024 renewal")
+		}
+
+		fmt.Fprint(w, `{"status": "success", "messages": "File uploaded"}`)
+	})
+
+	response, _, err := client.Licenses.UploadFile(1, "agreement.pdf", strings.NewReader("%PDF-1.7"), "2024 renewal")
+	if err != nil {
+		t.Fatalf("Licenses.UploadFile returned error: %v", err)
+	}
+
+	if response.Status != "success" {
+		t.Errorf("Licenses.UploadFile returned Status = %q, expected %q", response.Status, "success")
+	}
+}
+
+func TestLicensesFiles(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/licenses/1/files", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 11, "filename": "agreement.pdf", "note": "2024 renewal"}]}`)
+	})
+
+	files, _, err := client.Licenses.Files(1, nil)
+	if err != nil {
+		t.Fatalf("Licenses.Files returned error: %v", err)
+	}
+
+	if len(files.Rows) != 1 || files.Rows[0].ID != 11 || files.Rows[0].Filename != "agreement.pdf" {
+		t.Errorf("Licenses.Files returned %+v, expected file 11 agreement.pdf", files.Rows)
+	}
+}
+
+func TestLicensesDownloadFile(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/licenses/1/files/11", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "%PDF-1.7")
+	})
+
+	var buf bytes.Buffer
+	if _, err := client.Licenses.DownloadFile(1, 11, &buf); err != nil {
+		t.Fatalf("Licenses.DownloadFile returned error: %v", err)
+	}
+
+	if buf.String() != "%PDF-1.7" {
+		t.Errorf("Licenses.DownloadFile wrote %q, expected %q", buf.String(), "%PDF-1.7")
+	}
+}
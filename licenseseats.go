@@ -0,0 +1,381 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"sync"
+)
+
+// ErrNoFreeSeats is returned for the users of a BulkAssign left without a
+// seat because every seat of the license is assigned.
+var ErrNoFreeSeats = errors.New("snipeit: no free license seats")
+
+// LicenseSeat is a seat of a license, which can be checked out to a user
+// or an asset.
+type LicenseSeat struct {
+	// ID is the unique identifier of the seat
+	ID int `json:"id"`
+
+	// LicenseID is the license the seat belongs to
+	LicenseID int `json:"license_id"`
+
+	// Name is the name of the seat (e.g., "Seat 1")
+	Name string `json:"name,omitempty"`
+
+	// AssignedUser is the user the seat is checked out to, if any
+	AssignedUser *User `json:"assigned_user,omitempty"`
+
+	// AssignedAsset is the asset the seat is checked out to, if any
+	AssignedAsset *Asset `json:"assigned_asset,omitempty"`
+
+	// Location is the location of the assigned user or asset, if any
+	Location *Location `json:"location,omitempty"`
+
+	// Reassignable indicates if the seat can be checked in and reassigned
+	Reassignable Bool `json:"reassignable"`
+
+	// Notes contains the notes of the seat
+	Notes string `json:"notes,omitempty"`
+}
+
+// Free reports whether the seat is checked out to neither a user nor an
+// asset.
+func (s LicenseSeat) Free() bool {
+	return s.AssignedUser == nil && s.AssignedAsset == nil
+}
+
+// LicenseSeatResponse represents the API response for a single license
+// seat. The single seat endpoint returns the seat data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded LicenseSeat.
+type LicenseSeatResponse struct {
+	Response
+	LicenseSeat
+}
+
+// UnmarshalJSON implements json.Unmarshaler for LicenseSeatResponse.
+func (r *LicenseSeatResponse) UnmarshalJSON(data []byte) error {
+	_, err := decodePayload(data, &r.Response, &r.LicenseSeat)
+	return err
+}
+
+// LicenseSeatsResponse represents the API response for the seats of a
+// license. It embeds the standard Response struct and adds a Rows field
+// that contains a slice of LicenseSeats.
+type LicenseSeatsResponse struct {
+	Response
+	// Rows contains the list of LicenseSeat objects
+	Rows []LicenseSeat `json:"rows"`
+}
+
+// LicenseSeatUpdate holds the changes to a license seat. Fields that are
+// unset are left unchanged.
+//
+// Set AssignedTo to check the seat out to a user, or AssetID to check it
+// out to an asset. Set both to Null to check the seat in.
+type LicenseSeatUpdate struct {
+	// AssignedTo is the user the seat is checked out to
+	AssignedTo Nullable[int] `json:"assigned_to,omitzero"`
+
+	// AssetID is the asset the seat is checked out to
+	AssetID Nullable[int] `json:"asset_id,omitzero"`
+
+	// Notes contains the notes of the seat
+	Notes Nullable[string] `json:"notes,omitzero"`
+}
+
+// Seats returns the seats of a license with pagination options.
+//
+// id is the unique identifier of the license.
+// opts can be used to customize the response with pagination. If opts is
+// nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidseats
+func (s *LicensesService) Seats(id int, opts *ListOptions) (*LicenseSeatsResponse, *http.Response, error) {
+	return s.SeatsContext(context.Background(), id, opts)
+}
+
+// SeatsContext returns the seats of a license with pagination options and
+// the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the license.
+// opts can be used to customize the response with pagination. If opts is
+// nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidseats
+func (s *LicensesService) SeatsContext(ctx context.Context, id int, opts *ListOptions) (*LicenseSeatsResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/licenses/%d/seats", id)
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var seats LicenseSeatsResponse
+	resp, err := s.client.Do(req, &seats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &seats, resp, nil
+}
+
+// iterateSeats returns an iterator over every seat of a license.
+func (s *LicensesService) iterateSeats(ctx context.Context, id int) iter.Seq2[LicenseSeat, error] {
+	return iterate(ctx, (*ListOptions)(nil), func(ctx context.Context, pageOpts *ListOptions) ([]LicenseSeat, int, error) {
+		page, _, err := s.SeatsContext(ctx, id, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Seat fetches a single seat of a license.
+//
+// id is the unique identifier of the license.
+// seatID is the unique identifier of the seat.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidseatsseatid
+func (s *LicensesService) Seat(id, seatID int) (*LicenseSeatResponse, *http.Response, error) {
+	return s.SeatContext(context.Background(), id, seatID)
+}
+
+// SeatContext fetches a single seat of a license with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the license.
+// seatID is the unique identifier of the seat.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidseatsseatid
+func (s *LicensesService) SeatContext(ctx context.Context, id, seatID int) (*LicenseSeatResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/licenses/%d/seats/%d", id, seatID)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var seat LicenseSeatResponse
+	resp, err := s.client.Do(req, &seat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &seat, resp, nil
+}
+
+// UpdateSeat updates a seat of a license, such as to check it out to a
+// user or an asset, or to check it in.
+//
+// id is the unique identifier of the license.
+// seatID is the unique identifier of the seat.
+// seat holds the fields to change; fields that are not set are left
+// unchanged.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidseatsseatid-1
+func (s *LicensesService) UpdateSeat(id, seatID int, seat LicenseSeatUpdate) (*LicenseSeatResponse, *http.Response, error) {
+	return s.UpdateSeatContext(context.Background(), id, seatID, seat)
+}
+
+// UpdateSeatContext updates a seat of a license with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the license.
+// seatID is the unique identifier of the seat.
+// seat holds the fields to change; fields that are not set are left
+// unchanged.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidseatsseatid-1
+func (s *LicensesService) UpdateSeatContext(ctx context.Context, id, seatID int, seat LicenseSeatUpdate) (*LicenseSeatResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/licenses/%d/seats/%d", id, seatID)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPatch, u, seat)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated LicenseSeatResponse
+	resp, err := s.client.Do(req, &updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &updated, resp, nil
+}
+
+// SeatAssignment is the outcome of assigning a seat to one user in
+// BulkAssign.
+type SeatAssignment struct {
+	// UserID is the unique identifier of the user
+	UserID int
+
+	// SeatID is the seat assigned to the user, or 0 if none was
+	SeatID int
+
+	// Err is the error for this user, or nil if they hold a seat
+	Err error
+}
+
+// BulkAssign checks out a free seat of a license to each of several users.
+//
+// id is the unique identifier of the license.
+// userIDs are the unique identifiers of the users.
+//
+// Seats are claimed concurrently. Each seat is fetched again before it is
+// claimed, so that a seat taken since the seats were listed, by someone
+// else or by another BulkAssign, is skipped for the next free seat. Users
+// who already hold a seat of the license keep it, without a request.
+// Once every seat is assigned, the remaining users fail with
+// ErrNoFreeSeats.
+//
+// The returned results are in the same order as userIDs, one per user.
+// The returned error joins the errors of all failed assignments, or is nil
+// if every user holds a seat.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidseatsseatid-1
+func (s *LicensesService) BulkAssign(id int, userIDs []int) ([]SeatAssignment, error) {
+	return s.BulkAssignContext(context.Background(), id, userIDs)
+}
+
+// BulkAssignContext checks out a free seat of a license to each of several
+// users with the provided context.
+//
+// ctx is the context for the requests. Assignments not yet started when
+// ctx is canceled fail with the context's error.
+// id is the unique identifier of the license.
+// userIDs are the unique identifiers of the users.
+//
+// The returned results are in the same order as userIDs, one per user.
+// The returned error joins the errors of all failed assignments, or is nil
+// if every user holds a seat.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licensesidseatsseatid-1
+func (s *LicensesService) BulkAssignContext(ctx context.Context, id int, userIDs []int) ([]SeatAssignment, error) {
+	results := make([]SeatAssignment, len(userIDs))
+	for i, userID := range userIDs {
+		results[i].UserID = userID
+	}
+
+	held := make(map[int]int)
+	pool := &seatPool{}
+	for seat, err := range s.iterateSeats(ctx, id) {
+		if err != nil {
+			return results, fmt.Errorf("snipeit: listing seats of license %d: %w", id, err)
+		}
+		switch {
+		case seat.Free():
+			pool.free = append(pool.free, seat.ID)
+		case seat.AssignedUser != nil:
+			if _, ok := held[seat.AssignedUser.ID]; !ok {
+				held[seat.AssignedUser.ID] = seat.ID
+			}
+		}
+	}
+
+	// Each user is assigned once, however often they are listed
+	first := make(map[int]int)
+	sem := make(chan struct{}, bulkConcurrency)
+	var wg sync.WaitGroup
+	for i, userID := range userIDs {
+		if seatID, ok := held[userID]; ok {
+			results[i].SeatID = seatID
+			continue
+		}
+		if _, ok := first[userID]; ok {
+			continue
+		}
+		first[userID] = i
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i, userID int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i].SeatID, results[i].Err = s.claimSeat(ctx, id, userID, pool)
+		}(i, userID)
+	}
+	wg.Wait()
+
+	var errs []error
+	for i, result := range results {
+		if j, ok := first[result.UserID]; ok && j != i {
+			results[i] = results[j]
+		}
+		if results[i].Err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", result.UserID, results[i].Err))
+		}
+	}
+
+	return results, errors.Join(errs...)
+}
+
+// claimSeat checks out the next free seat of pool to a user and returns
+// the ID of the seat, skipping the seats taken since they were listed.
+func (s *LicensesService) claimSeat(ctx context.Context, id, userID int, pool *seatPool) (int, error) {
+	for {
+		seatID, ok := pool.take()
+		if !ok {
+			return 0, ErrNoFreeSeats
+		}
+
+		current, _, err := s.SeatContext(ctx, id, seatID)
+		if err != nil {
+			return 0, err
+		}
+		if !current.Free() {
+			continue
+		}
+
+		_, _, err = s.UpdateSeatContext(ctx, id, seatID, LicenseSeatUpdate{AssignedTo: NewNullable(userID)})
+		if err == nil {
+			return seatID, nil
+		}
+
+		// The seat may have been taken between the check and the update,
+		// which the API reports as an error
+		var errResp *ErrorResponse
+		if !errors.As(err, &errResp) {
+			return 0, err
+		}
+		if current, _, getErr := s.SeatContext(ctx, id, seatID); getErr != nil || current.Free() {
+			return 0, err
+		}
+	}
+}
+
+// seatPool hands out the free seats of a license to concurrent claims,
+// each seat at most once.
+type seatPool struct {
+	mu   sync.Mutex
+	free []int
+}
+
+// take returns the next free seat, or false if there is none left.
+func (p *seatPool) take() (int, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.free) == 0 {
+		return 0, false
+	}
+	seatID := p.free[0]
+	p.free = p.free[1:]
+	return seatID, true
+}
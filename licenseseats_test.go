@@ -0,0 +1,176 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestLicensesSeats(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/licenses/4/seats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query().Get("limit"); got != "2" {
+			t.Errorf("Request limit = %q, expected 2", got)
+		}
+		fmt.Fprint(w, `{"total": 3, "rows": [
+			{"id": 11, "license_id": 4, "name": "Seat 1", "assigned_user": {"id": 7, "name": "Alice"}, "reassignable": true},
+			{"id": 12, "license_id": 4, "name": "Seat 2", "assigned_user": null, "assigned_asset": null}
+		]}`)
+	})
+
+	seats, _, err := client.Licenses.Seats(4, &ListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("Licenses.Seats returned error: %v", err)
+	}
+	if seats.Total != 3 || len(seats.Rows) != 2 {
+		t.Fatalf("Licenses.Seats returned Total = %d and %d rows, expected 3 and 2", seats.Total, len(seats.Rows))
+	}
+	if seat := seats.Rows[0]; seat.Free() || seat.AssignedUser.ID != 7 || !bool(seat.Reassignable) {
+		t.Errorf("Seat 1 = %+v, expected it assigned to user 7 and reassignable", seat)
+	}
+	if seat := seats.Rows[1]; !seat.Free() || seat.Name != "Seat 2" {
+		t.Errorf("Seat 2 = %+v, expected it free", seat)
+	}
+}
+
+func TestLicensesUpdateSeat(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/licenses/4/seats/12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if fmt.Sprint(body) != "map[asset_id:<nil> assigned_to:<nil>]" {
+			t.Errorf("Request body = %v, expected assigned_to and asset_id null", body)
+		}
+		fmt.Fprint(w, `{"status": "success", "messages": "Seat checked in", "payload": {"id": 12, "license_id": 4}}`)
+	})
+
+	seat, _, err := client.Licenses.UpdateSeat(4, 12, LicenseSeatUpdate{AssignedTo: Null[int](), AssetID: Null[int]()})
+	if err != nil {
+		t.Fatalf("Licenses.UpdateSeat returned error: %v", err)
+	}
+	if seat.ID != 12 || !seat.Free() || seat.Status != "success" {
+		t.Errorf("Licenses.UpdateSeat returned %+v, expected free seat 12", seat)
+	}
+}
+
+// seatStore serves the seats of license 4. Seats in taken are assigned to
+// someone else as soon as they are fetched, and seats in rejected are
+// taken by someone else when a client tries to claim them.
+type seatStore struct {
+	mu       sync.Mutex
+	assigned map[int]int
+	taken    map[int]bool
+	rejected map[int]bool
+	claims   []string
+}
+
+func (s *seatStore) seat(id int) string {
+	if user := s.assigned[id]; user != 0 {
+		return fmt.Sprintf(`{"id": %d, "license_id": 4, "assigned_user": {"id": %d}}`, id, user)
+	}
+	return fmt.Sprintf(`{"id": %d, "license_id": 4, "assigned_user": null}`, id)
+}
+
+func (s *seatStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path == "/api/v1/licenses/4/seats" {
+		var rows []string
+		for id := 1; id <= len(s.assigned); id++ {
+			rows = append(rows, s.seat(id))
+		}
+		fmt.Fprintf(w, `{"total": %d, "rows": [%s]}`, len(rows), strings.Join(rows, ","))
+		return
+	}
+
+	var id int
+	fmt.Sscanf(strings.TrimPrefix(r.URL.Path, "/api/v1/licenses/4/seats/"), "%d", &id)
+	switch r.Method {
+	case http.MethodGet:
+		if s.taken[id] {
+			s.assigned[id] = 99
+		}
+		fmt.Fprintf(w, `%s`, s.seat(id))
+	case http.MethodPatch:
+		var body struct {
+			AssignedTo int `json:"assigned_to"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		s.claims = append(s.claims, fmt.Sprintf("%d:%d", id, body.AssignedTo))
+		if s.rejected[id] {
+			s.assigned[id] = 98
+			fmt.Fprint(w, `{"status": "error", "messages": "This seat is already checked out"}`)
+			return
+		}
+		s.assigned[id] = body.AssignedTo
+		fmt.Fprintf(w, `{"status": "success", "payload": %s}`, s.seat(id))
+	}
+}
+
+func TestLicensesBulkAssign(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.disableRetries = true
+
+	store := &seatStore{
+		assigned: map[int]int{1: 9, 2: 0, 3: 0, 4: 0, 5: 0},
+		taken:    map[int]bool{2: true},
+		rejected: map[int]bool{4: true},
+	}
+	mux.Handle("/api/v1/licenses/4/seats", store)
+	mux.Handle("/api/v1/licenses/4/seats/", store)
+
+	userIDs := []int{9, 10, 11, 12, 10}
+	results, err := client.Licenses.BulkAssign(4, userIDs)
+	if !errors.Is(err, ErrNoFreeSeats) {
+		t.Errorf("Licenses.BulkAssign returned error %v, expected ErrNoFreeSeats", err)
+	}
+	if len(results) != len(userIDs) {
+		t.Fatalf("Licenses.BulkAssign returned %d results, expected %d", len(results), len(userIDs))
+	}
+
+	if results[0].UserID != 9 || results[0].SeatID != 1 || results[0].Err != nil {
+		t.Errorf("results[0] = %+v, expected user 9 to keep seat 1", results[0])
+	}
+	if results[4] != results[1] {
+		t.Errorf("results[4] = %+v, expected the result of the first entry of user 10, %+v", results[4], results[1])
+	}
+
+	var seats []int
+	failed := 0
+	for _, result := range results[1:4] {
+		switch {
+		case errors.Is(result.Err, ErrNoFreeSeats) && result.SeatID == 0:
+			failed++
+		case result.Err == nil:
+			seats = append(seats, result.SeatID)
+			if store.assigned[result.SeatID] != result.UserID {
+				t.Errorf("Seat %d is assigned to user %d, expected %d", result.SeatID, store.assigned[result.SeatID], result.UserID)
+			}
+		default:
+			t.Errorf("Result %+v, expected a seat or ErrNoFreeSeats", result)
+		}
+	}
+	sort.Ints(seats)
+	if fmt.Sprint(seats) != "[3 5]" || failed != 1 {
+		t.Errorf("Licenses.BulkAssign assigned seats %v with %d users left without, expected [3 5] and 1", seats, failed)
+	}
+
+	for _, claim := range store.claims {
+		if strings.HasSuffix(claim, ":9") || strings.HasPrefix(claim, "2:") {
+			t.Errorf("Licenses.BulkAssign claimed %s, expected no claim for user 9 nor of the taken seat 2", claim)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// LocationsService handles communication with the location-related endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/locations
+type LocationsService struct {
+	client *Client
+}
+
+// LocationResponse represents the API response for a single location.
+// The single location endpoint returns the location data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Location.
+type LocationResponse struct {
+	Response
+	// Payload contains the location as returned in the payload field, if any
+	Payload *Location `json:"payload,omitempty"`
+	Location
+}
+
+// UnmarshalJSON implements json.Unmarshaler for LocationResponse.
+func (r *LocationResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Location)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Location
+	}
+	return nil
+}
+
+// LocationsResponse represents the API response for multiple locations.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Locations.
+type LocationsResponse struct {
+	Response
+	// Rows contains the list of Location objects
+	Rows []Location `json:"rows"`
+}
+
+// List returns a list of locations with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/locations
+func (s *LocationsService) List(opts *ListOptions) (*LocationsResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of locations with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/locations
+func (s *LocationsService) ListContext(ctx context.Context, opts *ListOptions) (*LocationsResponse, *http.Response, error) {
+	u := "api/v1/locations"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var locations LocationsResponse
+	resp, err := s.client.Do(req, &locations)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &locations, resp, nil
+}
+
+// Iterate returns an iterator over every location, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Location.
+func (s *LocationsService) Iterate(opts *ListOptions) iter.Seq2[Location, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every location with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Location.
+func (s *LocationsService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Location, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]Location, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single location by its ID.
+//
+// id is the unique identifier of the location to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/locationsid
+func (s *LocationsService) Get(id int) (*LocationResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single location by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the location to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/locationsid
+func (s *LocationsService) GetContext(ctx context.Context, id int) (*LocationResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/locations/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var location LocationResponse
+	resp, err := s.client.Do(req, &location)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &location, resp, nil
+}
+
+// Create creates a new location in Snipe-IT.
+//
+// location must contain the required fields:
+// - Name: The name of the location
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/locations-1
+func (s *LocationsService) Create(location Location) (*LocationResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), location)
+}
+
+// CreateContext creates a new location in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// location must contain the required fields:
+// - Name: The name of the location
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/locations-1
+func (s *LocationsService) CreateContext(ctx context.Context, location Location) (*LocationResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/locations", location)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response LocationResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing location in Snipe-IT.
+//
+// id is the unique identifier of the location to update.
+// location contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/locationsid-1
+func (s *LocationsService) Update(id int, location Location) (*LocationResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, location)
+}
+
+// UpdateContext updates an existing location in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the location to update.
+// location contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/locationsid-1
+func (s *LocationsService) UpdateContext(ctx context.Context, id int, location Location) (*LocationResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/locations/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, location)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response LocationResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a location from Snipe-IT.
+//
+// id is the unique identifier of the location to delete.
+// Snipe-IT refuses to delete locations that still have assets, users or child locations.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/locationsid-2
+func (s *LocationsService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a location from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the location to delete.
+// Snipe-IT refuses to delete locations that still have assets, users or child locations.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/locationsid-2
+func (s *LocationsService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/locations/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
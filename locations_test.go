@@ -0,0 +1,90 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestLocationsList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/locations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "Authorization", "Bearer test-token")
+		if search := r.URL.Query().Get("search"); search != "HQ" {
+			t.Errorf("Request search = %q, expected %q", search, "HQ")
+		}
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 1, "name": "HQ", "city": "Chicago", "parent": {"id": 5, "name": "North America"}, "assets_count": 40},
+				{"id": 2, "name": "HQ Annex", "city": "Chicago"}
+			]
+		}`)
+	})
+
+	locations, _, err := client.Locations.List(&ListOptions{Search: "HQ"})
+	if err != nil {
+		t.Fatalf("Locations.List returned error: %v", err)
+	}
+
+	if len(locations.Rows) != 2 {
+		t.Fatalf("Locations.List returned %d locations, expected %d", len(locations.Rows), 2)
+	}
+
+	hq := locations.Rows[0]
+	if hq.City != "Chicago" || hq.Parent == nil || hq.Parent.ID != 5 || hq.AssetsCount != 40 {
+		t.Errorf("Locations.List returned %+v, expected HQ in Chicago under location 5", hq)
+	}
+}
+
+func TestLocationsCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/locations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["name"] != "Warehouse" || requestBody["parent_id"] != 1.0 {
+			t.Errorf("Request body = %v, expected name and parent_id", requestBody)
+		}
+
+		fmt.Fprint(w, `{
+			"status": "success",
+			"messages": "Location created successfully.",
+			"payload": {"id": 3, "name": "Warehouse"}
+		}`)
+	})
+
+	location := Location{ParentID: 1}
+	location.Name = "Warehouse"
+
+	created, _, err := client.Locations.Create(location)
+	if err != nil {
+		t.Fatalf("Locations.Create returned error: %v", err)
+	}
+
+	if created.Status != "success" || created.Payload == nil || created.Payload.ID != 3 {
+		t.Errorf("Locations.Create returned %+v, expected a success payload with ID 3", created)
+	}
+}
+
+func TestLocationsDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/locations/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success", "messages": "Location deleted."}`)
+	})
+
+	if _, err := client.Locations.Delete(3); err != nil {
+		t.Errorf("Locations.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,259 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// MaintenancesService handles communication with the asset maintenance endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenances
+type MaintenancesService struct {
+	client *Client
+}
+
+// MaintenanceResponse represents the API response for a single maintenance.
+// The single maintenance endpoint returns the maintenance data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Maintenance.
+type MaintenanceResponse struct {
+	Response
+	// Payload contains the maintenance as returned in the payload field, if any
+	Payload *Maintenance `json:"payload,omitempty"`
+	Maintenance
+}
+
+// UnmarshalJSON implements json.Unmarshaler for MaintenanceResponse.
+func (r *MaintenanceResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Maintenance)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Maintenance
+	}
+	return nil
+}
+
+// MaintenancesResponse represents the API response for multiple maintenances.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Maintenances.
+type MaintenancesResponse struct {
+	Response
+	// Rows contains the list of Maintenance objects
+	Rows []Maintenance `json:"rows"`
+}
+
+// MaintenanceListOptions specifies the options for listing maintenances.
+// It embeds ListOptions for pagination, search and sorting, and adds
+// a filter on the asset.
+type MaintenanceListOptions struct {
+	ListOptions
+
+	// AssetID filters maintenances by the asset they were performed on
+	AssetID int `url:"asset_id,omitempty"`
+}
+
+// List returns a list of asset maintenances with pagination and filter options.
+//
+// opts can be used to customize the response with pagination, search, sorting
+// and an asset filter. If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenances
+func (s *MaintenancesService) List(opts *MaintenanceListOptions) (*MaintenancesResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of asset maintenances with the provided context and options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, sorting
+// and an asset filter. If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenances
+func (s *MaintenancesService) ListContext(ctx context.Context, opts *MaintenanceListOptions) (*MaintenancesResponse, *http.Response, error) {
+	u := "api/v1/maintenances"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var maintenances MaintenancesResponse
+	resp, err := s.client.Do(req, &maintenances)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &maintenances, resp, nil
+}
+
+// Iterate returns an iterator over every maintenance, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Maintenance.
+func (s *MaintenancesService) Iterate(opts *MaintenanceListOptions) iter.Seq2[Maintenance, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every maintenance with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Maintenance.
+func (s *MaintenancesService) IterateContext(ctx context.Context, opts *MaintenanceListOptions) iter.Seq2[Maintenance, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *MaintenanceListOptions) ([]Maintenance, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single asset maintenance by its ID.
+//
+// id is the unique identifier of the maintenance to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenancesid
+func (s *MaintenancesService) Get(id int) (*MaintenanceResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single asset maintenance by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the maintenance to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenancesid
+func (s *MaintenancesService) GetContext(ctx context.Context, id int) (*MaintenanceResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/maintenances/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var maintenance MaintenanceResponse
+	resp, err := s.client.Do(req, &maintenance)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &maintenance, resp, nil
+}
+
+// Create records a new asset maintenance in Snipe-IT.
+//
+// maintenance must contain the required fields:
+// - Title: A short description of the maintenance
+// - AssetID: The ID of the asset the maintenance is performed on
+// - MaintenanceType: The kind of maintenance (e.g., "Repair")
+// - StartDate: When the maintenance started
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenances-1
+func (s *MaintenancesService) Create(maintenance Maintenance) (*MaintenanceResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), maintenance)
+}
+
+// CreateContext records a new asset maintenance in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// maintenance must contain the required fields:
+// - Title: A short description of the maintenance
+// - AssetID: The ID of the asset the maintenance is performed on
+// - MaintenanceType: The kind of maintenance (e.g., "Repair")
+// - StartDate: When the maintenance started
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenances-1
+func (s *MaintenancesService) CreateContext(ctx context.Context, maintenance Maintenance) (*MaintenanceResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/maintenances", maintenance)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response MaintenanceResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing asset maintenance in Snipe-IT.
+//
+// id is the unique identifier of the maintenance to update.
+// maintenance contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenancesid-2
+func (s *MaintenancesService) Update(id int, maintenance Maintenance) (*MaintenanceResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, maintenance)
+}
+
+// UpdateContext updates an existing asset maintenance in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the maintenance to update.
+// maintenance contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenancesid-2
+func (s *MaintenancesService) UpdateContext(ctx context.Context, id int, maintenance Maintenance) (*MaintenanceResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/maintenances/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, maintenance)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response MaintenanceResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes an asset maintenance from Snipe-IT.
+//
+// id is the unique identifier of the maintenance to delete.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenancesid-1
+func (s *MaintenancesService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes an asset maintenance from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the maintenance to delete.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/maintenancesid-1
+func (s *MaintenancesService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/maintenances/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
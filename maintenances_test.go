@@ -0,0 +1,108 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestMaintenancesList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/maintenances", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if r.URL.Query().Get("asset_id") != "42" {
+			t.Errorf("Request URL query parameter 'asset_id' = %v, expected %v", r.URL.Query().Get("asset_id"), "42")
+		}
+
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{
+					"id": 3,
+					"title": "Battery replacement",
+					"asset": {"id": 42, "name": "Laptop 42", "asset_tag": "AT-42"},
+					"supplier": {"id": 5, "name": "Apple"},
+					"asset_maintenance_type": "Repair",
+					"cost": "129.00",
+					"start_date": "2024-03-01 00:00:00",
+					"asset_maintenance_time": 3,
+					"is_warranty": true
+				}
+			]
+		}`)
+	})
+
+	maintenances, _, err := client.Maintenances.List(&MaintenanceListOptions{AssetID: 42})
+	if err != nil {
+		t.Fatalf("Maintenances.List returned error: %v", err)
+	}
+
+	if len(maintenances.Rows) != 1 {
+		t.Fatalf("Maintenances.List returned %d maintenances, expected %d", len(maintenances.Rows), 1)
+	}
+
+	m := maintenances.Rows[0]
+	if m.Title != "Battery replacement" || m.MaintenanceType != "Repair" || m.Cost == nil || m.Cost.Cents != 12900 || !m.IsWarranty {
+		t.Errorf("Maintenances.List returned %+v, expected the warranty battery repair", m)
+	}
+
+	if m.Asset == nil || m.Asset.AssetTag != "AT-42" {
+		t.Errorf("Maintenances.List returned Asset = %+v, expected asset tag %q", m.Asset, "AT-42")
+	}
+
+	if m.StartDate == nil || m.StartDate.Format("2006-01-02") != "2024-03-01" {
+		t.Errorf("Maintenances.List returned StartDate = %v, expected %v", m.StartDate, "2024-03-01")
+	}
+}
+
+func TestMaintenancesCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/maintenances", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["asset_id"] != float64(42) {
+			t.Errorf("Request body asset_id = %v, expected %v", requestBody["asset_id"], 42)
+		}
+		if requestBody["asset_maintenance_type"] != "Upgrade" {
+			t.Errorf("Request body asset_maintenance_type = %v, expected %v", requestBody["asset_maintenance_type"], "Upgrade")
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 4, "title": "RAM upgrade", "asset_maintenance_type": "Upgrade"}}`)
+	})
+
+	maintenance, _, err := client.Maintenances.Create(Maintenance{
+		Title:           "RAM upgrade",
+		AssetID:         42,
+		MaintenanceType: "Upgrade",
+	})
+	if err != nil {
+		t.Fatalf("Maintenances.Create returned error: %v", err)
+	}
+
+	if maintenance.Payload == nil || maintenance.Payload.ID != 4 {
+		t.Errorf("Maintenances.Create returned Payload = %+v, expected ID %d", maintenance.Payload, 4)
+	}
+}
+
+func TestMaintenancesDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/maintenances/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success"}`)
+	})
+
+	if _, err := client.Maintenances.Delete(4); err != nil {
+		t.Fatalf("Maintenances.Delete returned error: %v", err)
+	}
+}
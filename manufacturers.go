@@ -0,0 +1,245 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// ManufacturersService handles communication with the manufacturer-related endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/manufacturers
+type ManufacturersService struct {
+	client *Client
+}
+
+// ManufacturerResponse represents the API response for a single manufacturer.
+// The single manufacturer endpoint returns the manufacturer data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Manufacturer.
+type ManufacturerResponse struct {
+	Response
+	// Payload contains the manufacturer as returned in the payload field, if any
+	Payload *Manufacturer `json:"payload,omitempty"`
+	Manufacturer
+}
+
+// UnmarshalJSON implements json.Unmarshaler for ManufacturerResponse.
+func (r *ManufacturerResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Manufacturer)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Manufacturer
+	}
+	return nil
+}
+
+// ManufacturersResponse represents the API response for multiple manufacturers.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Manufacturers.
+type ManufacturersResponse struct {
+	Response
+	// Rows contains the list of Manufacturer objects
+	Rows []Manufacturer `json:"rows"`
+}
+
+// List returns a list of manufacturers with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/manufacturers
+func (s *ManufacturersService) List(opts *ListOptions) (*ManufacturersResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of manufacturers with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/manufacturers
+func (s *ManufacturersService) ListContext(ctx context.Context, opts *ListOptions) (*ManufacturersResponse, *http.Response, error) {
+	u := "api/v1/manufacturers"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var manufacturers ManufacturersResponse
+	resp, err := s.client.Do(req, &manufacturers)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &manufacturers, resp, nil
+}
+
+// Iterate returns an iterator over every manufacturer, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Manufacturer.
+func (s *ManufacturersService) Iterate(opts *ListOptions) iter.Seq2[Manufacturer, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every manufacturer with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Manufacturer.
+func (s *ManufacturersService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Manufacturer, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]Manufacturer, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single manufacturer by its ID.
+//
+// id is the unique identifier of the manufacturer to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/manufacturersid
+func (s *ManufacturersService) Get(id int) (*ManufacturerResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single manufacturer by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the manufacturer to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/manufacturersid
+func (s *ManufacturersService) GetContext(ctx context.Context, id int) (*ManufacturerResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/manufacturers/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var manufacturer ManufacturerResponse
+	resp, err := s.client.Do(req, &manufacturer)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &manufacturer, resp, nil
+}
+
+// Create creates a new manufacturer in Snipe-IT.
+//
+// manufacturer must contain the required fields:
+// - Name: The name of the manufacturer
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/manufacturers-1
+func (s *ManufacturersService) Create(manufacturer Manufacturer) (*ManufacturerResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), manufacturer)
+}
+
+// CreateContext creates a new manufacturer in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// manufacturer must contain the required fields:
+// - Name: The name of the manufacturer
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/manufacturers-1
+func (s *ManufacturersService) CreateContext(ctx context.Context, manufacturer Manufacturer) (*ManufacturerResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/manufacturers", manufacturer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response ManufacturerResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing manufacturer in Snipe-IT.
+//
+// id is the unique identifier of the manufacturer to update.
+// manufacturer contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/manufacturersid-1
+func (s *ManufacturersService) Update(id int, manufacturer Manufacturer) (*ManufacturerResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, manufacturer)
+}
+
+// UpdateContext updates an existing manufacturer in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the manufacturer to update.
+// manufacturer contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/manufacturersid-1
+func (s *ManufacturersService) UpdateContext(ctx context.Context, id int, manufacturer Manufacturer) (*ManufacturerResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/manufacturers/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, manufacturer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response ManufacturerResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a manufacturer from Snipe-IT.
+//
+// id is the unique identifier of the manufacturer to delete.
+// Snipe-IT refuses to delete manufacturers that still have models or other items.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/manufacturersid-2
+func (s *ManufacturersService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a manufacturer from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the manufacturer to delete.
+// Snipe-IT refuses to delete manufacturers that still have models or other items.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/manufacturersid-2
+func (s *ManufacturersService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/manufacturers/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
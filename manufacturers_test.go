@@ -0,0 +1,122 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestManufacturersList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/manufacturers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "Authorization", "Bearer test-token")
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 1, "name": "Apple", "url": "https://apple.example", "support_email": "support@apple.example", "assets_count": 80},
+				{"id": 2, "name": "Dell", "assets_count": 45}
+			]
+		}`)
+	})
+
+	manufacturers, _, err := client.Manufacturers.List(nil)
+	if err != nil {
+		t.Fatalf("Manufacturers.List returned error: %v", err)
+	}
+
+	if len(manufacturers.Rows) != 2 {
+		t.Fatalf("Manufacturers.List returned %d manufacturers, expected %d", len(manufacturers.Rows), 2)
+	}
+
+	apple := manufacturers.Rows[0]
+	if apple.Name != "Apple" || apple.SupportEmail != "support@apple.example" || apple.AssetsCount != 80 {
+		t.Errorf("Manufacturers.List returned %+v, expected Apple with 80 assets", apple)
+	}
+}
+
+func TestManufacturersGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/manufacturers/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "name": "Apple", "support_phone": "555-0100"}`)
+	})
+
+	manufacturer, _, err := client.Manufacturers.Get(1)
+	if err != nil {
+		t.Fatalf("Manufacturers.Get returned error: %v", err)
+	}
+
+	if manufacturer.ID != 1 || manufacturer.SupportPhone != "555-0100" {
+		t.Errorf("Manufacturers.Get returned %+v, expected ID 1 with support phone 555-0100", manufacturer.Manufacturer)
+	}
+}
+
+func TestManufacturersCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/manufacturers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["name"] != "Lenovo" {
+			t.Errorf("Request body name = %v, expected %v", requestBody["name"], "Lenovo")
+		}
+
+		fmt.Fprint(w, `{
+			"status": "success",
+			"messages": "Manufacturer created successfully.",
+			"payload": {"id": 3, "name": "Lenovo"}
+		}`)
+	})
+
+	manufacturer, _, err := client.Manufacturers.Create(Manufacturer{CommonFields: CommonFields{Name: "Lenovo"}})
+	if err != nil {
+		t.Fatalf("Manufacturers.Create returned error: %v", err)
+	}
+
+	if manufacturer.Payload == nil || manufacturer.Payload.ID != 3 {
+		t.Errorf("Manufacturers.Create returned Payload = %+v, expected ID %d", manufacturer.Payload, 3)
+	}
+}
+
+func TestManufacturersUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/manufacturers/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 3, "name": "Lenovo Group"}}`)
+	})
+
+	manufacturer, _, err := client.Manufacturers.Update(3, Manufacturer{CommonFields: CommonFields{Name: "Lenovo Group"}})
+	if err != nil {
+		t.Fatalf("Manufacturers.Update returned error: %v", err)
+	}
+
+	if manufacturer.Name != "Lenovo Group" {
+		t.Errorf("Manufacturers.Update returned Name = %q, expected %q", manufacturer.Name, "Lenovo Group")
+	}
+}
+
+func TestManufacturersDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/manufacturers/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success", "messages": "Manufacturer deleted"}`)
+	})
+
+	if _, err := client.Manufacturers.Delete(3); err != nil {
+		t.Fatalf("Manufacturers.Delete returned error: %v", err)
+	}
+}
@@ -3,72 +3,144 @@ package snipeit
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
+// Layouts of the timestamps emitted by Snipe-IT.
+const (
+	// snipeDateTimeLayout is PHP's "Y-m-d H:i:s", used by most fields
+	snipeDateTimeLayout = "2006-01-02 15:04:05"
+
+	// snipeDateLayout is PHP's "Y-m-d", used by date-only fields
+	snipeDateLayout = "2006-01-02"
+)
+
+// snipeTimeLayouts lists the layouts SnipeTime accepts, most common first.
+// RFC 3339 covers the ISO 8601 timestamps of newer releases, with or
+// without fractional seconds.
+var snipeTimeLayouts = []string{
+	snipeDateTimeLayout,
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	snipeDateLayout,
+}
+
 // SnipeTime represents a time field from the Snipe-IT API.
-// Snipe-IT returns times as objects with "datetime" and "formatted" fields.
+//
+// Snipe-IT emits times in several shapes depending on the endpoint and
+// release: "Y-m-d H:i:s" strings, RFC 3339 strings with or without
+// microseconds, date-only strings, objects with "datetime" or "date" and
+// "formatted" fields, PHP DateTime objects with "date" and "timezone"
+// fields, and null or empty strings for unset values, which decode as the
+// zero time.
 type SnipeTime struct {
 	time.Time
 }
 
-// UnmarshalJSON implements json.Unmarshaler for SnipeTime.
+// ParseSnipeTime parses a timestamp in any of the string formats emitted by
+// Snipe-IT. Times without a zone are returned in UTC.
+func ParseSnipeTime(value string) (SnipeTime, error) {
+	return parseSnipeTimeIn(value, time.UTC)
+}
+
+// parseSnipeTimeIn parses a timestamp like ParseSnipeTime, interpreting
+// times without a zone in loc.
+func parseSnipeTimeIn(value string, loc *time.Location) (SnipeTime, error) {
+	for _, layout := range snipeTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return SnipeTime{t}, nil
+		}
+	}
+	return SnipeTime{}, fmt.Errorf("snipeit: cannot parse time %q", value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler for SnipeTime. Times without
+// a zone are interpreted in UTC; see ClientOptions.ServerTimezone.
 func (st *SnipeTime) UnmarshalJSON(data []byte) error {
+	return st.unmarshalIn(data, time.UTC)
+}
+
+// unmarshalIn decodes st from data, interpreting times without a zone
+// in loc.
+func (st *SnipeTime) unmarshalIn(data []byte, loc *time.Location) error {
 	// Handle null values
 	if string(data) == "null" {
 		st.Time = time.Time{}
 		return nil
 	}
 
-	// First try to unmarshal as a simple string (in case API changes or testing)
+	// Strings are the format of most fields
 	var str string
 	if err := json.Unmarshal(data, &str); err == nil {
-		// Try multiple time formats
-		formats := []string{
-			"2006-01-02 15:04:05",                 // Snipe-IT format
-			time.RFC3339,                           // ISO 8601 with timezone
-			"2006-01-02T15:04:05.000000Z",        // ISO 8601 with microseconds
-			"2006-01-02T15:04:05Z",               // ISO 8601 basic
-		}
-		
-		var parseErr error
-		for _, format := range formats {
-			t, err := time.Parse(format, str)
-			if err == nil {
-				st.Time = t
-				return nil
-			}
-			parseErr = err
-		}
-		return parseErr
+		return st.parse(str, loc)
 	}
 
-	// Otherwise, expect the object format
+	// Otherwise, expect the object format. Timestamps carry a "datetime"
+	// field, while date-only fields such as expected_checkin carry "date".
+	// Serialized PHP DateTime objects also carry "date", with the zone it
+	// is in.
 	var timeObj struct {
-		Datetime string `json:"datetime"`
+		Datetime  string `json:"datetime"`
+		Date      string `json:"date"`
 		Formatted string `json:"formatted"`
+		Timezone  string `json:"timezone"`
 	}
 	if err := json.Unmarshal(data, &timeObj); err != nil {
 		return err
 	}
 
-	if timeObj.Datetime != "" {
-		t, err := time.Parse("2006-01-02 15:04:05", timeObj.Datetime)
-		if err != nil {
-			return err
+	if timeObj.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(timeObj.Timezone); err != nil {
+			return fmt.Errorf("snipeit: unknown time zone %q: %w", timeObj.Timezone, err)
 		}
-		st.Time = t
 	}
 
+	if timeObj.Datetime != "" {
+		return st.parse(timeObj.Datetime, loc)
+	}
+	return st.parse(timeObj.Date, loc)
+}
+
+// parse sets st from a string, treating an empty string as unset.
+// Times without a zone are interpreted in loc.
+func (st *SnipeTime) parse(value string, loc *time.Location) error {
+	if value == "" {
+		st.Time = time.Time{}
+		return nil
+	}
+
+	parsed, err := parseSnipeTimeIn(value, loc)
+	if err != nil {
+		return err
+	}
+	st.Time = parsed.Time
 	return nil
 }
 
 // MarshalJSON implements json.Marshaler for SnipeTime.
+//
+// Times are written as "Y-m-d H:i:s", the format the API accepts for every
+// date and time field, in the time's own zone. Midnight, which is how
+// date-only values decode, is written as "Y-m-d" so that dates round-trip
+// unchanged. The zero time is written as null.
 func (st SnipeTime) MarshalJSON() ([]byte, error) {
 	if st.Time.IsZero() {
 		return []byte("null"), nil
 	}
-	return json.Marshal(st.Time.Format("2006-01-02 15:04:05"))
+	if st.isDate() {
+		return json.Marshal(st.Time.Format(snipeDateLayout))
+	}
+	return json.Marshal(st.Time.Format(snipeDateTimeLayout))
+}
+
+// isDate reports whether st holds a date without a time of day, which
+// decodes as midnight in the zone it was interpreted in.
+func (st SnipeTime) isDate() bool {
+	hour, min, sec := st.Time.Clock()
+	return hour == 0 && min == 0 && sec == 0 && st.Time.Nanosecond() == 0
 }
 
 // Response represents a standard response structure from the Snipe-IT API.
@@ -104,6 +176,29 @@ type Response struct {
 	PageSize int         `json:"pagesize,omitempty"`
 }
 
+// decodePayload decodes a single-item API response into resp and v.
+//
+// Single-item GET endpoints return the item directly, while mutating
+// endpoints wrap it in a payload field alongside status and messages.
+// The status fields are decoded into resp, and the item is decoded into v
+// from the payload field if present, or from the top-level object otherwise.
+// It reports whether the item was found in a payload field.
+func decodePayload(data []byte, resp *Response, v interface{}) (bool, error) {
+	var envelope struct {
+		Response
+		Payload json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return false, err
+	}
+	*resp = envelope.Response
+
+	if len(envelope.Payload) > 0 && string(envelope.Payload) != "null" {
+		return true, json.Unmarshal(envelope.Payload, v)
+	}
+	return false, json.Unmarshal(data, v)
+}
+
 // CommonFields contains fields that are common across many Snipe-IT resource types.
 // This is embedded in other model structs to avoid repetition.
 type CommonFields struct {
@@ -126,16 +221,22 @@ type CommonFields struct {
 	Notes       string    `json:"notes,omitempty"`
 	
 	// Available indicates if the resource is available for checkout
-	Available   bool      `json:"available"`
+	Available   Bool      `json:"available"`
 	
 	// Deleted indicates if the resource has been soft-deleted
-	Deleted     bool      `json:"deleted"`
+	Deleted     Bool      `json:"deleted"`
 	
 	// Image is a URL to the image associated with the resource
 	Image       string    `json:"image,omitempty"`
 	
-	// CustomFields contains any custom fields defined for the resource
-	CustomFields struct{} `json:"custom_fields,omitempty"`
+	// CustomFields contains the custom fields of the resource, keyed by
+	// field name
+	CustomFields CustomFields `json:"custom_fields,omitempty"`
+
+	// Raw is the JSON object the resource was decoded from, kept when the
+	// client's CaptureRawJSON option is set. It gives access to fields
+	// that the models do not cover yet.
+	Raw json.RawMessage `json:"-"`
 }
 
 // ListOptions specifies common options for paginated API methods.
@@ -158,6 +259,12 @@ type ListOptions struct {
 	
 	// Search is a search term to filter results
 	Search   string `url:"search,omitempty"`
+
+	// Prefetch is the number of pages the pagination helpers (ListPages,
+	// ListAll and Iterate) fetch concurrently after the first page. Pages
+	// are still delivered in order. Values below 2 fetch one page at a time.
+	// It is not sent to the API.
+	Prefetch int `url:"-"`
 }
 
 // Asset represents a Snipe-IT hardware asset.
@@ -187,27 +294,74 @@ type Asset struct {
 	// Manufacturer of the asset
 	Manufacturer   Manufacturer `json:"manufacturer"`
 	
-	// Supplier from whom the asset was purchased
-	Supplier       Supplier    `json:"supplier,omitempty"`
+	// Supplier from whom the asset was purchased, or nil if none is set
+	Supplier       *Supplier   `json:"supplier,omitempty"`
 	
-	// Location where the asset is physically located
-	Location       Location    `json:"location,omitempty"`
+	// Location where the asset is physically located, or nil if none is set
+	Location       *Location   `json:"location,omitempty"`
 	
 	// PurchaseDate when the asset was purchased
 	PurchaseDate   *SnipeTime  `json:"purchase_date,omitempty"`
 	
 	// PurchaseCost of the asset
-	PurchaseCost   string      `json:"purchase_cost,omitempty"`
+	PurchaseCost   *Money      `json:"purchase_cost,omitempty"`
 	
 	// WarrantyMonths is the length of the warranty in months
 	WarrantyMonths int         `json:"warranty_months,omitempty"`
 	
-	// User to whom the asset is assigned (if any)
-	User           *User       `json:"assigned_to,omitempty"`
+	// AssignedTo is the user, location or asset the asset is checked out
+	// to, or nil if it is not checked out
+	AssignedTo     *AssignedTo `json:"assigned_to,omitempty"`
 	
 	// AssignedType indicates what type of entity the asset is assigned to
 	// (e.g., "user", "location", "asset")
-	AssignedType   string      `json:"assigned_type,omitempty"`
+	AssignedType   AssignedType `json:"assigned_type,omitempty"`
+	
+	// ExpectedCheckin is when a checked-out asset is expected to be returned
+	ExpectedCheckin *SnipeTime `json:"expected_checkin,omitempty"`
+	
+	// LastCheckout is when the asset was last checked out
+	LastCheckout    *SnipeTime `json:"last_checkout,omitempty"`
+	
+	// CheckinCounter is the number of times the asset has been checked in
+	CheckinCounter  int        `json:"checkin_counter,omitempty"`
+	
+	// CheckoutCounter is the number of times the asset has been checked out
+	CheckoutCounter int        `json:"checkout_counter,omitempty"`
+	
+	// RequestsCounter is the number of times the asset has been requested
+	RequestsCounter int        `json:"requests_counter,omitempty"`
+	
+	// LastAuditDate is when the asset was last audited
+	LastAuditDate   *SnipeTime `json:"last_audit_date,omitempty"`
+	
+	// NextAuditDate is when the asset is next due for an audit
+	NextAuditDate   *SnipeTime `json:"next_audit_date,omitempty"`
+	
+	// EOL is the end-of-life period of the asset as text (e.g., "36 months")
+	EOL             string     `json:"eol,omitempty"`
+	
+	// AssetEOLDate is when the asset reaches its end of life
+	AssetEOLDate    *SnipeTime `json:"asset_eol_date,omitempty"`
+	
+	// BYOD indicates if the asset is owned by the user (bring your own device)
+	BYOD            Bool       `json:"byod,omitempty"`
+	
+	// OrderNumber is the purchase order number
+	OrderNumber     string     `json:"order_number,omitempty"`
+	
+	// Company that owns the asset, or nil if none is set
+	Company         *Company   `json:"company,omitempty"`
+	
+	// RTDLocation is the location the asset returns to when checked in
+	// (its default location), or nil if none is set
+	RTDLocation     *Location  `json:"rtd_location,omitempty"`
+	
+	// BookValue is the depreciated value of the asset
+	BookValue       *Money     `json:"book_value,omitempty"`
+	
+	// Requestable indicates if users can request the asset
+	Requestable     Bool       `json:"requestable,omitempty"`
 }
 
 // User represents a Snipe-IT user account.
@@ -238,7 +392,160 @@ type User struct {
 	Employee  string `json:"employee_num,omitempty"`
 	
 	// Activated indicates if the user account is active
-	Activated bool   `json:"activated"`
+	Activated Bool   `json:"activated"`
+	
+	// Manager of the user, or nil if none is set
+	Manager    *User       `json:"manager,omitempty"`
+	
+	// Department the user belongs to, or nil if none is set
+	Department *Department `json:"department,omitempty"`
+	
+	// Company the user belongs to, or nil if none is set
+	Company    *Company    `json:"company,omitempty"`
+	
+	// Location of the user, or nil if none is set
+	Location   *Location   `json:"location,omitempty"`
+	
+	// Groups the user is a member of, which grant permissions
+	Groups     *UserGroups `json:"groups,omitempty"`
+	
+	// Permissions granted to the user directly
+	Permissions Permissions `json:"permissions,omitempty"`
+	
+	// Remote indicates if the user works remotely
+	Remote     Bool        `json:"remote,omitempty"`
+	
+	// VIP indicates if the user is a VIP
+	VIP        Bool        `json:"vip,omitempty"`
+	
+	// StartDate is when the user started
+	StartDate  *SnipeTime  `json:"start_date,omitempty"`
+	
+	// EndDate is when the user left or will leave
+	EndDate    *SnipeTime  `json:"end_date,omitempty"`
+	
+	// LastLogin is when the user last logged in
+	LastLogin  *SnipeTime  `json:"last_login,omitempty"`
+	
+	// ManagerID is the ID of the manager, used when creating or updating
+	ManagerID    int `json:"manager_id,omitempty"`
+	
+	// DepartmentID is the ID of the department, used when creating or updating
+	DepartmentID int `json:"department_id,omitempty"`
+	
+	// CompanyID is the ID of the company, used when creating or updating
+	CompanyID    int `json:"company_id,omitempty"`
+	
+	// LocationID is the ID of the location, used when creating or updating
+	LocationID   int `json:"location_id,omitempty"`
+	
+	// Password is the password to set, used when creating or updating.
+	// The API never returns it.
+	Password             string `json:"password,omitempty"`
+	
+	// PasswordConfirmation must repeat Password when it is set
+	PasswordConfirmation string `json:"password_confirmation,omitempty"`
+}
+
+// Group represents a Snipe-IT permission group.
+type Group struct {
+	// CommonFields contains standard fields like ID, Name, etc.
+	CommonFields
+
+	// Permissions granted to members of the group
+	Permissions Permissions `json:"permissions,omitempty"`
+}
+
+// Permissions maps permission names (e.g., "superuser", "assets.view") to
+// their setting: "1" grants the permission, "-1" denies it, and "0"
+// inherits it from the user's groups.
+type Permissions map[string]string
+
+// UnmarshalJSON implements json.Unmarshaler for Permissions. Depending on
+// the Snipe-IT version, permissions are an object whose values are strings
+// or numbers, a JSON-encoded string holding that object, or an empty array.
+func (p *Permissions) UnmarshalJSON(data []byte) error {
+	var encoded string
+	if err := json.Unmarshal(data, &encoded); err == nil {
+		if encoded == "" {
+			*p = nil
+			return nil
+		}
+		data = []byte(encoded)
+	}
+
+	var values map[string]json.Number
+	if err := json.Unmarshal(data, &values); err != nil {
+		var empty []interface{}
+		if json.Unmarshal(data, &empty) == nil && len(empty) == 0 {
+			*p = nil
+			return nil
+		}
+
+		var strs map[string]string
+		if err := json.Unmarshal(data, &strs); err != nil {
+			return fmt.Errorf("snipeit: cannot decode permissions %s", data)
+		}
+		*p = strs
+		return nil
+	}
+
+	if values == nil {
+		*p = nil
+		return nil
+	}
+	permissions := make(Permissions, len(values))
+	for name, value := range values {
+		permissions[name] = value.String()
+	}
+	*p = permissions
+	return nil
+}
+
+// UserGroups holds the groups of a user.
+//
+// The API returns them as an object with a total and rows, but expects an
+// array of group IDs when creating or updating a user, which is how
+// UserGroups is encoded.
+type UserGroups struct {
+	// Total is the number of groups
+	Total int `json:"total"`
+
+	// Rows contains the groups
+	Rows []Group `json:"rows"`
+}
+
+// MarshalJSON implements json.Marshaler for UserGroups, encoding the IDs
+// of its groups.
+func (g UserGroups) MarshalJSON() ([]byte, error) {
+	ids := make([]int, len(g.Rows))
+	for i, group := range g.Rows {
+		ids[i] = group.ID
+	}
+	return json.Marshal(ids)
+}
+
+// UnmarshalJSON implements json.Unmarshaler for UserGroups. It accepts the
+// object the API returns, and the array of group IDs MarshalJSON writes, so
+// that users saved as JSON can be read back.
+func (g *UserGroups) UnmarshalJSON(data []byte) error {
+	var ids []int
+	if err := json.Unmarshal(data, &ids); err == nil {
+		rows := make([]Group, len(ids))
+		for i, id := range ids {
+			rows[i].ID = id
+		}
+		*g = UserGroups{Total: len(ids), Rows: rows}
+		return nil
+	}
+
+	type userGroups UserGroups
+	var groups userGroups
+	if err := json.Unmarshal(data, &groups); err != nil {
+		return err
+	}
+	*g = UserGroups(groups)
+	return nil
 }
 
 // Model represents a Snipe-IT model.
@@ -257,9 +564,22 @@ type Model struct {
 	// Manufacturer of this model
 	Manufacturer  Manufacturer `json:"manufacturer"`
 	
+	// CategoryID is the ID of the category, used when creating or updating
+	CategoryID    int         `json:"category_id,omitempty"`
+	
+	// ManufacturerID is the ID of the manufacturer, used when creating or updating
+	ManufacturerID int        `json:"manufacturer_id,omitempty"`
+	
 	// FieldsetID is the ID of the custom fieldset associated with this model
 	FieldsetID    int         `json:"fieldset_id,omitempty"`
 	
+	// Depreciation is the schedule over which assets of this model depreciate,
+	// or nil if none is set
+	Depreciation  *Depreciation `json:"depreciation,omitempty"`
+	
+	// DepreciationID is the ID of the depreciation, used when creating or updating
+	DepreciationID int        `json:"depreciation_id,omitempty"`
+	
 	// EOL is the End of Life in months for this model
 	EOL           int         `json:"eol,omitempty"`
 	
@@ -274,19 +594,26 @@ type Category struct {
 	CommonFields
 	
 	// Type of category (e.g., "asset", "accessory", "consumable", "component")
-	Type          string `json:"type"`
+	//
+	// Deprecated: Snipe-IT reports the type as category_type; use CategoryType.
+	Type          CategoryType `json:"type,omitempty"`
+	
+	// CategoryType is the kind of item the category holds ("asset", "accessory",
+	// "consumable", "component" or "license"). The API reports and expects the
+	// type under this name; it is required when creating a category.
+	CategoryType  CategoryType `json:"category_type,omitempty"`
 	
 	// EULA indicates if this category requires a EULA acceptance
-	EULA          bool   `json:"eula,omitempty"`
+	EULA          Bool   `json:"eula,omitempty"`
 	
 	// Checkin indicates if email should be sent on checkin
-	Checkin       bool   `json:"checkin_email,omitempty"`
+	Checkin       Bool   `json:"checkin_email,omitempty"`
 	
 	// Checkout indicates if email should be sent on checkout
-	Checkout      bool   `json:"checkout_email,omitempty"`
+	Checkout      Bool   `json:"checkout_email,omitempty"`
 	
 	// RequireMAAC indicates if manager acceptance is required
-	RequireMAAC   bool   `json:"require_acceptance,omitempty"`
+	RequireMAAC   Bool   `json:"require_acceptance,omitempty"`
 	
 	// AssetsCount is the number of assets in this category
 	AssetsCount   int    `json:"assets_count,omitempty"`
@@ -317,6 +644,25 @@ type Manufacturer struct {
 	AssetsCount  int    `json:"assets_count,omitempty"`
 }
 
+// Depreciation represents a Snipe-IT depreciation.
+// Depreciations are the schedules over which assets lose their value, in a
+// straight line from their purchase cost down to a minimum value.
+type Depreciation struct {
+	// CommonFields contains standard fields like ID, Name, etc.
+	CommonFields
+
+	// Months is the number of months over which assets depreciate
+	Months int `json:"months"`
+
+	// DepreciationMin is the minimum value of depreciated assets: an amount,
+	// or a percentage of the purchase cost, depending on DepreciationType
+	DepreciationMin float64 `json:"depreciation_min,omitempty"`
+
+	// DepreciationType is how DepreciationMin is expressed. Servers that do
+	// not report it only support amounts.
+	DepreciationType DepreciationType `json:"depreciation_type,omitempty"`
+}
+
 // Location represents a Snipe-IT location.
 // Locations are physical places where assets can be assigned or checked out to.
 type Location struct {
@@ -364,13 +710,25 @@ type StatusLabel struct {
 	CommonFields
 	
 	// Type of status (typically "deployable", "undeployable" or "archived")
-	Type       string `json:"type"`
+	Type       StatusType `json:"type"`
 	
 	// StatusMeta provides metadata about the status
-	StatusMeta string `json:"status_meta"`
+	StatusMeta StatusMeta `json:"status_meta"`
 	
 	// StatusType indicates the deployment status (typically same as Type)
-	StatusType string `json:"status_type"`
+	StatusType StatusType `json:"status_type"`
+	
+	// Color is the hex color used to display the status label
+	Color      string `json:"color,omitempty"`
+	
+	// ShowInNav indicates if the status label is shown in the navigation sidebar
+	ShowInNav  Bool   `json:"show_in_nav,omitempty"`
+	
+	// DefaultLabel indicates if the status label is preselected for new assets
+	DefaultLabel Bool `json:"default_label,omitempty"`
+	
+	// AssetsCount is the number of assets with this status label
+	AssetsCount int   `json:"assets_count,omitempty"`
 }
 
 // Supplier represents a Snipe-IT supplier.
@@ -414,4 +772,573 @@ type Supplier struct {
 	
 	// AssetsCount is the number of assets from this supplier
 	AssetsCount int    `json:"assets_count,omitempty"`
-}
\ No newline at end of file
+}
+// Fieldset represents a Snipe-IT custom fieldset.
+// Fieldsets group custom fields together and are attached to models,
+// so that every asset of a model carries the same set of custom fields.
+type Fieldset struct {
+	// CommonFields contains standard fields like ID, Name, etc.
+	CommonFields
+
+	// Fields contains the custom fields that belong to this fieldset
+	Fields FieldsetFields `json:"fields"`
+
+	// Models contains the models that use this fieldset
+	Models FieldsetModels `json:"models"`
+}
+
+// FieldsetFields is the list of custom fields embedded in a Fieldset.
+type FieldsetFields struct {
+	// Total number of fields in the fieldset
+	Total int `json:"total"`
+
+	// Rows contains the custom fields
+	Rows []CustomField `json:"rows"`
+}
+
+// FieldsetModels is the list of models embedded in a Fieldset.
+type FieldsetModels struct {
+	// Total number of models using the fieldset
+	Total int `json:"total"`
+
+	// Rows contains the models
+	Rows []Model `json:"rows"`
+}
+
+// CustomField represents a Snipe-IT custom field definition.
+// Custom field values are stored on assets in a database column
+// named by DBColumnName (e.g., "_snipeit_mac_address_1").
+type CustomField struct {
+	// CommonFields contains standard fields like ID, Name, etc.
+	CommonFields
+
+	// DBColumnName is the name of the column holding the field's values
+	DBColumnName string `json:"db_column_name"`
+
+	// Format is the validation format (e.g., "ANY", "NUMERIC", "MAC", "BOOLEAN")
+	// or a custom regular expression
+	Format string `json:"format"`
+
+	// Element is the form element used for the field (e.g., "text", "listbox", "checkbox")
+	Element string `json:"type"`
+
+	// FieldValues contains the newline-separated allowed values for list elements
+	FieldValues string `json:"field_values,omitempty"`
+
+	// FieldValuesArray contains the allowed values for list elements
+	FieldValuesArray []string `json:"field_values_array,omitempty"`
+
+	// Required indicates if the field is required within its fieldset
+	Required Bool `json:"required"`
+
+	// DisplayInUserView indicates if the field is shown to the assigned user
+	DisplayInUserView Bool `json:"display_in_user_view"`
+
+	// HelpText is shown beneath the field in the asset form
+	HelpText string `json:"help_text,omitempty"`
+
+	// FieldEncrypted indicates if the field's values are stored encrypted
+	FieldEncrypted Bool `json:"field_encrypted,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler for CustomField.
+// The API reports the form element as "type" but expects it as "element"
+// when creating or updating a field, so both keys are written.
+func (f CustomField) MarshalJSON() ([]byte, error) {
+	type customField CustomField
+	return json.Marshal(struct {
+		customField
+		Element string `json:"element,omitempty"`
+	}{customField(f), f.Element})
+}
+
+// Accessory represents a Snipe-IT accessory.
+// Accessories are peripherals such as keyboards, mice and headsets that are
+// tracked by quantity rather than individually.
+type Accessory struct {
+	// CommonFields contains standard fields like ID, Name, etc.
+	CommonFields
+
+	// Category of the accessory
+	Category Category `json:"category"`
+
+	// Manufacturer of the accessory
+	Manufacturer Manufacturer `json:"manufacturer"`
+
+	// Supplier from whom the accessory was purchased, or nil if none is set
+	Supplier *Supplier `json:"supplier,omitempty"`
+
+	// Location where the accessory is stored, or nil if none is set
+	Location *Location `json:"location,omitempty"`
+
+	// Company that owns the accessory, or nil if none is set
+	Company *Company `json:"company,omitempty"`
+
+	// CategoryID is the ID of the category, used when creating or updating
+	CategoryID int `json:"category_id,omitempty"`
+
+	// ManufacturerID is the ID of the manufacturer, used when creating or updating
+	ManufacturerID int `json:"manufacturer_id,omitempty"`
+
+	// SupplierID is the ID of the supplier, used when creating or updating
+	SupplierID int `json:"supplier_id,omitempty"`
+
+	// LocationID is the ID of the location, used when creating or updating
+	LocationID int `json:"location_id,omitempty"`
+
+	// CompanyID is the ID of the company, used when creating or updating
+	CompanyID int `json:"company_id,omitempty"`
+
+	// ModelNumber is the manufacturer's model number
+	ModelNumber string `json:"model_number,omitempty"`
+
+	// OrderNumber is the order number of the purchase
+	OrderNumber string `json:"order_number,omitempty"`
+
+	// PurchaseDate when the accessory was purchased
+	PurchaseDate *SnipeTime `json:"purchase_date,omitempty"`
+
+	// PurchaseCost of the accessory
+	PurchaseCost *Money `json:"purchase_cost,omitempty"`
+
+	// Qty is the total quantity of the accessory
+	Qty int `json:"qty"`
+
+	// MinQty is the quantity at or below which a low-stock alert is raised
+	MinQty int `json:"min_qty,omitempty"`
+
+	// RemainingQty is the quantity not currently checked out
+	RemainingQty int `json:"remaining_qty"`
+}
+
+// AccessoryCheckout represents a user holding a checked-out accessory.
+// Each checkout is identified by AssignedPivotID, which is needed to check
+// that particular unit back in.
+type AccessoryCheckout struct {
+	// AssignedPivotID is the ID of the checkout record
+	AssignedPivotID int `json:"assigned_pivot_id"`
+
+	// ID is the ID of the user holding the accessory
+	ID int `json:"id"`
+
+	// Username of the user holding the accessory
+	Username string `json:"username"`
+
+	// Name is the full name of the user holding the accessory
+	Name string `json:"name"`
+
+	// FirstName of the user holding the accessory
+	FirstName string `json:"first_name,omitempty"`
+
+	// LastName of the user holding the accessory
+	LastName string `json:"last_name,omitempty"`
+
+	// EmployeeNumber of the user holding the accessory
+	EmployeeNumber string `json:"employee_number,omitempty"`
+
+	// CheckoutNotes is the note entered at checkout
+	CheckoutNotes string `json:"checkout_notes,omitempty"`
+
+	// LastCheckout is when the accessory was checked out
+	LastCheckout *SnipeTime `json:"last_checkout,omitempty"`
+
+	// Type is the kind of entity holding the accessory (typically "user")
+	Type string `json:"type,omitempty"`
+}
+
+// Consumable represents a Snipe-IT consumable.
+// Consumables are items such as toner or paper that are used up once
+// checked out and are therefore never checked back in.
+type Consumable struct {
+	// CommonFields contains standard fields like ID, Name, etc.
+	CommonFields
+
+	// Category of the consumable
+	Category Category `json:"category"`
+
+	// Manufacturer of the consumable
+	Manufacturer Manufacturer `json:"manufacturer"`
+
+	// Supplier from whom the consumable was purchased, or nil if none is set
+	Supplier *Supplier `json:"supplier,omitempty"`
+
+	// Location where the consumable is stored, or nil if none is set
+	Location *Location `json:"location,omitempty"`
+
+	// CategoryID is the ID of the category, used when creating or updating
+	CategoryID int `json:"category_id,omitempty"`
+
+	// ManufacturerID is the ID of the manufacturer, used when creating or updating
+	ManufacturerID int `json:"manufacturer_id,omitempty"`
+
+	// SupplierID is the ID of the supplier, used when creating or updating
+	SupplierID int `json:"supplier_id,omitempty"`
+
+	// LocationID is the ID of the location, used when creating or updating
+	LocationID int `json:"location_id,omitempty"`
+
+	// CompanyID is the ID of the company, used when creating or updating
+	CompanyID int `json:"company_id,omitempty"`
+
+	// ItemNo is the item number of the consumable
+	ItemNo string `json:"item_no,omitempty"`
+
+	// ModelNumber is the manufacturer's model number
+	ModelNumber string `json:"model_number,omitempty"`
+
+	// OrderNumber is the order number of the purchase
+	OrderNumber string `json:"order_number,omitempty"`
+
+	// PurchaseDate when the consumable was purchased
+	PurchaseDate *SnipeTime `json:"purchase_date,omitempty"`
+
+	// PurchaseCost of the consumable
+	PurchaseCost *Money `json:"purchase_cost,omitempty"`
+
+	// Qty is the total quantity of the consumable
+	Qty int `json:"qty"`
+
+	// MinAmt is the quantity at or below which a low-stock alert is raised
+	MinAmt int `json:"min_amt,omitempty"`
+
+	// Remaining is the quantity not yet checked out
+	Remaining int `json:"remaining"`
+}
+
+// ConsumableCheckout represents a single checkout of a consumable.
+type ConsumableCheckout struct {
+	// Name of the user the consumable was checked out to.
+	// Snipe-IT renders this as an HTML link to the user.
+	Name string `json:"name"`
+
+	// CreatedAt is when the consumable was checked out
+	CreatedAt *SnipeTime `json:"created_at,omitempty"`
+
+	// Note entered at checkout
+	Note string `json:"note,omitempty"`
+
+	// Admin is the user who performed the checkout.
+	// Snipe-IT renders this as an HTML link to the user.
+	Admin string `json:"admin,omitempty"`
+}
+
+// License represents a Snipe-IT software license.
+// Licenses have a number of seats that can be checked out to users or assets.
+type License struct {
+	// CommonFields contains standard fields like ID, Name, etc.
+	CommonFields
+
+	// ProductKey is the license key or serial
+	ProductKey string `json:"product_key,omitempty"`
+
+	// Company that owns the license
+	Company *Company `json:"company,omitempty"`
+
+	// Category of the license
+	Category Category `json:"category"`
+
+	// Manufacturer of the licensed software
+	Manufacturer Manufacturer `json:"manufacturer"`
+
+	// Supplier from whom the license was purchased, or nil if none is set
+	Supplier *Supplier `json:"supplier,omitempty"`
+
+	// CategoryID is the ID of the category, used when creating or updating
+	CategoryID int `json:"category_id,omitempty"`
+
+	// ManufacturerID is the ID of the manufacturer, used when creating or updating
+	ManufacturerID int `json:"manufacturer_id,omitempty"`
+
+	// SupplierID is the ID of the supplier, used when creating or updating
+	SupplierID int `json:"supplier_id,omitempty"`
+
+	// CompanyID is the ID of the company, used when creating or updating
+	CompanyID int `json:"company_id,omitempty"`
+
+	// OrderNumber is the order number of the purchase
+	OrderNumber string `json:"order_number,omitempty"`
+
+	// PurchaseOrder is the purchase order number
+	PurchaseOrder string `json:"purchase_order,omitempty"`
+
+	// PurchaseDate when the license was purchased
+	PurchaseDate *SnipeTime `json:"purchase_date,omitempty"`
+
+	// PurchaseCost of the license
+	PurchaseCost *Money `json:"purchase_cost,omitempty"`
+
+	// ExpirationDate is when the license expires
+	ExpirationDate *SnipeTime `json:"expiration_date,omitempty"`
+
+	// TerminationDate is when the license was terminated
+	TerminationDate *SnipeTime `json:"termination_date,omitempty"`
+
+	// LicenseName is the name of the person the license is registered to
+	LicenseName string `json:"license_name,omitempty"`
+
+	// LicenseEmail is the email address the license is registered to
+	LicenseEmail string `json:"license_email,omitempty"`
+
+	// Seats is the total number of seats
+	Seats int `json:"seats"`
+
+	// FreeSeatsCount is the number of seats not checked out
+	FreeSeatsCount int `json:"free_seats_count"`
+
+	// MinAmt is the number of free seats at or below which an alert is raised
+	MinAmt int `json:"min_amt,omitempty"`
+
+	// Maintained indicates if the license is covered by a maintenance contract
+	Maintained Bool `json:"maintained"`
+
+	// Reassignable indicates if seats can be checked in and reassigned
+	Reassignable Bool `json:"reassignable"`
+}
+
+// Company represents a Snipe-IT company.
+// In multi-company deployments, companies scope which assets, licenses,
+// accessories, consumables and users are visible to whom.
+type Company struct {
+	// CommonFields contains standard fields like ID, Name, etc.
+	CommonFields
+
+	// Phone number of the company
+	Phone string `json:"phone,omitempty"`
+
+	// Fax number of the company
+	Fax string `json:"fax,omitempty"`
+
+	// Email address of the company
+	Email string `json:"email,omitempty"`
+
+	// AssetsCount is the number of assets belonging to this company
+	AssetsCount int `json:"assets_count,omitempty"`
+
+	// LicensesCount is the number of licenses belonging to this company
+	LicensesCount int `json:"licenses_count,omitempty"`
+
+	// AccessoriesCount is the number of accessories belonging to this company
+	AccessoriesCount int `json:"accessories_count,omitempty"`
+
+	// ConsumablesCount is the number of consumables belonging to this company
+	ConsumablesCount int `json:"consumables_count,omitempty"`
+
+	// ComponentsCount is the number of components belonging to this company
+	ComponentsCount int `json:"components_count,omitempty"`
+
+	// UsersCount is the number of users belonging to this company
+	UsersCount int `json:"users_count,omitempty"`
+}
+
+// Department represents a Snipe-IT department.
+// Departments group users within a company and may have a manager and a location.
+type Department struct {
+	// CommonFields contains standard fields like ID, Name, etc.
+	CommonFields
+
+	// Phone number of the department
+	Phone string `json:"phone,omitempty"`
+
+	// Fax number of the department
+	Fax string `json:"fax,omitempty"`
+
+	// Company the department belongs to
+	Company *Company `json:"company,omitempty"`
+
+	// Manager of the department
+	Manager *User `json:"manager,omitempty"`
+
+	// Location of the department
+	Location *Location `json:"location,omitempty"`
+
+	// CompanyID is the ID of the company, used when creating or updating
+	CompanyID int `json:"company_id,omitempty"`
+
+	// ManagerID is the ID of the managing user, used when creating or updating
+	ManagerID int `json:"manager_id,omitempty"`
+
+	// LocationID is the ID of the location, used when creating or updating
+	LocationID int `json:"location_id,omitempty"`
+
+	// UsersCount is the number of users in the department
+	UsersCount int `json:"users_count,omitempty"`
+}
+
+// Maintenance represents a Snipe-IT asset maintenance record.
+// Maintenances track repairs, upgrades and other work performed on an asset,
+// along with who performed it, what it cost and how long it took.
+type Maintenance struct {
+	// CommonFields contains standard fields like ID, Notes, etc.
+	CommonFields
+
+	// Title is a short description of the maintenance
+	Title string `json:"title"`
+
+	// Asset the maintenance was performed on
+	Asset *Asset `json:"asset,omitempty"`
+
+	// Supplier who performed the maintenance
+	Supplier *Supplier `json:"supplier,omitempty"`
+
+	// AssetID is the ID of the asset, used when creating or updating
+	AssetID int `json:"asset_id,omitempty"`
+
+	// SupplierID is the ID of the supplier, used when creating or updating
+	SupplierID int `json:"supplier_id,omitempty"`
+
+	// MaintenanceType is the kind of maintenance
+	// (e.g., "Maintenance", "Repair", "Upgrade", "PAT Test", "Calibration")
+	MaintenanceType string `json:"asset_maintenance_type"`
+
+	// Cost of the maintenance
+	Cost *Money `json:"cost,omitempty"`
+
+	// StartDate is when the maintenance started
+	StartDate *SnipeTime `json:"start_date,omitempty"`
+
+	// CompletionDate is when the maintenance was completed, if it has been
+	CompletionDate *SnipeTime `json:"completion_date,omitempty"`
+
+	// MaintenanceTime is the duration of the maintenance in days
+	MaintenanceTime int `json:"asset_maintenance_time,omitempty"`
+
+	// IsWarranty indicates if the maintenance was covered by warranty
+	IsWarranty Bool `json:"is_warranty"`
+}
+
+// Kit represents a Snipe-IT predefined kit.
+// Kits bundle models, licenses, accessories and consumables so that
+// everything a new hire needs can be checked out to them in one step.
+type Kit struct {
+	// CommonFields contains standard fields like ID, Name, etc.
+	CommonFields
+}
+
+// KitItem represents a model, license, accessory or consumable within a kit.
+type KitItem struct {
+	// ID is the unique identifier of the model, license, accessory or consumable
+	ID int `json:"id"`
+
+	// Name of the model, license, accessory or consumable
+	Name string `json:"name"`
+
+	// Quantity is how many of the item the kit contains
+	Quantity int `json:"quantity"`
+
+	// PivotID is the unique identifier of the item's membership in the kit
+	PivotID int `json:"pivot_id,omitempty"`
+}
+
+// ActivityEvent represents an entry in the Snipe-IT activity log.
+// Every checkout, checkin, update, audit and similar action is recorded
+// as an event describing what was acted on, by whom, and for whom.
+type ActivityEvent struct {
+	// ID is the unique identifier for the event
+	ID int `json:"id"`
+
+	// Icon is the name of the icon Snipe-IT displays for the item type
+	Icon string `json:"icon,omitempty"`
+
+	// ActionType is the kind of action (e.g., "checkout", "checkin from", "update", "audit")
+	ActionType string `json:"action_type"`
+
+	// Item is the object that was acted on
+	Item *ActivityObject `json:"item,omitempty"`
+
+	// Target is the object the item was checked out to or in from, if any
+	Target *ActivityObject `json:"target,omitempty"`
+
+	// Location is where the action took place, if recorded
+	Location *Location `json:"location,omitempty"`
+
+	// Admin is the user who performed the action
+	Admin *User `json:"admin,omitempty"`
+
+	// Note entered with the action
+	Note string `json:"note,omitempty"`
+
+	// LogMeta contains the changed fields for update actions, keyed by field name
+	LogMeta map[string]ActivityChange `json:"log_meta,omitempty"`
+
+	// CreatedAt is when the event was recorded
+	CreatedAt *SnipeTime `json:"created_at"`
+
+	// ActionDate is when the action took place
+	ActionDate *SnipeTime `json:"action_date,omitempty"`
+}
+
+// ActivityObject identifies the item or target of an ActivityEvent.
+type ActivityObject struct {
+	// ID is the unique identifier of the object
+	ID int `json:"id"`
+
+	// Name of the object
+	Name string `json:"name"`
+
+	// Type of the object (e.g., "asset", "user", "license", "location")
+	Type string `json:"type"`
+}
+
+// ActivityChange records the old and new value of a field changed by an update.
+type ActivityChange struct {
+	// Old is the value before the change
+	Old interface{} `json:"old"`
+
+	// New is the value after the change
+	New interface{} `json:"new"`
+}
+
+// Backup represents a Snipe-IT backup archive stored on the server.
+type Backup struct {
+	// Filename is the name of the backup archive
+	Filename string `json:"filename"`
+
+	// Filesize is the human-readable size of the archive (e.g., "12.4 MB")
+	Filesize string `json:"filesize"`
+
+	// ModifiedDisplay is the human-readable modification time of the archive
+	ModifiedDisplay string `json:"modified_display,omitempty"`
+
+	// ModifiedValue is the modification time of the archive as a Unix timestamp
+	ModifiedValue int64 `json:"modified_value,omitempty"`
+}
+
+// LoginAttempt represents a recorded attempt to log in to Snipe-IT.
+type LoginAttempt struct {
+	// ID is the unique identifier for the login attempt
+	ID int `json:"id"`
+
+	// Username that was used in the attempt
+	Username string `json:"username"`
+
+	// RemoteIP is the IP address the attempt came from
+	RemoteIP string `json:"remote_ip"`
+
+	// UserAgent is the browser user agent of the attempt
+	UserAgent string `json:"user_agent"`
+
+	// Successful indicates if the attempt succeeded
+	Successful Bool `json:"successful"`
+
+	// CreatedAt is when the attempt was made
+	CreatedAt *SnipeTime `json:"created_at"`
+}
+
+// File represents a file uploaded to a Snipe-IT item, such as a purchase
+// agreement attached to a license.
+type File struct {
+	// ID is the unique identifier for the file
+	ID int `json:"id"`
+
+	// Filename is the name of the uploaded file
+	Filename string `json:"filename"`
+
+	// URL is where the file can be downloaded from
+	URL string `json:"url,omitempty"`
+
+	// Note entered with the upload
+	Note string `json:"note,omitempty"`
+
+	// CreatedAt is when the file was uploaded
+	CreatedAt *SnipeTime `json:"created_at"`
+}
@@ -0,0 +1,163 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSnipeTimeUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected time.Time
+	}{
+		{"Datetime", `"2023-01-01 12:00:00"`, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{"RFC 3339 with microseconds", `"2023-01-01T12:00:00.123456Z"`, time.Date(2023, 1, 1, 12, 0, 0, 123456000, time.UTC)},
+		{"RFC 3339 with offset", `"2023-01-01T12:00:00+02:00"`, time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)},
+		{"ISO 8601 without zone", `"2023-01-01T12:00:00.000000"`, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{"Date", `"2023-01-01"`, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"Datetime object", `{"datetime": "2023-01-01 12:00:00", "formatted": "Sun Jan 01, 2023 12:00PM"}`, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{"Date object", `{"date": "2023-01-01", "formatted": "Sun Jan 01, 2023"}`, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"PHP DateTime", `{"date": "2023-01-01 12:00:00.000000", "timezone_type": 3, "timezone": "UTC"}`, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{"PHP DateTime with zone", `{"date": "2023-01-01 12:00:00.000000", "timezone_type": 3, "timezone": "Europe/Paris"}`, time.Date(2023, 1, 1, 11, 0, 0, 0, time.UTC)},
+		{"Null", `null`, time.Time{}},
+		{"Empty string", `""`, time.Time{}},
+		{"Empty object", `{"datetime": null, "formatted": null}`, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := SnipeTime{time.Now()}
+			if err := json.Unmarshal([]byte(tt.data), &st); err != nil {
+				t.Fatalf("json.Unmarshal returned error: %v", err)
+			}
+
+			if !st.Time.Equal(tt.expected) {
+				t.Errorf("json.Unmarshal(%s) = %v, expected %v", tt.data, st.Time, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSnipeTimeUnmarshalJSONInvalid(t *testing.T) {
+	for _, data := range []string{`"yesterday"`, `{"datetime": "01/02/2023"}`, `{"date": "2023-01-01", "timezone": "Mars/Olympus"}`, `42`} {
+		var st SnipeTime
+		if err := json.Unmarshal([]byte(data), &st); err == nil {
+			t.Errorf("json.Unmarshal(%s) returned nil error, expected an error", data)
+		}
+	}
+}
+
+func TestSnipeTimeRoundTrip(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected string
+	}{
+		{`"2023-01-01 12:00:00"`, `"2023-01-01 12:00:00"`},
+		{`"2023-01-01"`, `"2023-01-01"`},
+		{`{"date": "2023-01-01", "formatted": "Sun Jan 01, 2023"}`, `"2023-01-01"`},
+		{`"2023-01-01T12:00:00.000000Z"`, `"2023-01-01 12:00:00"`},
+		{`null`, `null`},
+	}
+
+	for _, tt := range tests {
+		var st SnipeTime
+		if err := json.Unmarshal([]byte(tt.data), &st); err != nil {
+			t.Fatalf("json.Unmarshal(%s) returned error: %v", tt.data, err)
+		}
+
+		data, err := json.Marshal(st)
+		if err != nil {
+			t.Fatalf("json.Marshal returned error: %v", err)
+		}
+		if string(data) != tt.expected {
+			t.Errorf("json.Marshal after json.Unmarshal(%s) = %s, expected %s", tt.data, data, tt.expected)
+		}
+	}
+}
+
+func TestUserUnmarshalJSON(t *testing.T) {
+	data := `{
+		"id": 7,
+		"username": "jdoe",
+		"manager": {"id": 3, "name": "Ann Boss", "first_name": "Ann", "last_name": "Boss"},
+		"department": {"id": 4, "name": "IT"},
+		"company": {"id": 2, "name": "Acme"},
+		"location": {"id": 5, "name": "HQ"},
+		"groups": {"total": 2, "rows": [{"id": 1, "name": "Admins"}, {"id": 6, "name": "Techs"}]},
+		"permissions": {"superuser": "0", "assets.view": 1, "admin": "-1"},
+		"remote": true,
+		"vip": true,
+		"start_date": {"date": "2021-06-01", "formatted": "Tue Jun 01, 2021"},
+		"end_date": null,
+		"last_login": {"datetime": "2023-05-04 08:15:00", "formatted": "Thu May 04, 2023 8:15AM"}
+	}`
+
+	var user User
+	if err := json.Unmarshal([]byte(data), &user); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	if user.Manager == nil || user.Manager.ID != 3 || user.Department == nil || user.Department.Name != "IT" ||
+		user.Company == nil || user.Company.ID != 2 || user.Location == nil || user.Location.ID != 5 {
+		t.Errorf("User relations = %+v, %+v, %+v, %+v", user.Manager, user.Department, user.Company, user.Location)
+	}
+	if user.Groups == nil || user.Groups.Total != 2 || user.Groups.Rows[1].Name != "Techs" {
+		t.Errorf("User.Groups = %+v, expected Admins and Techs", user.Groups)
+	}
+	if expected := (Permissions{"superuser": "0", "assets.view": "1", "admin": "-1"}); !reflect.DeepEqual(user.Permissions, expected) {
+		t.Errorf("User.Permissions = %v, expected %v", user.Permissions, expected)
+	}
+	if !user.Remote || !user.VIP || user.EndDate != nil {
+		t.Errorf("User.Remote = %v, VIP = %v, EndDate = %v", user.Remote, user.VIP, user.EndDate)
+	}
+	if user.StartDate == nil || user.StartDate.Format(snipeDateLayout) != "2021-06-01" || user.LastLogin == nil {
+		t.Errorf("User.StartDate = %v, LastLogin = %v", user.StartDate, user.LastLogin)
+	}
+
+	// Groups are written as IDs
+	out, err := json.Marshal(User{Username: "jdoe", Groups: user.Groups, ManagerID: 3})
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	var body map[string]interface{}
+	json.Unmarshal(out, &body)
+	if !reflect.DeepEqual(body["groups"], []interface{}{1.0, 6.0}) || body["manager_id"] != 3.0 {
+		t.Errorf("json.Marshal = %s, expected group IDs and manager_id", out)
+	}
+
+	// and read back
+	var decoded User
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal of the marshaled user returned error: %v", err)
+	}
+	if decoded.Groups == nil || decoded.Groups.Total != 2 || decoded.Groups.Rows[1].ID != 6 {
+		t.Errorf("User.Groups = %+v, expected groups 1 and 6", decoded.Groups)
+	}
+}
+
+func TestPermissionsUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected Permissions
+	}{
+		{`{"admin": "1"}`, Permissions{"admin": "1"}},
+		{`"{\"admin\":\"1\",\"reports.view\":0}"`, Permissions{"admin": "1", "reports.view": "0"}},
+		{`[]`, nil},
+		{`""`, nil},
+		{`null`, nil},
+	}
+
+	for _, tt := range tests {
+		var p Permissions
+		if err := json.Unmarshal([]byte(tt.data), &p); err != nil {
+			t.Errorf("json.Unmarshal(%s) returned error: %v", tt.data, err)
+			continue
+		}
+		if !reflect.DeepEqual(p, tt.expected) {
+			t.Errorf("json.Unmarshal(%s) = %v, expected %v", tt.data, p, tt.expected)
+		}
+	}
+}
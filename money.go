@@ -0,0 +1,217 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Money is a monetary amount, such as a purchase cost, held as an exact
+// number of cents so that totals can be computed without rounding errors.
+//
+// Snipe-IT formats amounts according to the locale of the instance, so
+// Money decodes numbers as well as strings such as "1234.56", "1,234.56",
+// "1.234,56" and "1 234,56", optionally with a currency code or symbol
+// ("$1,234.56", "1.234,56 €"). Amounts with more than two decimals are
+// rounded to the nearest cent. It is encoded as a plain JSON number, which
+// the API accepts whatever its locale. Use a *Money field to tell a null
+// amount apart from zero.
+type Money struct {
+	// Cents is the amount in hundredths of the currency unit
+	Cents int64
+
+	// Currency is the currency code or symbol that accompanied the amount,
+	// if any. Snipe-IT usually omits it; the instance's default currency
+	// is reported by Settings.
+	Currency string
+}
+
+// ParseMoney parses an amount in any of the formats described by Money.
+func ParseMoney(value string) (Money, error) {
+	var m Money
+
+	// Separate the currency from the number
+	number := strings.TrimFunc(value, func(r rune) bool {
+		return !unicode.IsDigit(r) && r != '-' && r != '.' && r != ','
+	})
+	start := strings.Index(value, number)
+	m.Currency = strings.TrimSpace(value[:start] + value[start+len(number):])
+	if number == "" {
+		return Money{}, fmt.Errorf("snipeit: cannot parse amount %q", value)
+	}
+
+	negative := strings.HasPrefix(number, "-")
+	number = strings.TrimPrefix(number, "-")
+
+	// Spaces and apostrophes only ever group thousands
+	number = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '\'' || r == '’' {
+			return -1
+		}
+		return r
+	}, number)
+
+	decimal := decimalSeparator(number)
+	var whole, frac string
+	for _, r := range number {
+		switch {
+		case r == decimal:
+			if frac != "" {
+				return Money{}, fmt.Errorf("snipeit: cannot parse amount %q", value)
+			}
+			if whole == "" {
+				whole = "0"
+			}
+			frac = "."
+		case r == '.' || r == ',':
+			if frac != "" {
+				return Money{}, fmt.Errorf("snipeit: cannot parse amount %q", value)
+			}
+		case r >= '0' && r <= '9' && frac != "":
+			frac += string(r)
+		case r >= '0' && r <= '9':
+			whole += string(r)
+		default:
+			return Money{}, fmt.Errorf("snipeit: cannot parse amount %q", value)
+		}
+	}
+	frac = strings.TrimPrefix(frac, ".")
+
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units > math.MaxInt64/100-1 {
+		return Money{}, fmt.Errorf("snipeit: cannot parse amount %q", value)
+	}
+
+	// Round to the nearest cent
+	cents := int64(0)
+	for i := 0; i < 2; i++ {
+		cents *= 10
+		if i < len(frac) {
+			cents += int64(frac[i] - '0')
+		}
+	}
+	if len(frac) > 2 && frac[2] >= '5' {
+		cents++
+	}
+
+	m.Cents = units*100 + cents
+	if negative {
+		m.Cents = -m.Cents
+	}
+	return m, nil
+}
+
+// decimalSeparator returns the decimal separator of number, or 0 if it has
+// no decimals. When both '.' and ',' appear, the last one is the decimal
+// separator. A separator that appears once is taken as decimal unless it
+// is followed by exactly three digits and preceded by a nonzero number, in
+// which case it groups thousands.
+func decimalSeparator(number string) rune {
+	lastDot := strings.LastIndex(number, ".")
+	lastComma := strings.LastIndex(number, ",")
+
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		if lastDot > lastComma {
+			return '.'
+		}
+		return ','
+	case lastDot >= 0:
+		if isDecimalSeparator(number, '.', lastDot) {
+			return '.'
+		}
+	case lastComma >= 0:
+		if isDecimalSeparator(number, ',', lastComma) {
+			return ','
+		}
+	}
+	return 0
+}
+
+// isDecimalSeparator reports whether sep, the only kind of separator in
+// number, last found at index i, separates decimals.
+func isDecimalSeparator(number string, sep byte, i int) bool {
+	if strings.Count(number, string(sep)) > 1 {
+		return false
+	}
+	whole := strings.TrimLeft(number[:i], "0")
+	return len(number)-i-1 != 3 || whole == ""
+}
+
+// String returns the amount as a plain decimal number with two decimals,
+// such as "1234.56", without the currency.
+func (m Money) String() string {
+	cents := m.Cents
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// Float64 returns the amount in currency units. Use Cents for exact
+// arithmetic.
+func (m Money) Float64() float64 {
+	return float64(m.Cents) / 100
+}
+
+// Add returns the sum of m and other, in the currency of m.
+func (m Money) Add(other Money) Money {
+	m.Cents += other.Cents
+	return m
+}
+
+// Sub returns the difference of m and other, in the currency of m.
+func (m Money) Sub(other Money) Money {
+	m.Cents -= other.Cents
+	return m
+}
+
+// Mul returns m multiplied by n, such as the cost of n seats.
+func (m Money) Mul(n int64) Money {
+	m.Cents *= n
+	return m
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Money. It accepts numbers,
+// strings in any of the formats described by Money, and null or an empty
+// string, which decode as zero.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*m = Money{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		var number float64
+		if err := json.Unmarshal(data, &number); err != nil {
+			return fmt.Errorf("snipeit: cannot decode amount %s", data)
+		}
+		*m = Money{Cents: int64(math.Round(number * 100))}
+		return nil
+	}
+
+	if strings.TrimSpace(str) == "" {
+		*m = Money{}
+		return nil
+	}
+
+	parsed, err := ParseMoney(str)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for Money, encoding the amount as
+// a JSON number.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
@@ -0,0 +1,97 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		value    string
+		cents    int64
+		currency string
+	}{
+		{"1234.56", 123456, ""},
+		{"1,234.56", 123456, ""},
+		{"1.234,56", 123456, ""},
+		{"1 234,56", 123456, ""},
+		{"1'234.56", 123456, ""},
+		{"1,234,567", 123456700, ""},
+		{"1.234", 123400, ""},
+		{"12,5", 1250, ""},
+		{"0.125", 13, ""},
+		{".99", 99, ""},
+		{"-42.10", -4210, ""},
+		{"$1,234.56", 123456, "$"},
+		{"1.234,56 €", 123456, "€"},
+		{"USD 99.00", 9900, "USD"},
+	}
+
+	for _, tt := range tests {
+		m, err := ParseMoney(tt.value)
+		if err != nil {
+			t.Errorf("ParseMoney(%q) returned error: %v", tt.value, err)
+			continue
+		}
+		if m.Cents != tt.cents || m.Currency != tt.currency {
+			t.Errorf("ParseMoney(%q) = %+v, expected %d cents in %q", tt.value, m, tt.cents, tt.currency)
+		}
+	}
+}
+
+func TestParseMoneyInvalid(t *testing.T) {
+	for _, value := range []string{"", "free", "1.2.3,4,5", "12a34", "-"} {
+		if m, err := ParseMoney(value); err == nil {
+			t.Errorf("ParseMoney(%q) = %+v, expected an error", value, m)
+		}
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	var v struct {
+		Number *Money `json:"number"`
+		String *Money `json:"string"`
+		Empty  *Money `json:"empty"`
+		Null   *Money `json:"null"`
+	}
+	data := `{"number": 1234.5, "string": "1.234,56", "empty": "", "null": null}`
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	if v.Number == nil || v.Number.Cents != 123450 {
+		t.Errorf("number decoded as %v, expected 1234.50", v.Number)
+	}
+	if v.String == nil || v.String.Cents != 123456 {
+		t.Errorf("string decoded as %v, expected 1234.56", v.String)
+	}
+	if v.Empty == nil || v.Empty.Cents != 0 {
+		t.Errorf("empty string decoded as %v, expected 0.00", v.Empty)
+	}
+	if v.Null != nil {
+		t.Errorf("null decoded as %v, expected nil", v.Null)
+	}
+
+	out, err := json.Marshal(v.String)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	if string(out) != "1234.56" {
+		t.Errorf("json.Marshal = %s, expected %s", out, "1234.56")
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	seat := Money{Cents: 1999, Currency: "USD"}
+
+	total := seat.Mul(3).Add(Money{Cents: 1}).Sub(Money{Cents: 500})
+	if total.Cents != 5498 || total.Currency != "USD" {
+		t.Errorf("arithmetic returned %+v, expected 5498 cents in USD", total)
+	}
+	if total.String() != "54.98" || total.Float64() != 54.98 {
+		t.Errorf("String() = %s, Float64() = %v, expected 54.98", total.String(), total.Float64())
+	}
+	if s := (Money{Cents: -5}).String(); s != "-0.05" {
+		t.Errorf("String() = %s, expected -0.05", s)
+	}
+}
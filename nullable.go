@@ -0,0 +1,90 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Nullable is an optional value that distinguishes three states, so that
+// partial updates can leave a field unchanged, set it, or clear it:
+//
+//   - unset (the zero Nullable), which is omitted from requests by fields
+//     tagged omitzero and is the result of decoding a missing field
+//   - null, created by Null, which is encoded as JSON null to clear the field
+//   - set, created by NewNullable, which is encoded as its value
+//
+// Decoding a JSON null gives a null Nullable, so values round-trip.
+type Nullable[T any] struct {
+	value T
+	set   bool
+	valid bool
+}
+
+// NewNullable returns a Nullable holding v.
+func NewNullable[T any](v T) Nullable[T] {
+	return Nullable[T]{value: v, set: true, valid: true}
+}
+
+// Null returns a Nullable that is explicitly null.
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{set: true}
+}
+
+// Get returns the value and whether there is one. It returns the zero
+// value and false if n is unset or null.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.value, n.valid
+}
+
+// IsSet reports whether n was given a value or explicitly set to null.
+func (n Nullable[T]) IsSet() bool {
+	return n.set
+}
+
+// IsNull reports whether n was explicitly set to null.
+func (n Nullable[T]) IsNull() bool {
+	return n.set && !n.valid
+}
+
+// IsZero reports whether n is unset. It lets fields tagged omitzero be
+// omitted from requests.
+func (n Nullable[T]) IsZero() bool {
+	return !n.set
+}
+
+// String returns the value formatted with %v, "null", or "unset".
+func (n Nullable[T]) String() string {
+	switch {
+	case !n.set:
+		return "unset"
+	case !n.valid:
+		return "null"
+	}
+	return fmt.Sprint(n.value)
+}
+
+// MarshalJSON implements json.Marshaler for Nullable. Unset and null
+// values are both encoded as null; tag fields omitzero to omit unset ones.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Nullable.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		*n = Null[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = NewNullable(v)
+	return nil
+}
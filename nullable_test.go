@@ -0,0 +1,83 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullableMarshalJSON(t *testing.T) {
+	type update struct {
+		Notes    Nullable[string] `json:"notes,omitzero"`
+		Location Nullable[int]    `json:"location_id,omitzero"`
+		Warranty Nullable[int]    `json:"warranty_months,omitzero"`
+	}
+
+	data, err := json.Marshal(update{
+		Notes:    NewNullable(""),
+		Location: Null[int](),
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	if expected := `{"notes":"","location_id":null}`; string(data) != expected {
+		t.Errorf("json.Marshal = %s, expected %s", data, expected)
+	}
+}
+
+func TestNullableUnmarshalJSON(t *testing.T) {
+	var v struct {
+		Set     Nullable[int] `json:"set"`
+		Null    Nullable[int] `json:"null"`
+		Missing Nullable[int] `json:"missing"`
+	}
+	if err := json.Unmarshal([]byte(`{"set": 0, "null": null}`), &v); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	if value, ok := v.Set.Get(); !ok || value != 0 || v.Set.IsNull() {
+		t.Errorf("set field = %v, expected 0", v.Set)
+	}
+	if !v.Null.IsSet() || !v.Null.IsNull() {
+		t.Errorf("null field = %v, expected null", v.Null)
+	}
+	if v.Missing.IsSet() {
+		t.Errorf("missing field = %v, expected unset", v.Missing)
+	}
+
+	// Values round-trip
+	data, err := json.Marshal(v.Null)
+	if err != nil || string(data) != "null" {
+		t.Errorf("json.Marshal(null) = %s, %v, expected null", data, err)
+	}
+
+	var bad Nullable[int]
+	if err := json.Unmarshal([]byte(`"x"`), &bad); err == nil {
+		t.Error("json.Unmarshal of a string into Nullable[int] returned nil error")
+	}
+}
+
+func TestAssetOptionalRelations(t *testing.T) {
+	var asset Asset
+	data := `{"id": 1, "supplier": {"id": 2, "name": "CDW"}, "location": null, "purchase_date": null}`
+	if err := json.Unmarshal([]byte(data), &asset); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	if asset.Supplier == nil || asset.Supplier.ID != 2 {
+		t.Errorf("Asset.Supplier = %+v, expected ID 2", asset.Supplier)
+	}
+	if asset.Location != nil || asset.PurchaseDate != nil {
+		t.Errorf("Asset.Location = %+v, PurchaseDate = %v, expected nil", asset.Location, asset.PurchaseDate)
+	}
+
+	out, err := json.Marshal(asset)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	var body map[string]interface{}
+	json.Unmarshal(out, &body)
+	if _, ok := body["location"]; ok {
+		t.Errorf("json.Marshal = %s, expected no location", out)
+	}
+}
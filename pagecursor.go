@@ -0,0 +1,180 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ErrCursorMismatch is returned when a PageCursor is resumed with list
+// options other than those of the listing it was taken from.
+var ErrCursorMismatch = errors.New("snipeit: page cursor does not match the list options")
+
+// PageCursor records how far a paged listing has got, so that a long
+// listing, such as an export interrupted by a deploy, can be resumed later
+// where it stopped instead of from the first page.
+//
+// A PageCursor is serialized to JSON, or to an opaque token by String and
+// ParsePageCursor. It holds a hash of the search and filters of the listing,
+// so that it is only resumed with the same list options. The zero PageCursor
+// starts at the first page.
+//
+// Pages are fetched by offset: assets created or deleted while a listing is
+// interrupted shift the pages, so that some assets may be listed twice or
+// missed when it is resumed.
+type PageCursor struct {
+	// Page is the number of pages processed
+	Page int `json:"page"`
+
+	// Offset is the offset of the next page
+	Offset int `json:"offset"`
+
+	// Total is the number of items reported by the last page processed
+	Total int `json:"total,omitempty"`
+
+	// Sort is the field the listing is sorted by
+	Sort string `json:"sort,omitempty"`
+
+	// SortDir is the direction of the sort
+	SortDir string `json:"sort_dir,omitempty"`
+
+	// Filter is a hash of the search and filters of the listing
+	Filter string `json:"filter,omitempty"`
+
+	// Done reports whether the last page has been processed
+	Done bool `json:"done,omitempty"`
+}
+
+// String returns the cursor as an opaque token, safe to use in URLs and
+// file names, which ParsePageCursor turns back into the cursor.
+func (c PageCursor) String() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParsePageCursor returns the cursor of a token returned by
+// PageCursor.String.
+func ParsePageCursor(token string) (PageCursor, error) {
+	var c PageCursor
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		err = json.Unmarshal(data, &c)
+	}
+	if err != nil {
+		return PageCursor{}, fmt.Errorf("snipeit: invalid page cursor %q: %w", token, err)
+	}
+	return c, nil
+}
+
+// ListPagesFrom calls fn with each page of assets from cursor onwards,
+// following pagination until every asset has been visited.
+//
+// opts can be used to customize the pages with search and sorting, and
+// must be the options the cursor was taken with; otherwise
+// ErrCursorMismatch is returned. opts.Offset is replaced by that of the
+// cursor.
+//
+// fn is called with each page and the cursor following it, to be saved
+// once the page has been processed, so that a listing resumed from it
+// continues with the next page. A cursor that is Done lists nothing.
+//
+// Paging stops at the first error returned by fn, and that error is returned.
+func (s *AssetsService) ListPagesFrom(cursor PageCursor, opts *ListOptions, fn func(page *AssetsResponse, next PageCursor) error) error {
+	return s.ListPagesFromContext(context.Background(), cursor, opts, fn)
+}
+
+// ListPagesFromContext calls fn with each page of assets from cursor
+// onwards with the provided context, following pagination until every
+// asset has been visited.
+//
+// ctx is the context for the requests.
+// opts must be the options the cursor was taken with; otherwise
+// ErrCursorMismatch is returned.
+// fn is called with each page and the cursor following it.
+//
+// Paging stops at the first error returned by fn, and that error is returned.
+func (s *AssetsService) ListPagesFromContext(ctx context.Context, cursor PageCursor, opts *ListOptions, fn func(page *AssetsResponse, next PageCursor) error) error {
+	return resumePages(ctx, cursor, opts, func(ctx context.Context, pageOpts *ListOptions) (*AssetsResponse, int, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return page, len(page.Rows), page.Total, nil
+	}, fn)
+}
+
+// countedPage is a page with the number of rows it held and the total
+// reported by the API.
+type countedPage[R any] struct {
+	page  R
+	rows  int
+	total int
+}
+
+// resumePages walks the pages of a list endpoint with paginate, starting at
+// cursor, and calls fn with each page and the cursor following it.
+func resumePages[R any, O any, PO interface {
+	*O
+	pageable
+}](ctx context.Context, cursor PageCursor, opts *O, fetch func(ctx context.Context, pageOpts *O) (R, int, int, error), fn func(page R, next PageCursor) error) error {
+	var pageOpts O
+	if opts != nil {
+		pageOpts = *opts
+	}
+	lo := PO(&pageOpts).listOptions()
+
+	filter, err := filterHash[O, PO](pageOpts)
+	if err != nil {
+		return err
+	}
+	if cursor != (PageCursor{}) && (cursor.Filter != filter || cursor.Sort != lo.Sort || cursor.SortDir != lo.SortDir) {
+		return ErrCursorMismatch
+	}
+	if cursor.Done {
+		return nil
+	}
+	cursor.Filter, cursor.Sort, cursor.SortDir = filter, lo.Sort, lo.SortDir
+	lo.Offset = cursor.Offset
+
+	counted := func(ctx context.Context, pageOpts *O) (countedPage[R], int, int, error) {
+		page, rows, total, err := fetch(ctx, pageOpts)
+		return countedPage[R]{page: page, rows: rows, total: total}, rows, total, err
+	}
+	return paginate[countedPage[R], O, PO](ctx, &pageOpts, counted, func(page countedPage[R]) error {
+		next := cursor
+		next.Page++
+		next.Offset += page.rows
+		next.Total = page.total
+		next.Done = page.rows == 0 || next.Offset >= page.total
+		if err := fn(page.page, next); err != nil {
+			return err
+		}
+		cursor = next
+		return nil
+	})
+}
+
+// filterHash returns a hash of the query parameters of opts other than
+// pagination and sorting.
+func filterHash[O any, PO interface {
+	*O
+	pageable
+}](opts O) (string, error) {
+	lo := PO(&opts).listOptions()
+	lo.Page, lo.Offset, lo.Limit, lo.Sort, lo.SortDir = 0, 0, 0, "", ""
+
+	values, err := query.Values(&opts)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(values.Encode()))
+	return hex.EncodeToString(sum[:8]), nil
+}
@@ -0,0 +1,148 @@
+package snipeit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// servePagedAssets serves n assets with IDs 1 to n as a paginated list,
+// and records the offsets requested.
+func servePagedAssets(t *testing.T, mux *http.ServeMux, n int, offsets *[]int) {
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		query := r.URL.Query()
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		*offsets = append(*offsets, offset)
+
+		fmt.Fprintf(w, `{"total": %d, "rows": [`, n)
+		for id := offset + 1; id <= min(offset+limit, n); id++ {
+			if id > offset+1 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"id": %d}`, id)
+		}
+		fmt.Fprint(w, `]}`)
+	})
+}
+
+func TestAssetsListPagesFrom(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.disableRetries = true
+
+	var offsets []int
+	servePagedAssets(t, mux, 5, &offsets)
+
+	opts := &ListOptions{Search: "dell", Sort: "id", SortDir: "asc", Limit: 2}
+	interrupted := errors.New("interrupted")
+
+	// Process the first page, then stop while processing the second
+	var saved PageCursor
+	var ids []int
+	err := client.Assets.ListPagesFrom(PageCursor{}, opts, func(page *AssetsResponse, next PageCursor) error {
+		if saved.Page == 1 {
+			return interrupted
+		}
+		for _, asset := range page.Rows {
+			ids = append(ids, asset.ID)
+		}
+		saved = next
+		return nil
+	})
+	if !errors.Is(err, interrupted) {
+		t.Fatalf("Assets.ListPagesFrom returned error %v, expected %v", err, interrupted)
+	}
+	if saved.Page != 1 || saved.Offset != 2 || saved.Total != 5 || saved.Done || saved.Sort != "id" || saved.SortDir != "asc" || saved.Filter == "" {
+		t.Errorf("Assets.ListPagesFrom returned cursor %+v, expected page 1 at offset 2 of 5", saved)
+	}
+
+	// Resume from the serialized cursor
+	cursor, err := ParsePageCursor(saved.String())
+	if err != nil {
+		t.Fatalf("ParsePageCursor returned error: %v", err)
+	}
+	if cursor != saved {
+		t.Errorf("ParsePageCursor returned %+v, expected %+v", cursor, saved)
+	}
+
+	offsets = nil
+	err = client.Assets.ListPagesFrom(cursor, opts, func(page *AssetsResponse, next PageCursor) error {
+		for _, asset := range page.Rows {
+			ids = append(ids, asset.ID)
+		}
+		saved = next
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Assets.ListPagesFrom returned error: %v", err)
+	}
+	if fmt.Sprint(ids) != "[1 2 3 4 5]" || fmt.Sprint(offsets) != "[2 4]" {
+		t.Errorf("Assets.ListPagesFrom listed %v at offsets %v, expected [1 2 3 4 5] at [2 4]", ids, offsets)
+	}
+	if saved.Page != 3 || saved.Offset != 5 || !saved.Done {
+		t.Errorf("Assets.ListPagesFrom returned cursor %+v, expected page 3 at offset 5, done", saved)
+	}
+
+	// A finished listing lists nothing more
+	offsets = nil
+	err = client.Assets.ListPagesFrom(saved, opts, func(page *AssetsResponse, next PageCursor) error {
+		t.Error("Assets.ListPagesFrom called fn for a done cursor")
+		return nil
+	})
+	if err != nil || len(offsets) != 0 {
+		t.Errorf("Assets.ListPagesFrom returned error %v after %d requests, expected none", err, len(offsets))
+	}
+}
+
+func TestAssetsListPagesFromMismatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var offsets []int
+	servePagedAssets(t, mux, 5, &offsets)
+
+	opts := &ListOptions{Search: "dell", Sort: "id", Limit: 2}
+	var cursor PageCursor
+	err := client.Assets.ListPagesFrom(PageCursor{}, opts, func(page *AssetsResponse, next PageCursor) error {
+		cursor = next
+		return errors.New("stop")
+	})
+	if err == nil {
+		t.Fatal("Assets.ListPagesFrom returned nil error, expected the one returned by fn")
+	}
+
+	// The page size and offset are not part of the listing
+	resumed := *opts
+	resumed.Limit, resumed.Offset = 3, 1
+	err = client.Assets.ListPagesFrom(cursor, &resumed, func(page *AssetsResponse, next PageCursor) error {
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Assets.ListPagesFrom with another page size returned error: %v", err)
+	}
+
+	for _, other := range []ListOptions{
+		{Search: "hp", Sort: "id", Limit: 2},
+		{Search: "dell", Sort: "name", Limit: 2},
+		{Search: "dell", Sort: "id", SortDir: "desc", Limit: 2},
+	} {
+		err := client.Assets.ListPagesFrom(cursor, &other, func(page *AssetsResponse, next PageCursor) error {
+			return nil
+		})
+		if !errors.Is(err, ErrCursorMismatch) {
+			t.Errorf("Assets.ListPagesFrom with %+v returned error %v, expected ErrCursorMismatch", other, err)
+		}
+	}
+}
+
+func TestParsePageCursorInvalid(t *testing.T) {
+	for _, token := range []string{"not a cursor", "bm90IGpzb24"} {
+		if _, err := ParsePageCursor(token); err == nil {
+			t.Errorf("ParsePageCursor(%q) returned nil error, expected an error", token)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+)
+
+// defaultPageSize is the page size used by the pagination helpers when
+// the caller does not set a limit. It matches Snipe-IT's default maximum.
+const defaultPageSize = 500
+
+// ListPages calls fn with each page of assets, following pagination until
+// every asset has been visited.
+//
+// opts can be used to customize the pages with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// Paging stops at the first error returned by fn, and that error is returned.
+// Requests are subject to the client's rate limiter and retry policy.
+func (s *AssetsService) ListPages(opts *ListOptions, fn func(page *AssetsResponse) error) error {
+	return s.ListPagesContext(context.Background(), opts, fn)
+}
+
+// ListPagesContext calls fn with each page of assets with the provided context,
+// following pagination until every asset has been visited.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the pages with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// Paging stops at the first error returned by fn, and that error is returned.
+func (s *AssetsService) ListPagesContext(ctx context.Context, opts *ListOptions, fn func(page *AssetsResponse) error) error {
+	return paginate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) (*AssetsResponse, int, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return page, len(page.Rows), page.Total, nil
+	}, fn)
+}
+
+// ListAll returns every asset, following pagination.
+//
+// opts can be used to customize the result with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// ListAll holds every asset in memory; use ListPages to process large
+// inventories a page at a time.
+func (s *AssetsService) ListAll(opts *ListOptions) ([]Asset, error) {
+	return s.ListAllContext(context.Background(), opts)
+}
+
+// ListAllContext returns every asset with the provided context, following pagination.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the result with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+func (s *AssetsService) ListAllContext(ctx context.Context, opts *ListOptions) ([]Asset, error) {
+	var assets []Asset
+	err := s.ListPagesContext(ctx, opts, func(page *AssetsResponse) error {
+		assets = append(assets, page.Rows...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assets, nil
+}
+
+// errStopIteration is used internally to end paging when the consumer
+// of an iterator stops ranging.
+var errStopIteration = errors.New("snipeit: iteration stopped")
+
+// pageable is implemented by ListOptions and, through embedding, by every
+// service-specific list options type.
+type pageable interface {
+	listOptions() *ListOptions
+}
+
+// listOptions returns the pagination options themselves.
+func (o *ListOptions) listOptions() *ListOptions {
+	return o
+}
+
+// paginate walks the pages of a list endpoint using offset-based paging,
+// calling fn with each page in order.
+//
+// It works on a copy of opts: Page is cleared, since it would conflict with
+// Offset, and a missing Limit is set to defaultPageSize. fetch requests a
+// single page and reports how many rows it held and the total reported by
+// the API. Paging stops after the last page, on an empty page, or at the
+// first error returned by fetch or fn.
+//
+// When opts.Prefetch is greater than one, the pages following the first are
+// fetched concurrently by prefetch.
+func paginate[R any, O any, PO interface {
+	*O
+	pageable
+}](ctx context.Context, opts *O, fetch func(ctx context.Context, pageOpts *O) (page R, rows, total int, err error), fn func(page R) error) error {
+	var pageOpts O
+	if opts != nil {
+		pageOpts = *opts
+	}
+
+	lo := PO(&pageOpts).listOptions()
+	lo.Page = 0
+	if lo.Limit <= 0 {
+		lo.Limit = defaultPageSize
+	}
+
+	for {
+		page, rows, total, err := fetch(ctx, &pageOpts)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(page); err != nil {
+			return err
+		}
+
+		lo.Offset += rows
+		if rows == 0 || lo.Offset >= total {
+			return nil
+		}
+
+		if lo.Prefetch > 1 {
+			return prefetch[R, O, PO](ctx, pageOpts, total, fetch, fn)
+		}
+	}
+}
+
+// pageResult holds the outcome of fetching a single page.
+type pageResult[R any] struct {
+	page R
+	rows int
+	err  error
+}
+
+// prefetch fetches the remaining pages of a list, from pageOpts.Offset up to
+// total, with up to Prefetch requests in flight, and calls fn with each page
+// in order. Pages count against Prefetch until fn has consumed them, so a
+// slow consumer does not cause unbounded buffering.
+//
+// The page boundaries are computed from total, as reported by the first page.
+// Paging stops early on an empty page or at the first error.
+func prefetch[R any, O any, PO interface {
+	*O
+	pageable
+}](ctx context.Context, pageOpts O, total int, fetch func(ctx context.Context, pageOpts *O) (R, int, int, error), fn func(page R) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	lo := PO(&pageOpts).listOptions()
+	sem := make(chan struct{}, lo.Prefetch)
+	pending := make(chan chan pageResult[R], lo.Prefetch)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(pending)
+
+		for offset := lo.Offset; offset < total; offset += lo.Limit {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			result := make(chan pageResult[R], 1)
+			pending <- result
+
+			next := pageOpts
+			PO(&next).listOptions().Offset = offset
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				page, rows, _, err := fetch(ctx, &next)
+				result <- pageResult[R]{page: page, rows: rows, err: err}
+			}()
+		}
+	}()
+
+	for result := range pending {
+		r := <-result
+		<-sem
+		if r.err != nil {
+			return r.err
+		}
+
+		if err := fn(r.page); err != nil {
+			return err
+		}
+
+		if r.rows == 0 {
+			return nil
+		}
+	}
+
+	return ctx.Err()
+}
+
+// iterate returns an iterator over the rows of a list endpoint, fetching
+// pages lazily with paginate as the caller ranges. list requests a single
+// page and returns its rows and the total reported by the API.
+//
+// An error ends the iteration and is yielded once with the zero value of T.
+func iterate[T any, O any, PO interface {
+	*O
+	pageable
+}](ctx context.Context, opts *O, list func(ctx context.Context, pageOpts *O) ([]T, int, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		fetch := func(ctx context.Context, pageOpts *O) ([]T, int, int, error) {
+			rows, total, err := list(ctx, pageOpts)
+			return rows, len(rows), total, err
+		}
+
+		err := paginate[[]T, O, PO](ctx, opts, fetch, func(rows []T) error {
+			for _, row := range rows {
+				if !yield(row, nil) {
+					return errStopIteration
+				}
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errStopIteration) {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package snipeit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// handleAssetPages serves total assets with sequential IDs from
+// /api/v1/hardware, honoring the offset and limit parameters.
+func handleAssetPages(t *testing.T, mux *http.ServeMux, total int) *[]string {
+	var mu sync.Mutex
+	var offsets []string
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		var offset, limit int
+		fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+		fmt.Sscan(r.URL.Query().Get("limit"), &limit)
+		mu.Lock()
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+		mu.Unlock()
+
+		fmt.Fprintf(w, `{"total": %d, "rows": [`, total)
+		for id := offset + 1; id <= offset+limit && id <= total; id++ {
+			if id > offset+1 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"id": %d}`, id)
+		}
+		fmt.Fprint(w, `]}`)
+	})
+	return &offsets
+}
+
+func TestAssetsListAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	offsets := handleAssetPages(t, mux, 5)
+
+	assets, err := client.Assets.ListAll(&ListOptions{Limit: 2, Page: 3})
+	if err != nil {
+		t.Fatalf("Assets.ListAll returned error: %v", err)
+	}
+
+	if len(assets) != 5 {
+		t.Fatalf("Assets.ListAll returned %d assets, expected %d", len(assets), 5)
+	}
+	for i, asset := range assets {
+		if asset.ID != i+1 {
+			t.Errorf("assets[%d].ID = %d, expected %d", i, asset.ID, i+1)
+		}
+	}
+
+	expected := []string{"", "2", "4"}
+	if !reflect.DeepEqual(*offsets, expected) {
+		t.Errorf("Assets.ListAll requested offsets %q, expected %q", *offsets, expected)
+	}
+}
+
+func TestAssetsListPagesStopsOnError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	offsets := handleAssetPages(t, mux, 10)
+	errStop := errors.New("stop")
+
+	pages := 0
+	err := client.Assets.ListPages(&ListOptions{Limit: 3}, func(page *AssetsResponse) error {
+		pages++
+		if pages == 2 {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) {
+		t.Errorf("Assets.ListPages returned error %v, expected %v", err, errStop)
+	}
+
+	if len(*offsets) != 2 {
+		t.Errorf("Assets.ListPages made %d requests, expected %d", len(*offsets), 2)
+	}
+}
+
+func TestAssetsIterate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	offsets := handleAssetPages(t, mux, 7)
+
+	var ids []int
+	for asset, err := range client.Assets.Iterate(&ListOptions{Limit: 3}) {
+		if err != nil {
+			t.Fatalf("Assets.Iterate yielded error: %v", err)
+		}
+		ids = append(ids, asset.ID)
+		if asset.ID == 4 {
+			break
+		}
+	}
+
+	expected := []int{1, 2, 3, 4}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Assets.Iterate yielded IDs %v, expected %v", ids, expected)
+	}
+
+	if len(*offsets) != 2 {
+		t.Errorf("Assets.Iterate made %d requests, expected %d", len(*offsets), 2)
+	}
+}
+
+func TestAssetsIterateError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.disableRetries = true
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"status": "error", "messages": "Forbidden"}`)
+	})
+
+	var errs []error
+	for _, err := range client.Assets.Iterate(nil) {
+		errs = append(errs, err)
+	}
+
+	if len(errs) != 1 || errs[0] == nil {
+		t.Errorf("Assets.Iterate yielded %v, expected a single error", errs)
+	}
+}
+
+func TestCategoriesIterate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/categories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query().Get("category_type"); got != "asset" {
+			t.Errorf("category_type = %q, expected %q", got, "asset")
+		}
+
+		if r.URL.Query().Get("offset") == "" {
+			fmt.Fprint(w, `{"total": 3, "rows": [{"id": 1}, {"id": 2}]}`)
+		} else {
+			fmt.Fprint(w, `{"total": 3, "rows": [{"id": 3}]}`)
+		}
+	})
+
+	opts := &CategoryListOptions{ListOptions: ListOptions{Limit: 2}, CategoryType: "asset"}
+
+	var ids []int
+	for category, err := range client.Categories.Iterate(opts) {
+		if err != nil {
+			t.Fatalf("Categories.Iterate yielded error: %v", err)
+		}
+		ids = append(ids, category.ID)
+	}
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Categories.Iterate yielded IDs %v, expected %v", ids, expected)
+	}
+
+	if opts.Offset != 0 {
+		t.Errorf("Categories.Iterate modified opts.Offset to %d", opts.Offset)
+	}
+}
+
+func TestAssetsListAllPrefetch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		var offset int
+		fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+		fmt.Fprintf(w, `{"total": 10, "rows": [{"id": %d}, {"id": %d}]}`, offset+1, offset+2)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	})
+
+	assets, err := client.Assets.ListAll(&ListOptions{Limit: 2, Prefetch: 3})
+	if err != nil {
+		t.Fatalf("Assets.ListAll returned error: %v", err)
+	}
+
+	for i, asset := range assets {
+		if asset.ID != i+1 {
+			t.Fatalf("assets[%d].ID = %d, expected %d", i, asset.ID, i+1)
+		}
+	}
+	if len(assets) != 10 {
+		t.Errorf("Assets.ListAll returned %d assets, expected %d", len(assets), 10)
+	}
+
+	if maxInFlight < 2 || maxInFlight > 3 {
+		t.Errorf("Assets.ListAll had %d requests in flight, expected between 2 and 3", maxInFlight)
+	}
+}
+
+func TestAssetsIteratePrefetchStopsEarly(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	offsets := handleAssetPages(t, mux, 100)
+
+	count := 0
+	for _, err := range client.Assets.Iterate(&ListOptions{Limit: 5, Prefetch: 2}) {
+		if err != nil {
+			t.Fatalf("Assets.Iterate yielded error: %v", err)
+		}
+		count++
+		if count == 7 {
+			break
+		}
+	}
+
+	// The first page, the page being consumed and at most one prefetched page.
+	if len(*offsets) > 4 {
+		t.Errorf("Assets.Iterate made %d requests, expected at most %d", len(*offsets), 4)
+	}
+}
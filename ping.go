@@ -0,0 +1,185 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Errors returned by Ping and Version, wrapping the underlying error.
+var (
+	// ErrInvalidURL indicates that the base URL does not resolve or does not
+	// point to a Snipe-IT API (e.g., the endpoint is missing or returns HTML).
+	ErrInvalidURL = errors.New("snipeit: base URL does not point to a Snipe-IT API")
+
+	// ErrHostNotFound indicates that the host name of the base URL could not
+	// be resolved. Errors wrapping ErrHostNotFound also wrap ErrInvalidURL.
+	ErrHostNotFound = errors.New("snipeit: host not found")
+
+	// ErrTLS indicates that a secure connection could not be established,
+	// typically because the server's certificate is not trusted.
+	ErrTLS = errors.New("snipeit: TLS handshake failed")
+
+	// ErrInvalidToken indicates that the server rejected the API token.
+	ErrInvalidToken = errors.New("snipeit: API token was rejected")
+
+	// ErrServerUnavailable indicates that the server could not be reached
+	// or failed to handle the request.
+	ErrServerUnavailable = errors.New("snipeit: server is unavailable")
+)
+
+// PingResult describes the outcome of a successful Ping.
+type PingResult struct {
+	// Latency is the round-trip time of the probe request
+	Latency time.Duration
+
+	// UserID is the ID of the user that owns the API token
+	UserID int
+}
+
+// VersionInfo describes the version of a Snipe-IT instance.
+type VersionInfo struct {
+	// Version is the release version (e.g., "v7.0.13")
+	Version string `json:"version"`
+
+	// BuildVersion is the build number of the release
+	BuildVersion string `json:"build_version,omitempty"`
+
+	// HashVersion is the git commit the release was built from
+	HashVersion string `json:"hash_version,omitempty"`
+
+	// FullAppVersion combines the version, build and branch
+	// (e.g., "v7.0.13 - build 14502-g1f3d1c9")
+	FullAppVersion string `json:"full_app_version,omitempty"`
+}
+
+// Ping performs a cheap authenticated request to verify that the Snipe-IT
+// instance is reachable and that the API token is valid.
+//
+// Ping does not retry failed requests. On failure the returned error wraps
+// ErrInvalidURL, ErrHostNotFound, ErrTLS, ErrInvalidToken or ErrServerUnavailable,
+// so callers can use errors.Is to tell a misconfigured URL from a bad token
+// or an outage.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/users-me
+func (c *Client) Ping() (*PingResult, error) {
+	return c.PingContext(context.Background())
+}
+
+// PingContext performs a cheap authenticated request with the provided context.
+//
+// ctx is the context for the request. If ctx is canceled or its deadline
+// is exceeded, the context's error is returned unwrapped.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/users-me
+func (c *Client) PingContext(ctx context.Context) (*PingResult, error) {
+	req, err := c.newRequestWithContext(ctx, http.MethodGet, "api/v1/users/me", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var me struct {
+		ID int `json:"id"`
+	}
+	start := time.Now()
+	_, err = c.DoWithOptions(req, &me, &RequestOptions{Context: ctx, DisableRetries: true})
+	latency := time.Since(start)
+	if err != nil {
+		return nil, classifyPingError(err)
+	}
+
+	return &PingResult{Latency: latency, UserID: me.ID}, nil
+}
+
+// Version fetches the version of the Snipe-IT instance.
+//
+// Like Ping, Version is authenticated and does not retry failed requests,
+// and its errors wrap the same sentinel errors.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/version
+func (c *Client) Version() (*VersionInfo, error) {
+	return c.VersionContext(context.Background())
+}
+
+// VersionContext fetches the version of the Snipe-IT instance with the provided context.
+//
+// ctx is the context for the request. If ctx is canceled or its deadline
+// is exceeded, the context's error is returned unwrapped.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/version
+func (c *Client) VersionContext(ctx context.Context) (*VersionInfo, error) {
+	req, err := c.newRequestWithContext(ctx, http.MethodGet, "api/v1/version", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var version VersionInfo
+	_, err = c.DoWithOptions(req, &version, &RequestOptions{Context: ctx, DisableRetries: true})
+	if err != nil {
+		return nil, classifyPingError(err)
+	}
+	if version.Version == "" {
+		return nil, fmt.Errorf("%w: response did not include a version", ErrInvalidURL)
+	}
+
+	return &version, nil
+}
+
+// classifyPingError wraps err with the Ping error that best describes it.
+func classifyPingError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) {
+		switch code := errorResponse.Response.StatusCode; {
+		case code == http.StatusUnauthorized || code == http.StatusForbidden:
+			return fmt.Errorf("%w: %w", ErrInvalidToken, err)
+		case code >= 500:
+			return fmt.Errorf("%w: %w", ErrServerUnavailable, err)
+		default:
+			return fmt.Errorf("%w: %w", ErrInvalidURL, err)
+		}
+	}
+
+	var dnsError *net.DNSError
+	if errors.As(err, &dnsError) {
+		return fmt.Errorf("%w: %w: %w", ErrInvalidURL, ErrHostNotFound, err)
+	}
+
+	if isTLSError(err) {
+		return fmt.Errorf("%w: %w", ErrTLS, err)
+	}
+
+	var syntaxError *json.SyntaxError
+	var typeError *json.UnmarshalTypeError
+	if errors.As(err, &syntaxError) || errors.As(err, &typeError) {
+		return fmt.Errorf("%w: %w", ErrInvalidURL, err)
+	}
+
+	return fmt.Errorf("%w: %w", ErrServerUnavailable, err)
+}
+
+// isTLSError reports whether err was caused by a failed TLS handshake.
+func isTLSError(err error) bool {
+	var verificationError *tls.CertificateVerificationError
+	var recordHeaderError tls.RecordHeaderError
+	var alertError tls.AlertError
+	var unknownAuthorityError x509.UnknownAuthorityError
+	var hostnameError x509.HostnameError
+	var invalidError x509.CertificateInvalidError
+	return errors.As(err, &verificationError) ||
+		errors.As(err, &recordHeaderError) ||
+		errors.As(err, &alertError) ||
+		errors.As(err, &unknownAuthorityError) ||
+		errors.As(err, &hostnameError) ||
+		errors.As(err, &invalidError)
+}
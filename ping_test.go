@@ -0,0 +1,148 @@
+package snipeit
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPing(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/users/me", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "Authorization", "Bearer test-token")
+		fmt.Fprint(w, `{"id": 7, "username": "api"}`)
+	})
+
+	result, err := client.Ping()
+	if err != nil {
+		t.Fatalf("Ping returned error: %v", err)
+	}
+
+	if result.UserID != 7 {
+		t.Errorf("Ping returned UserID = %d, expected %d", result.UserID, 7)
+	}
+
+	if result.Latency <= 0 {
+		t.Errorf("Ping returned Latency = %v, expected a positive duration", result.Latency)
+	}
+}
+
+func TestPingErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		expected error
+	}{
+		{"Unauthorized", http.StatusUnauthorized, `{"status": "error", "message": "Unauthorized."}`, ErrInvalidToken},
+		{"Not found", http.StatusNotFound, `<html>Not Found</html>`, ErrInvalidURL},
+		{"HTML page", http.StatusOK, `<html>Login</html>`, ErrInvalidURL},
+		{"Server error", http.StatusInternalServerError, `{"status": "error"}`, ErrServerUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			requests := 0
+			mux.HandleFunc("/api/v1/users/me", func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			})
+
+			_, err := client.Ping()
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("Ping returned error %v, expected %v", err, tt.expected)
+			}
+
+			if requests != 1 {
+				t.Errorf("Ping made %d requests, expected %d", requests, 1)
+			}
+		})
+	}
+}
+
+func TestPingServerDown(t *testing.T) {
+	client, _, _, teardown := setup()
+	teardown()
+
+	_, err := client.Ping()
+	if !errors.Is(err, ErrServerUnavailable) {
+		t.Errorf("Ping returned error %v, expected %v", err, ErrServerUnavailable)
+	}
+}
+
+func TestPingHostNotFound(t *testing.T) {
+	client, err := NewClient("http://snipeit.invalid", "test-token")
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	_, err = client.Ping()
+	if !errors.Is(err, ErrHostNotFound) || !errors.Is(err, ErrInvalidURL) {
+		t.Errorf("Ping returned error %v, expected %v wrapping %v", err, ErrHostNotFound, ErrInvalidURL)
+	}
+}
+
+func TestPingUntrustedCertificate(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 7}`)
+	}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	_, err = client.Ping()
+	if !errors.Is(err, ErrTLS) {
+		t.Errorf("Ping returned error %v, expected %v", err, ErrTLS)
+	}
+}
+
+func TestVersion(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "Authorization", "Bearer test-token")
+		fmt.Fprint(w, `{"version": "v7.0.13", "build_version": "14502", "hash_version": "g1f3d1c9", "full_app_version": "v7.0.13 - build 14502-g1f3d1c9"}`)
+	})
+
+	version, err := client.Version()
+	if err != nil {
+		t.Fatalf("Version returned error: %v", err)
+	}
+
+	if version.Version != "v7.0.13" || version.BuildVersion != "14502" {
+		t.Errorf("Version returned %+v, expected v7.0.13 build 14502", version)
+	}
+}
+
+func TestVersionInvalidToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/version", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message": "Unauthenticated."}`)
+	})
+
+	_, err := client.Version()
+	if !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Version returned error %v, expected %v", err, ErrInvalidToken)
+	}
+}
@@ -0,0 +1,278 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AssetQuery is a query over assets, built one filter at a time:
+//
+//	query := snipeit.AssetsQuery().
+//	    Status(snipeit.StatusMetaDeployed).
+//	    Category("Laptop").
+//	    Location(12).
+//	    UpdatedAfter(lastSync)
+//
+//	for asset, err := range client.Assets.Query(query) {
+//	    ...
+//	}
+//
+// Filters supported by the hardware endpoint are compiled to its query
+// parameters; the others, such as UpdatedAfter and Where, are applied on
+// the client to the assets the server returns, so that every filter
+// narrows the results whatever the server supports.
+//
+// An AssetQuery is an immutable value: each method returns a new query, so
+// that a query can be shared and extended.
+type AssetQuery struct {
+	search       string
+	status       StatusMeta
+	statusID     int
+	modelID      int
+	categoryID   int
+	category     string
+	locationID   int
+	companyID    int
+	manufacturer int
+	supplierID   int
+	sort         string
+	order        string
+	pageSize     int
+	updatedAfter time.Time
+	where        []func(Asset) bool
+}
+
+// AssetsQuery returns a query matching every asset.
+func AssetsQuery() AssetQuery {
+	return AssetQuery{}
+}
+
+// Search returns q matching the assets whose searchable fields, such as
+// name, asset tag and serial, contain term.
+func (q AssetQuery) Search(term string) AssetQuery {
+	q.search = term
+	return q
+}
+
+// Status returns q matching the assets in the given state, such as
+// StatusMetaDeployed or StatusMetaArchived.
+func (q AssetQuery) Status(status StatusMeta) AssetQuery {
+	q.status = status
+	return q
+}
+
+// StatusLabel returns q matching the assets with the status label of the
+// given ID.
+func (q AssetQuery) StatusLabel(id int) AssetQuery {
+	q.statusID = id
+	return q
+}
+
+// Model returns q matching the assets of the model of the given ID.
+func (q AssetQuery) Model(id int) AssetQuery {
+	q.modelID = id
+	return q
+}
+
+// Category returns q matching the assets in the category named name,
+// ignoring case.
+func (q AssetQuery) Category(name string) AssetQuery {
+	q.category = name
+	return q
+}
+
+// CategoryID returns q matching the assets in the category of the given ID.
+func (q AssetQuery) CategoryID(id int) AssetQuery {
+	q.categoryID = id
+	return q
+}
+
+// Location returns q matching the assets at the location of the given ID.
+func (q AssetQuery) Location(id int) AssetQuery {
+	q.locationID = id
+	return q
+}
+
+// Company returns q matching the assets owned by the company of the given
+// ID.
+func (q AssetQuery) Company(id int) AssetQuery {
+	q.companyID = id
+	return q
+}
+
+// Manufacturer returns q matching the assets made by the manufacturer of
+// the given ID.
+func (q AssetQuery) Manufacturer(id int) AssetQuery {
+	q.manufacturer = id
+	return q
+}
+
+// Supplier returns q matching the assets bought from the supplier of the
+// given ID.
+func (q AssetQuery) Supplier(id int) AssetQuery {
+	q.supplierID = id
+	return q
+}
+
+// UpdatedAfter returns q matching the assets last updated after t. The
+// hardware endpoint cannot filter by update time, so the assets are
+// filtered on the client.
+func (q AssetQuery) UpdatedAfter(t time.Time) AssetQuery {
+	q.updatedAfter = t
+	return q
+}
+
+// Where returns q also matching only the assets for which match reports
+// true. The assets are filtered on the client, after the other filters.
+func (q AssetQuery) Where(match func(Asset) bool) AssetQuery {
+	// Copy where, so that queries extended from the same one do not share
+	// it
+	q.where = append(q.where[:len(q.where):len(q.where)], match)
+	return q
+}
+
+// Sort returns q sorting the assets by field, such as "created_at" or
+// "asset_tag", in the direction dir, "asc" or "desc".
+func (q AssetQuery) Sort(field, dir string) AssetQuery {
+	q.sort, q.order = field, dir
+	return q
+}
+
+// PageSize returns q fetching n assets per request instead of the default
+// 500.
+func (q AssetQuery) PageSize(n int) AssetQuery {
+	q.pageSize = n
+	return q
+}
+
+// statusParams are the values of the status parameter of the hardware
+// endpoint for each status meta.
+var statusParams = map[StatusMeta]string{
+	StatusMetaDeployable:   "RTD",
+	StatusMetaDeployed:     "Deployed",
+	StatusMetaPending:      "Pending",
+	StatusMetaUndeployable: "Undeployable",
+	StatusMetaArchived:     "Archived",
+}
+
+// Values returns the query parameters of the hardware endpoint that q
+// compiles to, without pagination. Filters the endpoint does not support
+// are left out, to be applied on the client.
+func (q AssetQuery) Values() url.Values {
+	v := url.Values{}
+	set := func(key, value string) {
+		if value != "" {
+			v.Set(key, value)
+		}
+	}
+	setID := func(key string, id int) {
+		if id != 0 {
+			v.Set(key, strconv.Itoa(id))
+		}
+	}
+
+	set("search", q.search)
+	if q.status != "" {
+		status := string(q.status)
+		for meta, param := range statusParams {
+			if q.status.Is(meta) {
+				status = param
+			}
+		}
+		v.Set("status", status)
+	}
+	setID("status_id", q.statusID)
+	setID("model_id", q.modelID)
+	setID("category_id", q.categoryID)
+	setID("location_id", q.locationID)
+	setID("company_id", q.companyID)
+	setID("manufacturer_id", q.manufacturer)
+	setID("supplier_id", q.supplierID)
+	if q.category != "" {
+		// The filter matches categories containing the name; matches returns
+		// the exact ones
+		filter, _ := json.Marshal(map[string]string{"category": q.category})
+		v.Set("filter", string(filter))
+	}
+	set("sort", q.sort)
+	set("order", q.order)
+	return v
+}
+
+// matches reports whether asset passes the filters of q applied on the
+// client.
+func (q AssetQuery) matches(asset Asset) bool {
+	if q.category != "" && !strings.EqualFold(strings.TrimSpace(asset.Category.Name), strings.TrimSpace(q.category)) {
+		return false
+	}
+	if !q.updatedAfter.IsZero() && (asset.UpdatedAt == nil || !asset.UpdatedAt.After(q.updatedAfter)) {
+		return false
+	}
+	for _, match := range q.where {
+		if !match(asset) {
+			return false
+		}
+	}
+	return true
+}
+
+// Query returns an iterator over the assets matching q, fetching pages
+// lazily as the caller ranges over it.
+//
+// An error ends the iteration and is yielded alongside a zero Asset.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-list
+func (s *AssetsService) Query(q AssetQuery) iter.Seq2[Asset, error] {
+	return s.QueryContext(context.Background(), q)
+}
+
+// QueryContext returns an iterator over the assets matching q with the
+// provided context, fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+//
+// An error ends the iteration and is yielded alongside a zero Asset.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-list
+func (s *AssetsService) QueryContext(ctx context.Context, q AssetQuery) iter.Seq2[Asset, error] {
+	params := q.Values()
+	assets := iterate(ctx, &ListOptions{Limit: q.pageSize}, func(ctx context.Context, pageOpts *ListOptions) ([]Asset, int, error) {
+		v := url.Values{}
+		for key, values := range params {
+			v[key] = values
+		}
+		v.Set("offset", strconv.Itoa(pageOpts.Offset))
+		v.Set("limit", strconv.Itoa(pageOpts.Limit))
+
+		req, err := s.client.newRequestWithContext(ctx, http.MethodGet, "api/v1/hardware?"+v.Encode(), nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var page AssetsResponse
+		if _, err := s.client.Do(req, &page); err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+
+	return func(yield func(Asset, error) bool) {
+		for asset, err := range assets {
+			if err != nil {
+				yield(asset, err)
+				return
+			}
+			if q.matches(asset) && !yield(asset, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package snipeit
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestAssetQueryValues(t *testing.T) {
+	query := AssetsQuery().
+		Status(StatusMetaDeployed).
+		Category("Laptop").
+		Location(12).
+		Model(3).
+		Search("mac").
+		Sort("created_at", "desc").
+		UpdatedAfter(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	want := "filter=%7B%22category%22%3A%22Laptop%22%7D&location_id=12&model_id=3&order=desc&search=mac&sort=created_at&status=Deployed"
+	if got := query.Values().Encode(); got != want {
+		t.Errorf("Values returned %q, expected %q", got, want)
+	}
+
+	tests := []struct {
+		status StatusMeta
+		want   string
+	}{
+		{StatusMetaDeployable, "RTD"},
+		{"Archived", "Archived"},
+		{"Requestable", "Requestable"},
+	}
+	for _, tt := range tests {
+		if got := AssetsQuery().Status(tt.status).Values().Get("status"); got != tt.want {
+			t.Errorf("Status(%q) compiled to status=%q, expected %q", tt.status, got, tt.want)
+		}
+	}
+
+	if got := AssetsQuery().Values().Encode(); got != "" {
+		t.Errorf("Values of an empty query returned %q, expected none", got)
+	}
+}
+
+func TestAssetQueryImmutable(t *testing.T) {
+	base := AssetsQuery().Where(func(a Asset) bool { return a.ID > 1 })
+	odd := base.Where(func(a Asset) bool { return a.ID%2 == 1 })
+	even := base.Where(func(a Asset) bool { return a.ID%2 == 0 })
+	located := base.Location(4)
+
+	asset := Asset{CommonFields: CommonFields{ID: 3}}
+	if !odd.matches(asset) || even.matches(asset) || !base.matches(asset) {
+		t.Errorf("Queries extended from the same one share their filters")
+	}
+	if base.Values().Get("location_id") != "" || located.Values().Get("location_id") != "4" {
+		t.Errorf("Location changed the query it was called on")
+	}
+}
+
+func TestAssetsQuery(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.disableRetries = true
+
+	updated := func(day int) string {
+		return fmt.Sprintf(`{"datetime": "2025-01-%02d 10:00:00"}`, day)
+	}
+	rows := []string{
+		fmt.Sprintf(`{"id": 1, "category": {"id": 1, "name": "Laptop"}, "updated_at": %s}`, updated(5)),
+		fmt.Sprintf(`{"id": 2, "category": {"id": 2, "name": "Laptop Bags"}, "updated_at": %s}`, updated(5)),
+		fmt.Sprintf(`{"id": 3, "category": {"id": 1, "name": "laptop"}, "updated_at": %s}`, updated(1)),
+		fmt.Sprintf(`{"id": 4, "category": {"id": 1, "name": "Laptop"}, "updated_at": %s}`, updated(9)),
+		`{"id": 5, "category": {"id": 1, "name": "Laptop"}}`,
+	}
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		query := r.URL.Query()
+		if query.Get("status") != "Deployed" || query.Get("location_id") != "12" || query.Get("filter") != `{"category":"Laptop"}` {
+			t.Errorf("Request query = %v, expected the deployed laptops at location 12", query)
+		}
+
+		// Two assets per page
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		if query.Get("limit") != "2" {
+			t.Errorf("Request limit = %q, expected %q", query.Get("limit"), "2")
+		}
+		end := min(offset+2, len(rows))
+		fmt.Fprintf(w, `{"total": %d, "rows": [`, len(rows))
+		for i := offset; i < end; i++ {
+			if i > offset {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprint(w, rows[i])
+		}
+		fmt.Fprint(w, `]}`)
+	})
+
+	query := AssetsQuery().
+		Status(StatusMetaDeployed).
+		Category("Laptop").
+		Location(12).
+		UpdatedAfter(time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)).
+		PageSize(2)
+
+	var ids []int
+	for asset, err := range client.Assets.Query(query) {
+		if err != nil {
+			t.Fatalf("Assets.Query returned error: %v", err)
+		}
+		ids = append(ids, asset.ID)
+	}
+	if fmt.Sprint(ids) != "[1 4]" {
+		t.Errorf("Assets.Query returned assets %v, expected [1 4]", ids)
+	}
+}
+
+func TestAssetsQueryError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.disableRetries = true
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	for _, err := range client.Assets.Query(AssetsQuery().Location(1)) {
+		if err == nil {
+			t.Fatal("Assets.Query yielded an asset, expected an error")
+		}
+		return
+	}
+	t.Error("Assets.Query yielded nothing, expected an error")
+}
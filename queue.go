@@ -0,0 +1,403 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrQueued is wrapped by the error returned when a mutating request could
+// not reach the server and was stored in the offline queue for replay.
+var ErrQueued = errors.New("snipeit: server unreachable, request queued for replay")
+
+// ErrReplayRejected is wrapped by the errors of queued requests that the
+// API rejected when they were replayed.
+var ErrReplayRejected = errors.New("snipeit: queued request rejected on replay")
+
+// QueuedRequest is a mutating request stored in an offline queue.
+type QueuedRequest struct {
+	// ID identifies the request in its store. IDs sort in queue order.
+	ID string `json:"id"`
+
+	// Method is the HTTP method of the request
+	Method string `json:"method"`
+
+	// URL is the absolute URL of the request
+	URL string `json:"url"`
+
+	// Header holds the request headers, without credentials
+	Header http.Header `json:"header,omitempty"`
+
+	// Body is the raw request body
+	Body []byte `json:"body,omitempty"`
+
+	// QueuedAt is when the request was queued
+	QueuedAt time.Time `json:"queued_at"`
+}
+
+// QueueStore persists the requests of an offline queue.
+// Implementations must be safe for concurrent use.
+type QueueStore interface {
+	// Append adds a request to the end of the queue.
+	Append(req *QueuedRequest) error
+
+	// List returns the queued requests, oldest first.
+	List() ([]*QueuedRequest, error)
+
+	// Remove deletes the request with the given ID from the queue.
+	Remove(id string) error
+}
+
+// offlineQueue serializes the use of a QueueStore by a client and the
+// clients derived from it.
+type offlineQueue struct {
+	mu    sync.Mutex
+	store QueueStore
+}
+
+// isMutating reports whether requests with the given method change data
+// on the server, and so are queued when it is unreachable.
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isUnreachable reports whether err means the request never got a
+// response from the server, as opposed to an API error or cancellation.
+func isUnreachable(resp *http.Response, err error) bool {
+	if err == nil || resp != nil {
+		return false
+	}
+	var errorResponse *ErrorResponse
+	return !errors.As(err, &errorResponse) &&
+		!errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// doQueued sends a mutating request through the offline queue.
+//
+// Requests already waiting in the queue are replayed first, so that changes
+// reach the server in order. If the server is unreachable, the request is
+// appended to the queue and an error wrapping ErrQueued is returned. The
+// errors of queued requests rejected by the API while replaying, which wrap
+// ErrReplayRejected, are joined with the result of the request.
+func (c *Client) doQueued(req *http.Request, v interface{}, opts *RequestOptions) (*http.Response, error) {
+	queueOpts := RequestOptions{}
+	if opts != nil {
+		queueOpts = *opts
+	}
+	queueOpts.skipQueue = true
+
+	// Requests whose body cannot be read again cannot be queued
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return c.DoWithOptions(req, v, &queueOpts)
+		}
+		r, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		body, err = io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := req.Context()
+	if queueOpts.Context != nil {
+		ctx = queueOpts.Context
+	}
+
+	// Set the idempotency key before the first attempt, so that a queued
+	// request is replayed with the same key
+	if name := c.retryPolicy.idempotencyKeyHeader(req.Method); name != "" && req.Header.Get(name) == "" {
+		key, err := newRandomID()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Header.Set(name, key)
+	}
+
+	// Only the replay holds the lock, so that requests sent while the queue
+	// is empty are not serialized
+	c.queue.mu.Lock()
+	_, rejected, err := c.replayQueue(ctx)
+	if err != nil {
+		// Keep the request behind those left in the queue
+		err = c.enqueue(req, body, err)
+		c.queue.mu.Unlock()
+		return nil, errors.Join(rejected, err)
+	}
+	c.queue.mu.Unlock()
+
+	resp, err := c.DoWithOptions(req, v, &queueOpts)
+	if isUnreachable(resp, err) {
+		return nil, errors.Join(rejected, c.enqueue(req, body, err))
+	}
+	return resp, errors.Join(rejected, err)
+}
+
+// enqueue appends req to the offline queue after it failed with cause,
+// and returns the error reported to the caller.
+func (c *Client) enqueue(req *http.Request, body []byte, cause error) error {
+	id, err := newRandomID()
+	if err != nil {
+		return err
+	}
+
+	header := req.Header.Clone()
+	for _, name := range sensitiveHeaders {
+		header.Del(name)
+	}
+
+	now := time.Now()
+	queued := &QueuedRequest{
+		ID:       fmt.Sprintf("%020d-%s", now.UnixNano(), id),
+		Method:   req.Method,
+		URL:      req.URL.String(),
+		Header:   header,
+		Body:     body,
+		QueuedAt: now,
+	}
+	if err := c.queue.store.Append(queued); err != nil {
+		return fmt.Errorf("snipeit: queueing request: %w (request failed: %w)", err, cause)
+	}
+
+	return fmt.Errorf("%w: %w", ErrQueued, cause)
+}
+
+// ReplayQueue sends the requests of the offline queue in order, removing
+// each one once the server has answered it.
+//
+// Replay stops at the first request that cannot reach the server, leaving
+// it and the following requests queued; call ReplayQueue again once
+// connectivity returns. Requests rejected by the API are removed from the
+// queue and their errors, which wrap ErrReplayRejected, returned, joined,
+// after the others are replayed.
+//
+// It returns the number of requests removed from the queue.
+// If the client has no offline queue, it does nothing.
+func (c *Client) ReplayQueue() (int, error) {
+	return c.ReplayQueueContext(context.Background())
+}
+
+// ReplayQueueContext sends the requests of the offline queue in order with
+// the provided context. See ReplayQueue.
+//
+// ctx is the context for the requests.
+func (c *Client) ReplayQueueContext(ctx context.Context) (int, error) {
+	if c.queue == nil {
+		return 0, nil
+	}
+
+	c.queue.mu.Lock()
+	defer c.queue.mu.Unlock()
+
+	replayed, rejected, err := c.replayQueue(ctx)
+	return replayed, errors.Join(rejected, err)
+}
+
+// replayQueue replays the offline queue. It must be called with the
+// queue's mutex held.
+//
+// It returns the number of requests removed from the queue, the joined
+// errors of the requests rejected by the API, and the error that stopped
+// the replay, if any.
+func (c *Client) replayQueue(ctx context.Context) (int, error, error) {
+	queued, err := c.queue.store.List()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	replayed := 0
+	var rejected []error
+	for _, q := range queued {
+		req, err := c.newReplayRequest(ctx, q)
+		if err != nil {
+			return replayed, errors.Join(rejected...), err
+		}
+
+		resp, err := c.DoWithOptions(req, nil, &RequestOptions{Context: ctx, skipQueue: true})
+		if err != nil && (isUnreachable(resp, err) || ctx.Err() != nil) {
+			return replayed, errors.Join(rejected...), err
+		}
+		if err != nil {
+			rejected = append(rejected, fmt.Errorf("%w: %s %s: %w", ErrReplayRejected, q.Method, q.URL, err))
+		}
+
+		if err := c.queue.store.Remove(q.ID); err != nil {
+			return replayed, errors.Join(rejected...), err
+		}
+		replayed++
+	}
+
+	return replayed, errors.Join(rejected...), nil
+}
+
+// newReplayRequest rebuilds a queued request with the client's credentials.
+func (c *Client) newReplayRequest(ctx context.Context, q *QueuedRequest) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, q.Method, q.URL, bytes.NewReader(q.Body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range q.Header {
+		req.Header[name] = append([]string(nil), values...)
+	}
+	req.Header.Set("Authorization", c.token)
+
+	return req, nil
+}
+
+// MemoryQueueStore is a QueueStore that keeps requests in memory. Queued
+// requests are lost when the process exits; use FileQueueStore to keep them.
+type MemoryQueueStore struct {
+	mu       sync.Mutex
+	requests []*QueuedRequest
+}
+
+// NewMemoryQueueStore returns an empty MemoryQueueStore.
+func NewMemoryQueueStore() *MemoryQueueStore {
+	return &MemoryQueueStore{}
+}
+
+// Append adds a request to the end of the queue.
+func (s *MemoryQueueStore) Append(req *QueuedRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, req)
+	return nil
+}
+
+// List returns the queued requests, oldest first.
+func (s *MemoryQueueStore) List() ([]*QueuedRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*QueuedRequest(nil), s.requests...), nil
+}
+
+// Remove deletes the request with the given ID from the queue.
+func (s *MemoryQueueStore) Remove(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, req := range s.requests {
+		if req.ID == id {
+			s.requests = append(s.requests[:i], s.requests[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// FileQueueStore is a QueueStore that keeps each request as a JSON file in
+// a directory, so that queued requests survive restarts. Request bodies are
+// stored as is; protect the directory accordingly.
+type FileQueueStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewFileQueueStore returns a FileQueueStore using dir, creating it if needed.
+func NewFileQueueStore(dir string) (*FileQueueStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileQueueStore{dir: dir}, nil
+}
+
+// Append adds a request to the end of the queue. The file is written
+// atomically, so a crash never leaves a partial request behind.
+func (s *FileQueueStore) Append(req *QueuedRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(s.dir, ".queued-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path(req.ID))
+}
+
+// List returns the queued requests, oldest first.
+func (s *FileQueueStore) List() ([]*QueuedRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if name := entry.Name(); !entry.IsDir() && !strings.HasPrefix(name, ".") && strings.HasSuffix(name, ".json") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	requests := make([]*QueuedRequest, 0, len(names))
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(s.dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		var req QueuedRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			return nil, fmt.Errorf("snipeit: reading queued request %s: %w", name, err)
+		}
+		requests = append(requests, &req)
+	}
+
+	return requests, nil
+}
+
+// Remove deletes the request with the given ID from the queue.
+func (s *FileQueueStore) Remove(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := os.Remove(s.path(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// path returns the path of the file holding the request with the given ID.
+func (s *FileQueueStore) path(id string) string {
+	return filepath.Join(s.dir, filepath.Base(id)+".json")
+}
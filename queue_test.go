@@ -0,0 +1,265 @@
+package snipeit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+// newOfflineClient returns a client with an offline queue whose transport
+// fails while *offline is true.
+func newOfflineClient(t *testing.T, serverURL string, store QueueStore, offline *bool) *Client {
+	t.Helper()
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if *offline {
+			return nil, errors.New("network is unreachable")
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{Transport: transport, OfflineQueue: store})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	client.disableRetries = true
+
+	return client
+}
+
+func TestOfflineQueue(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var received []string
+	mux.HandleFunc("/api/v1/hardware/", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Bearer test-token")
+		body, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		received = append(received, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, bytes.TrimSpace(body)))
+		mu.Unlock()
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 1}}`)
+	})
+
+	store, err := NewFileQueueStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileQueueStore returned error: %v", err)
+	}
+
+	offline := true
+	client := newOfflineClient(t, serverURL, store, &offline)
+
+	_, _, err = client.Assets.Patch(1, map[string]interface{}{"notes": "first"})
+	if !errors.Is(err, ErrQueued) {
+		t.Fatalf("Assets.Patch returned error %v, expected %v", err, ErrQueued)
+	}
+	if _, err := client.Assets.Delete(2); !errors.Is(err, ErrQueued) {
+		t.Fatalf("Assets.Delete returned error %v, expected %v", err, ErrQueued)
+	}
+
+	queued, err := store.List()
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(queued) != 2 {
+		t.Fatalf("store has %d queued requests, expected %d", len(queued), 2)
+	}
+	if queued[0].Header.Get("Authorization") != "" {
+		t.Error("queued request kept the Authorization header")
+	}
+
+	// A new mutating request replays the queue before being sent
+	offline = false
+	if _, _, err := client.Assets.Patch(3, map[string]interface{}{"notes": "third"}); err != nil {
+		t.Fatalf("Assets.Patch returned error: %v", err)
+	}
+
+	expected := []string{
+		`PATCH /api/v1/hardware/1 {"notes":"first"}`,
+		`DELETE /api/v1/hardware/2 `,
+		`PATCH /api/v1/hardware/3 {"notes":"third"}`,
+	}
+	if fmt.Sprint(received) != fmt.Sprint(expected) {
+		t.Errorf("server received %q, expected %q", received, expected)
+	}
+
+	if queued, _ := store.List(); len(queued) != 0 {
+		t.Errorf("store has %d queued requests after replay, expected none", len(queued))
+	}
+}
+
+func TestReplayQueue(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status": "error", "messages": "Asset not found"}`)
+	})
+	requests := 0
+	mux.HandleFunc("/api/v1/hardware/2", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 2}}`)
+	})
+
+	store := NewMemoryQueueStore()
+	offline := true
+	client := newOfflineClient(t, serverURL, store, &offline)
+
+	client.Assets.Delete(1)
+	client.Assets.Delete(2)
+
+	// Replay stops while the server is still unreachable
+	n, err := client.ReplayQueue()
+	if n != 0 || err == nil {
+		t.Errorf("ReplayQueue returned %d, %v, expected 0 and an error", n, err)
+	}
+
+	offline = false
+	n, err = client.ReplayQueue()
+	if n != 2 {
+		t.Errorf("ReplayQueue returned %d, expected %d", n, 2)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("ReplayQueue returned error %v, expected %v", err, ErrNotFound)
+	}
+	if requests != 1 {
+		t.Errorf("server received %d requests for asset 2, expected %d", requests, 1)
+	}
+
+	if queued, _ := store.List(); len(queued) != 0 {
+		t.Errorf("store has %d queued requests after replay, expected none", len(queued))
+	}
+}
+
+func TestOfflineQueueAPIError(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"status": "error", "messages": {"name": ["The name field is required."]}}`)
+	})
+
+	store := NewMemoryQueueStore()
+	offline := false
+	client := newOfflineClient(t, serverURL, store, &offline)
+
+	_, _, err := client.Assets.Patch(1, map[string]interface{}{"name": ""})
+	if !errors.Is(err, ErrValidation) || errors.Is(err, ErrQueued) {
+		t.Errorf("Assets.Patch returned error %v, expected %v", err, ErrValidation)
+	}
+
+	if queued, _ := store.List(); len(queued) != 0 {
+		t.Errorf("store has %d queued requests, expected none", len(queued))
+	}
+}
+
+func TestOfflineQueueReplayRejected(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status": "error", "messages": "Asset not found"}`)
+	})
+	mux.HandleFunc("/api/v1/hardware/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 2}}`)
+	})
+
+	store := NewMemoryQueueStore()
+	offline := true
+	client := newOfflineClient(t, serverURL, store, &offline)
+
+	client.Assets.Delete(1)
+
+	// The queued request rejected on replay is reported by the next request
+	offline = false
+	resp, err := client.Assets.Delete(2)
+	if !errors.Is(err, ErrReplayRejected) || !errors.Is(err, ErrNotFound) {
+		t.Errorf("Assets.Delete returned error %v, expected %v and %v", err, ErrReplayRejected, ErrNotFound)
+	}
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("Assets.Delete returned response %v, expected the response of its request", resp)
+	}
+
+	if queued, _ := store.List(); len(queued) != 0 {
+		t.Errorf("store has %d queued requests after replay, expected none", len(queued))
+	}
+}
+
+func TestOfflineQueueIdempotencyKey(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	var keys []string
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 1}}`)
+	})
+
+	store := NewMemoryQueueStore()
+	offline := true
+	client := newOfflineClient(t, serverURL, store, &offline)
+	client.retryPolicy.IdempotencyKeyHeader = "Idempotency-Key"
+
+	if _, _, err := client.Assets.Patch(1, map[string]interface{}{"notes": "first"}); !errors.Is(err, ErrQueued) {
+		t.Fatalf("Assets.Patch returned error %v, expected %v", err, ErrQueued)
+	}
+
+	queued, _ := store.List()
+	if len(queued) != 1 || queued[0].Header.Get("Idempotency-Key") == "" {
+		t.Fatalf("store has %v queued, expected a request with an idempotency key", queued)
+	}
+	key := queued[0].Header.Get("Idempotency-Key")
+
+	offline = false
+	if n, err := client.ReplayQueue(); n != 1 || err != nil {
+		t.Fatalf("ReplayQueue returned %d, %v, expected 1 and no error", n, err)
+	}
+	if len(keys) != 1 || keys[0] != key {
+		t.Errorf("server received idempotency keys %q, expected [%q]", keys, key)
+	}
+}
+
+func TestOfflineQueueConcurrentRequests(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	started, release := make(chan struct{}), make(chan struct{})
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 1}}`)
+	})
+	mux.HandleFunc("/api/v1/hardware/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 2}}`)
+	})
+
+	offline := false
+	client := newOfflineClient(t, serverURL, NewMemoryQueueStore(), &offline)
+
+	done := make(chan error)
+	go func() {
+		_, err := client.Assets.Delete(1)
+		done <- err
+	}()
+	<-started
+
+	// The queue is not locked while the first request waits for its response
+	if _, err := client.Assets.Delete(2); err != nil {
+		t.Errorf("Assets.Delete returned error: %v", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("Assets.Delete returned error: %v", err)
+	}
+}
@@ -3,19 +3,61 @@ package snipeit
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
 	"math"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
 
 // RateLimiter defines the interface for rate limiting API requests.
+//
+// Any type with a Wait method can be used, including *rate.Limiter from
+// golang.org/x/time/rate, which can be passed to ClientOptions.RateLimiter
+// as is.
 type RateLimiter interface {
 	// Wait blocks until a request can be made according to the rate limit.
 	Wait(ctx context.Context) error
 }
 
+// RateLimiterFunc is an adapter that allows the use of an ordinary function
+// as a RateLimiter.
+type RateLimiterFunc func(ctx context.Context) error
+
+// Wait calls f(ctx).
+func (f RateLimiterFunc) Wait(ctx context.Context) error {
+	return f(ctx)
+}
+
+// WaitNLimiter is implemented by rate limiters that can wait for several
+// tokens at once, such as *rate.Limiter from golang.org/x/time/rate and
+// *TokenBucketRateLimiter.
+type WaitNLimiter interface {
+	// WaitN blocks until n tokens are available or the context is done.
+	WaitN(ctx context.Context, n int) error
+}
+
+// NewWaitNRateLimiter returns a RateLimiter that takes n tokens from
+// limiter for every request, including retries. It adapts a
+// *rate.Limiter shared with other work, for example, so that each API
+// request costs more than one token. If n is not positive, 1 is used.
+func NewWaitNRateLimiter(limiter WaitNLimiter, n int) RateLimiter {
+	if n <= 0 {
+		n = 1
+	}
+
+	return RateLimiterFunc(func(ctx context.Context) error {
+		return limiter.WaitN(ctx, n)
+	})
+}
+
 // TokenBucketRateLimiter implements a simple token bucket rate limiter.
+// It is safe for concurrent use; waiting callers do not block each other.
 type TokenBucketRateLimiter struct {
 	tokens         float64
 	maxTokens      float64
@@ -24,6 +66,14 @@ type TokenBucketRateLimiter struct {
 	mutex          sync.Mutex
 }
 
+// Reservation holds tokens reserved from a TokenBucketRateLimiter
+// by ReserveN.
+type Reservation struct {
+	limiter   *TokenBucketRateLimiter
+	tokens    int
+	timeToAct time.Time
+}
+
 // NewTokenBucketRateLimiter creates a new token bucket rate limiter.
 //
 // requestsPerSecond is the maximum number of requests allowed per second.
@@ -46,40 +96,87 @@ func NewTokenBucketRateLimiter(requestsPerSecond float64, burstSize int) *TokenB
 
 // Wait blocks until a token is available or the context is canceled.
 func (r *TokenBucketRateLimiter) Wait(ctx context.Context) error {
-	r.mutex.Lock()
-	defer r.mutex.Unlock()
-
-	// Refill tokens based on elapsed time
-	now := time.Now()
-	elapsed := now.Sub(r.lastRefillTime).Seconds()
-	r.tokens = math.Min(r.maxTokens, r.tokens+elapsed*r.tokensPerSec)
-	r.lastRefillTime = now
+	return r.WaitN(ctx, 1)
+}
 
-	// If we have at least one token, consume it immediately
-	if r.tokens >= 1 {
-		r.tokens--
+// WaitN blocks until n tokens are available or the context is canceled.
+// If the context is canceled, the tokens are returned to the bucket.
+func (r *TokenBucketRateLimiter) WaitN(ctx context.Context, n int) error {
+	reservation := r.ReserveN(n)
+	delay := reservation.Delay()
+	if delay == 0 {
 		return nil
 	}
 
-	// Calculate wait time until next token is available
-	waitTime := time.Duration((1.0 - r.tokens) / r.tokensPerSec * float64(time.Second))
-
-	// Create a timer for the wait
-	timer := time.NewTimer(waitTime)
+	// Wait without holding the mutex, so other callers can reserve
+	// their own tokens in the meantime
+	timer := time.NewTimer(delay)
 	defer timer.Stop()
 
-	// Wait for either the timer to expire or the context to be canceled
 	select {
 	case <-timer.C:
-		// Timer expired, we can make the request
-		r.tokens = 0 // Consumed token
 		return nil
 	case <-ctx.Done():
-		// Context was canceled
+		reservation.Cancel()
 		return ctx.Err()
 	}
 }
 
+// ReserveN reserves n tokens and returns a Reservation describing when
+// they will be available. Tokens are taken from the bucket immediately,
+// even if that makes it go into debt, so later callers wait longer; this
+// lets batch tools plan capacity ahead of time.
+//
+// The caller should wait for Reservation.Delay before acting, or call
+// Reservation.Cancel to give the tokens back.
+func (r *TokenBucketRateLimiter) ReserveN(n int) *Reservation {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	r.refill(now)
+	r.tokens -= float64(n)
+
+	var delay time.Duration
+	if r.tokens < 0 {
+		delay = time.Duration(-r.tokens / r.tokensPerSec * float64(time.Second))
+	}
+
+	return &Reservation{limiter: r, tokens: n, timeToAct: now.Add(delay)}
+}
+
+// refill adds the tokens earned since the last refill. It must be called
+// with the mutex held.
+func (r *TokenBucketRateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(r.lastRefillTime).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	r.tokens = math.Min(r.maxTokens, r.tokens+elapsed*r.tokensPerSec)
+	r.lastRefillTime = now
+}
+
+// Delay returns how long to wait before the reserved tokens are available.
+// It returns zero if they are available now.
+func (res *Reservation) Delay() time.Duration {
+	return max(0, time.Until(res.timeToAct))
+}
+
+// Cancel returns the reserved tokens to the bucket, so that other callers
+// can use them. It has no effect once the reservation's delay has passed.
+func (res *Reservation) Cancel() {
+	if res.Delay() == 0 {
+		return
+	}
+
+	r := res.limiter
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.refill(time.Now())
+	r.tokens = math.Min(r.maxTokens, r.tokens+float64(res.tokens))
+}
+
 // RetryPolicy defines how requests should be retried.
 type RetryPolicy struct {
 	// MaxRetries is the maximum number of times to retry a failed request.
@@ -101,6 +198,103 @@ type RetryPolicy struct {
 	// from retrying in lockstep. It's a value between 0 and 1, where 0 means no jitter
 	// and 1 means the backoff can be anywhere from 0 to the calculated backoff time.
 	Jitter float64
+
+	// MaxElapsedTime caps the total time spent on a request and its retries,
+	// measured from the start of the first attempt and including Retry-After
+	// waits. When the next wait would exceed it, the last error is returned
+	// wrapped with ErrRetryBudgetExceeded. Zero means no limit.
+	MaxElapsedTime time.Duration
+
+	// MaxRetryAfter caps the wait requested by a server's Retry-After header.
+	// Longer waits are shortened to MaxRetryAfter. Zero means no cap.
+	MaxRetryAfter time.Duration
+
+	// RetryableMethods is the set of HTTP methods whose requests may be retried.
+	// Retrying a non-idempotent request, such as a POST that creates an asset
+	// or checks one out, could apply it twice. If nil, GET, HEAD, PUT and
+	// DELETE requests are retried. RequestOptions.AllowRetry overrides it for
+	// a single request.
+	RetryableMethods map[string]bool
+
+	// IdempotencyKeyHeader, if set, is the name of a header (e.g.,
+	// "Idempotency-Key") that is sent with a random key on every POST and
+	// PATCH request, unchanged across retries, so that a server or proxy
+	// that supports idempotency keys can discard duplicates. It does not
+	// make those requests retryable by itself.
+	IdempotencyKeyHeader string
+
+	// RetryIf, if set, decides whether a failed attempt is retried, replacing
+	// the checks on RetryableStatusCodes and network errors. It is called with
+	// the response, which may be nil, and the error of the attempt, e.g. to
+	// retry errors that Snipe-IT reports with a 2xx status. A Retry-After
+	// header is still honored, and requests whose method is not retryable are
+	// never retried.
+	RetryIf func(resp *http.Response, err error) bool
+}
+
+// defaultRetryableMethods are the methods retried when
+// RetryPolicy.RetryableMethods is nil.
+var defaultRetryableMethods = map[string]bool{
+	http.MethodGet:    true,
+	http.MethodHead:   true,
+	http.MethodPut:    true,
+	http.MethodDelete: true,
+}
+
+// retryable reports whether requests with the given method may be retried.
+func (p *RetryPolicy) retryable(method string) bool {
+	if p.RetryableMethods == nil {
+		return defaultRetryableMethods[method]
+	}
+	return p.RetryableMethods[method]
+}
+
+// idempotencyKeyHeader returns the name of the header an idempotency key is
+// sent in with requests of the given method, or "" if none is sent.
+func (p *RetryPolicy) idempotencyKeyHeader(method string) string {
+	if p == nil || (method != http.MethodPost && method != http.MethodPatch) {
+		return ""
+	}
+	return p.IdempotencyKeyHeader
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either
+// a number of seconds or an HTTP date, relative to now.
+//
+// Fractional seconds are accepted. It reports false for empty, negative or
+// malformed values, in which case the regular backoff should be used. A date
+// in the past yields a zero delay.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		if seconds < 0 || math.IsNaN(seconds) || math.IsInf(seconds, 0) || seconds > math.MaxInt64/float64(time.Second) {
+			return 0, false
+		}
+		return time.Duration(seconds * float64(time.Second)), true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	return max(0, date.Sub(now)), true
+}
+
+// ErrRetryBudgetExceeded is wrapped by the error returned when retries stop
+// because waiting any longer would exceed RetryPolicy.MaxElapsedTime.
+var ErrRetryBudgetExceeded = errors.New("snipeit: retry budget exceeded")
+
+// retryBudgetError wraps err, the error of the last attempt, with
+// ErrRetryBudgetExceeded.
+func retryBudgetError(err error) error {
+	if err == nil {
+		return ErrRetryBudgetExceeded
+	}
+	return fmt.Errorf("%w: %w", ErrRetryBudgetExceeded, err)
 }
 
 // DefaultRetryPolicy returns the default retry policy.
@@ -118,6 +312,7 @@ func DefaultRetryPolicy() *RetryPolicy {
 		MaxBackoff:        defaultMaxBackoff,
 		BackoffMultiplier: defaultBackoffMultiplier,
 		Jitter:            defaultJitter,
+		RetryableMethods:  maps.Clone(defaultRetryableMethods),
 	}
 }
 
@@ -135,10 +330,51 @@ const (
 // ClientOptions contains options for configuring the Snipe-IT client.
 type ClientOptions struct {
 	// HTTPClient is the HTTP client to use for making requests.
-	// If nil, http.DefaultClient will be used.
+	// If nil, the client builds its own, configured by Timeout and the
+	// connection settings below, which are ignored otherwise.
 	HTTPClient *http.Client
 
+	// Timeout limits the time taken by each request, including reading
+	// the response body. Default: 60s. A negative value disables it.
+	Timeout time.Duration
+
+	// DialTimeout limits the time taken to open a connection.
+	// Default: 10s. A negative value disables it.
+	DialTimeout time.Duration
+
+	// TLSHandshakeTimeout limits the time taken by the TLS handshake.
+	// Default: 10s. A negative value disables it.
+	TLSHandshakeTimeout time.Duration
+
+	// ResponseHeaderTimeout limits the time to wait for the response
+	// headers after sending the request. Default: no limit beyond Timeout.
+	ResponseHeaderTimeout time.Duration
+
+	// IdleConnTimeout is how long an idle connection is kept open for
+	// reuse. Default: 90s. A negative value keeps idle connections open.
+	IdleConnTimeout time.Duration
+
+	// MaxIdleConnsPerHost is the number of idle connections kept open
+	// to the Snipe-IT server. Default: 10, enough for concurrent requests
+	// such as prefetching and bulk operations to reuse connections.
+	MaxIdleConnsPerHost int
+
+	// DisableHTTP2, if true, stops the client from negotiating HTTP/2.
+	DisableHTTP2 bool
+
+	// Transport, if set, is the round tripper used to send requests, such as
+	// an instrumented transport. It replaces the transport of HTTPClient,
+	// whose timeout and redirect policy are kept.
+	Transport http.RoundTripper
+
+	// TLS configures custom root CAs, client certificates for mutual TLS
+	// and certificate verification. It is applied to a clone of the
+	// transport, which must be an *http.Transport; if HTTPClient has none,
+	// Go's default transport is cloned. If nil, Go's default TLS settings are used.
+	TLS *TLSOptions
+
 	// RateLimiter controls the rate at which requests are made to the API.
+	// Every attempt of a request, including retries, waits on it.
 	// If nil, no rate limiting will be applied.
 	RateLimiter RateLimiter
 
@@ -148,6 +384,103 @@ type ClientOptions struct {
 
 	// DisableRetries, if true, disables automatic retries for failed requests.
 	DisableRetries bool
+
+	// Cache, if set, stores the responses to GET requests, which are reused
+	// for CacheTTL, and deletes those a successful write may have changed.
+	// Responses carrying an ETag or Last-Modified header are revalidated
+	// with conditional requests once they are older than CacheTTL, and the
+	// cached body is decoded when the server answers 304 Not Modified.
+	// If nil, responses are not cached.
+	Cache Cache
+
+	// CacheTTL is how long a cached response is reused without sending the
+	// request. If it is zero, only responses carrying an ETag or
+	// Last-Modified header are cached, and they are always revalidated.
+	CacheTTL time.Duration
+
+	// UserAgent is sent in the User-Agent header of every request, so that
+	// Snipe-IT administrators can attribute API traffic to an application.
+	// If empty, "go-snipeit/<Version>" will be used.
+	UserAgent string
+
+	// Headers are sent with every request, such as proxy authentication or
+	// tracing headers. They replace the client's default headers of the
+	// same name.
+	Headers http.Header
+
+	// RequestIDHeader, if set, is the name of a header (e.g., "X-Request-ID")
+	// carrying a correlation ID on every request, so that Snipe-IT server logs
+	// can be matched with client-side failures. The ID is taken from the
+	// request's context (see WithRequestID) or generated, and is the same
+	// across retries. It is available to hooks through RequestIDFromContext
+	// and in ErrorResponse.RequestID.
+	RequestIDHeader string
+
+	// KeepRawBody, if true, leaves the body of every returned *http.Response
+	// readable after it has been decoded, for callers that need the raw JSON.
+	// Error bodies are always available in ErrorResponse.Body.
+	KeepRawBody bool
+
+	// StrictDecoding, if true, makes requests fail with an
+	// *UnknownFieldsError when a response contains fields that the model
+	// it is decoded into lacks, naming the fields and the endpoint. Use it
+	// in tests and CI to catch changes in new Snipe-IT releases; by
+	// default, unknown fields are ignored.
+	StrictDecoding bool
+
+	// CaptureRawJSON, if true, sets the Raw field of every decoded model
+	// (assets, users, and the models nested in them) to the JSON object it
+	// was decoded from, so that fields the models lack can be read without
+	// another request. It costs a second pass over each response.
+	CaptureRawJSON bool
+
+	// ServerTimezone is the time zone of the Snipe-IT server (its
+	// APP_TIMEZONE setting). Times the API sends without a zone, including
+	// date-only values such as purchase dates, are interpreted in it, so
+	// that a date decodes as midnight on that day in the server's zone
+	// instead of in UTC, where it can fall on the previous day once
+	// converted. If nil, UTC is used.
+	ServerTimezone *time.Location
+
+	// ValidateRequests, if true, calls the Validate method of request
+	// bodies that have one, such as AssetCreateRequest and CheckoutOptions,
+	// before sending them, so that requests Snipe-IT would reject fail
+	// with ValidationErrors without a round trip.
+	ValidateRequests bool
+
+	// OfflineQueue, if set, stores POST, PUT, PATCH and DELETE requests that
+	// cannot reach the server, returning an error wrapping ErrQueued, and
+	// replays them in order before the next mutating request or when
+	// Client.ReplayQueue is called. Use NewFileQueueStore to keep queued
+	// requests across restarts. Requests are queued only when no response
+	// was received, so API errors are still returned directly. The errors of
+	// queued requests the API rejects on replay, which wrap
+	// ErrReplayRejected, are joined with the result of the request that
+	// replayed them.
+	OfflineQueue QueueStore
+
+	// OnRequest, if set, is called before each attempt of a request is sent,
+	// including retries.
+	OnRequest func(req *http.Request)
+
+	// OnResponse, if set, is called after each attempt of a request with its
+	// response, which is nil if the request failed to reach the server, its
+	// error, and how long it took.
+	OnResponse func(req *http.Request, resp *http.Response, err error, elapsed time.Duration)
+
+	// OnRetry, if set, is called before waiting to retry a request. attempt
+	// is the number of the upcoming retry, starting at 1, resp and err are
+	// the outcome of the failed attempt, and wait is how long the client will
+	// wait before retrying, including any Retry-After delay.
+	OnRetry func(attempt int, req *http.Request, resp *http.Response, err error, wait time.Duration)
+
+	// Debug, if true, dumps every request and response, including headers
+	// and bodies, to DumpWriter. The API token and cookies are redacted.
+	Debug bool
+
+	// DumpWriter receives the wire dumps of debugged requests.
+	// If nil, os.Stderr will be used.
+	DumpWriter io.Writer
 }
 
 // RequestOptions contains options for individual API requests.
@@ -159,4 +492,26 @@ type RequestOptions struct {
 	// DisableRetries, if true, disables automatic retries for this request,
 	// regardless of the client's retry configuration.
 	DisableRetries bool
+
+	// AllowRetry, if true, allows this request to be retried even if its
+	// method is not in RetryPolicy.RetryableMethods. Use it for requests
+	// known to be safe to repeat. DisableRetries takes precedence.
+	AllowRetry bool
+
+	// Debug, if true, dumps this request and its response to the client's
+	// DumpWriter, regardless of the client's Debug setting.
+	Debug bool
+
+	// Headers are added to this request only. They replace headers of the
+	// same name, including those set by ClientOptions.Headers.
+	Headers http.Header
+
+	// KeepRawBody, if true, leaves the body of the returned *http.Response
+	// readable after it has been decoded, regardless of the client's
+	// KeepRawBody setting.
+	KeepRawBody bool
+
+	// skipQueue, if true, sends the request directly even if the client
+	// has an offline queue
+	skipQueue bool
 }
\ No newline at end of file
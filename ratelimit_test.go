@@ -2,9 +2,12 @@ package snipeit
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -58,6 +61,168 @@ func TestTokenBucketRateLimiterCancellation(t *testing.T) {
 	}
 }
 
+func TestTokenBucketRateLimiterConcurrentWaiters(t *testing.T) {
+	limiter := NewTokenBucketRateLimiter(0.1, 1)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait returned error: %v", err)
+	}
+
+	// A long wait must not hold up other callers
+	slowCtx, cancelSlow := context.WithCancel(context.Background())
+	defer cancelSlow()
+	go limiter.Wait(slowCtx)
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := limiter.Wait(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Wait returned error %v, expected %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Wait returned after %v, expected it to honor the 50ms deadline", elapsed)
+	}
+}
+
+func TestTokenBucketRateLimiterReserveN(t *testing.T) {
+	limiter := NewTokenBucketRateLimiter(10, 5)
+
+	if delay := limiter.ReserveN(5).Delay(); delay != 0 {
+		t.Errorf("ReserveN(5) Delay = %v, expected 0 within the burst", delay)
+	}
+
+	reservation := limiter.ReserveN(2)
+	if delay := reservation.Delay(); delay < 150*time.Millisecond || delay > 200*time.Millisecond {
+		t.Errorf("ReserveN(2) Delay = %v, expected about 200ms", delay)
+	}
+	reservation.Cancel()
+
+	if delay := limiter.ReserveN(1).Delay(); delay < 50*time.Millisecond || delay > 100*time.Millisecond {
+		t.Errorf("ReserveN(1) after Cancel Delay = %v, expected about 100ms", delay)
+	}
+}
+
+func TestRateLimiterFunc(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 0, "rows": []}`)
+	})
+
+	calls := 0
+	limiter := RateLimiterFunc(func(ctx context.Context) error {
+		calls++
+		return nil
+	})
+
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{RateLimiter: limiter})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if _, _, err := client.Assets.List(nil); err != nil {
+		t.Fatalf("Assets.List returned error: %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("RateLimiterFunc was called %d times, expected %d", calls, 1)
+	}
+}
+
+type waitNRecorder struct {
+	tokens []int
+}
+
+func (r *waitNRecorder) WaitN(ctx context.Context, n int) error {
+	r.tokens = append(r.tokens, n)
+	return nil
+}
+
+func TestNewWaitNRateLimiter(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 0, "rows": []}`)
+	})
+
+	recorder := &waitNRecorder{}
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{RateLimiter: NewWaitNRateLimiter(recorder, 3)})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if _, _, err := client.Assets.List(nil); err != nil {
+		t.Fatalf("Assets.List returned error: %v", err)
+	}
+
+	if len(recorder.tokens) != 1 || recorder.tokens[0] != 3 {
+		t.Errorf("WaitN was called with %v, expected [3]", recorder.tokens)
+	}
+
+	// A non-positive cost takes one token per request
+	recorder.tokens = nil
+	if err := NewWaitNRateLimiter(recorder, 0).Wait(context.Background()); err != nil {
+		t.Fatalf("Wait returned error: %v", err)
+	}
+	if len(recorder.tokens) != 1 || recorder.tokens[0] != 1 {
+		t.Errorf("WaitN was called with %v, expected [1]", recorder.tokens)
+	}
+
+	// The token bucket limiter can be shared the same way
+	bucket := NewTokenBucketRateLimiter(1, 2)
+	if err := NewWaitNRateLimiter(bucket, 2).Wait(context.Background()); err != nil {
+		t.Fatalf("Wait returned error: %v", err)
+	}
+	if delay := bucket.ReserveN(1).Delay(); delay == 0 {
+		t.Error("TokenBucketRateLimiter had tokens left, expected the request to take both")
+	}
+}
+
+func TestClientRetryRateLimited(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, `{"status":"success"}`)
+	}))
+	defer server.Close()
+
+	waits := 0
+	limiter := RateLimiterFunc(func(ctx context.Context) error {
+		waits++
+		return nil
+	})
+
+	retryPolicy := DefaultRetryPolicy()
+	retryPolicy.InitialBackoff = time.Millisecond
+	retryPolicy.MaxBackoff = time.Millisecond
+
+	client, err := NewClientWithOptions(server.URL, "test-token", &ClientOptions{RateLimiter: limiter, RetryPolicy: retryPolicy})
+	if err != nil {
+		t.Fatalf("Error creating client: %v", err)
+	}
+
+	req, err := client.newRequest("GET", "/test", nil)
+	if err != nil {
+		t.Fatalf("Error creating request: %v", err)
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("Expected success after retries, got error: %v", err)
+	}
+
+	if waits != attempts {
+		t.Errorf("RateLimiter waited %d times for %d attempts, expected one wait per attempt", waits, attempts)
+	}
+}
+
 func TestClientRetry(t *testing.T) {
 	// Number of server request attempts
 	attempts := 0
@@ -223,4 +388,285 @@ func TestClientRetryAfterHeader(t *testing.T) {
 	if attempts != maxAttempts {
 		t.Errorf("Expected %d attempts, got %d", maxAttempts, attempts)
 	}
-}
\ No newline at end of file
+}
+func TestClientRetryMaxElapsedTime(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		// Ask for a wait far beyond the retry budget
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintln(w, `{"status":"error","message":"Service unavailable"}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithOptions(server.URL, "test-token", &ClientOptions{
+		RetryPolicy: &RetryPolicy{
+			MaxRetries: 5,
+			RetryableStatusCodes: map[int]bool{
+				http.StatusServiceUnavailable: true,
+			},
+			InitialBackoff:    10 * time.Millisecond,
+			MaxBackoff:        time.Second,
+			BackoffMultiplier: 2.0,
+			MaxElapsedTime:    time.Second,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating client: %v", err)
+	}
+
+	req, err := client.newRequest("GET", "/test", nil)
+	if err != nil {
+		t.Fatalf("Error creating request: %v", err)
+	}
+
+	start := time.Now()
+	_, err = client.Do(req, nil)
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Do returned after %v, expected it to give up without waiting", elapsed)
+	}
+
+	if !errors.Is(err, ErrRetryBudgetExceeded) {
+		t.Errorf("Do returned error %v, expected it to wrap %v", err, ErrRetryBudgetExceeded)
+	}
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Response.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Do returned error %v, expected it to wrap the 503 ErrorResponse", err)
+	}
+
+	if attempts != 1 {
+		t.Errorf("Expected %d attempts, got %d", 1, attempts)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"5", 5 * time.Second, true},
+		{" 5 ", 5 * time.Second, true},
+		{"1.5", 1500 * time.Millisecond, true},
+		{"0", 0, true},
+		{"Fri, 01 Mar 2024 12:00:30 GMT", 30 * time.Second, true},
+		{"Friday, 01-Mar-24 12:00:30 GMT", 30 * time.Second, true},
+		{"Fri, 01 Mar 2024 11:59:00 GMT", 0, true},
+		{"", 0, false},
+		{"-1", 0, false},
+		{"NaN", 0, false},
+		{"1e300", 0, false},
+		{"soon", 0, false},
+		{"5s", 0, false},
+	}
+
+	for _, tt := range tests {
+		delay, ok := parseRetryAfter(tt.value, now)
+		if delay != tt.expected || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q) = %v, %v, expected %v, %v", tt.value, delay, ok, tt.expected, tt.ok)
+		}
+	}
+}
+
+func TestShouldRetryMaxRetryAfter(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	policy := DefaultRetryPolicy()
+	policy.MaxRetryAfter = 10 * time.Second
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3600")
+
+	retry, wait := client.shouldRetry(resp, &ErrorResponse{Response: resp}, policy)
+	if !retry || wait != 10*time.Second {
+		t.Errorf("shouldRetry = %v, %v, expected true, %v", retry, wait, 10*time.Second)
+	}
+}
+
+func TestClientRetryableMethods(t *testing.T) {
+	tests := []struct {
+		name     string
+		methods  map[string]bool
+		opts     *RequestOptions
+		attempts int
+	}{
+		{"POST not retried by default", nil, nil, 1},
+		{"POST retried with AllowRetry", nil, &RequestOptions{AllowRetry: true}, 3},
+		{"POST retried when listed", map[string]bool{http.MethodPost: true}, nil, 3},
+		{"DisableRetries wins over AllowRetry", nil, &RequestOptions{AllowRetry: true, DisableRetries: true}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			var keys []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				keys = append(keys, r.Header.Get("Idempotency-Key"))
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprintln(w, `{"status":"error","message":"Server error"}`)
+			}))
+			defer server.Close()
+
+			client, err := NewClientWithOptions(server.URL, "test-token", &ClientOptions{
+				RetryPolicy: &RetryPolicy{
+					MaxRetries: 2,
+					RetryableStatusCodes: map[int]bool{
+						http.StatusInternalServerError: true,
+					},
+					InitialBackoff:       time.Millisecond,
+					MaxBackoff:           time.Millisecond,
+					BackoffMultiplier:    1.0,
+					RetryableMethods:     tt.methods,
+					IdempotencyKeyHeader: "Idempotency-Key",
+				},
+			})
+			if err != nil {
+				t.Fatalf("Error creating client: %v", err)
+			}
+
+			req, err := client.newRequest("POST", "api/v1/hardware", map[string]interface{}{"name": "Laptop"})
+			if err != nil {
+				t.Fatalf("Error creating request: %v", err)
+			}
+
+			if _, err := client.DoWithOptions(req, nil, tt.opts); err == nil {
+				t.Fatal("Expected an error, got nil")
+			}
+
+			if attempts != tt.attempts {
+				t.Errorf("Expected %d attempts, got %d", tt.attempts, attempts)
+			}
+
+			for _, key := range keys {
+				if key == "" || key != keys[0] {
+					t.Errorf("Idempotency-Key headers = %q, expected the same non-empty key on every attempt", keys)
+					break
+				}
+			}
+
+			if req.Header.Get("Idempotency-Key") != "" {
+				t.Errorf("DoWithOptions modified the request headers")
+			}
+		})
+	}
+}
+
+func TestClientRetryIf(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			fmt.Fprintln(w, `{"status":"error","messages":"SQLSTATE[40001]: Deadlock found when trying to get lock"}`)
+			return
+		}
+		fmt.Fprintln(w, `{"status":"success","messages":"OK"}`)
+	}))
+	defer server.Close()
+
+	retryIf := func(resp *http.Response, err error) bool {
+		var errorResponse *ErrorResponse
+		return errors.As(err, &errorResponse) && strings.Contains(errorResponse.Message, "Deadlock")
+	}
+
+	client, err := NewClientWithOptions(server.URL, "test-token", &ClientOptions{
+		RetryPolicy: &RetryPolicy{
+			MaxRetries:        3,
+			InitialBackoff:    time.Millisecond,
+			MaxBackoff:        time.Millisecond,
+			BackoffMultiplier: 1.0,
+			RetryIf:           retryIf,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating client: %v", err)
+	}
+
+	req, err := client.newRequest("GET", "/test", nil)
+	if err != nil {
+		t.Fatalf("Error creating request: %v", err)
+	}
+
+	var response Response
+	if _, err := client.Do(req, &response); err != nil {
+		t.Fatalf("Expected success after retries, got error: %v", err)
+	}
+
+	if response.Status != "success" {
+		t.Errorf("Expected success status, got %s", response.Status)
+	}
+
+	if attempts != 3 {
+		t.Errorf("Expected %d attempts, got %d", 3, attempts)
+	}
+}
+
+func TestClientHooks(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0.01")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintln(w, `{"status":"error","message":"Service unavailable"}`)
+			return
+		}
+		fmt.Fprintln(w, `{"status":"success","messages":"OK"}`)
+	}))
+	defer server.Close()
+
+	var requests, retries int
+	var statuses []int
+	var wait time.Duration
+	client, err := NewClientWithOptions(server.URL, "test-token", &ClientOptions{
+		RetryPolicy: &RetryPolicy{
+			MaxRetries: 1,
+			RetryableStatusCodes: map[int]bool{
+				http.StatusServiceUnavailable: true,
+			},
+			InitialBackoff: time.Second,
+		},
+		OnRequest: func(req *http.Request) {
+			requests++
+		},
+		OnResponse: func(req *http.Request, resp *http.Response, err error, elapsed time.Duration) {
+			statuses = append(statuses, resp.StatusCode)
+		},
+		OnRetry: func(attempt int, req *http.Request, resp *http.Response, err error, w time.Duration) {
+			retries++
+			wait = w
+			if attempt != 1 || resp.StatusCode != http.StatusServiceUnavailable || err == nil {
+				t.Errorf("OnRetry called with attempt %d, status %d, error %v", attempt, resp.StatusCode, err)
+			}
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating client: %v", err)
+	}
+
+	req, err := client.newRequest("GET", "/test", nil)
+	if err != nil {
+		t.Fatalf("Error creating request: %v", err)
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("Expected success after retries, got error: %v", err)
+	}
+
+	if requests != 2 || retries != 1 {
+		t.Errorf("OnRequest called %d times and OnRetry %d times, expected 2 and 1", requests, retries)
+	}
+
+	if !reflect.DeepEqual(statuses, []int{http.StatusServiceUnavailable, http.StatusOK}) {
+		t.Errorf("OnResponse saw statuses %v, expected %v", statuses, []int{http.StatusServiceUnavailable, http.StatusOK})
+	}
+
+	if wait != 10*time.Millisecond {
+		t.Errorf("OnRetry wait = %v, expected the Retry-After delay of %v", wait, 10*time.Millisecond)
+	}
+}
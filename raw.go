@@ -0,0 +1,107 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// commonFieldsType is the type of CommonFields, embedded by the models.
+var commonFieldsType = reflect.TypeOf(CommonFields{})
+
+// captureRawJSON sets the Raw field of every model in v, the destination
+// data was decoded into, to the JSON object the model was decoded from.
+// It follows the same fields as the decoder, so nested models such as an
+// asset's model and assigned user get their own objects.
+func captureRawJSON(data []byte, v interface{}) {
+	if v == nil {
+		return
+	}
+	walkJSON(bytes.TrimSpace(data), reflect.ValueOf(v), captureRaw)
+}
+
+// captureRaw sets the Raw field of v, if it is a model, from data.
+func captureRaw(data []byte, v reflect.Value) {
+	if v.Kind() != reflect.Struct || data[0] != '{' {
+		return
+	}
+	if common, ok := v.Type().FieldByName("CommonFields"); ok && common.Anonymous && common.Type == commonFieldsType {
+		if raw := v.FieldByIndex(common.Index).FieldByName("Raw"); raw.CanSet() {
+			raw.SetBytes(append(json.RawMessage(nil), data...))
+		}
+	}
+}
+
+// walkJSON calls visit with every value in v, the destination data was
+// decoded into, and the JSON it was decoded from. It follows the same
+// fields as the decoder, into envelopes and the entity of an AssignedTo,
+// but not into other types that decode themselves.
+func walkJSON(data []byte, v reflect.Value, visit func(data []byte, v reflect.Value)) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if len(data) == 0 {
+		return
+	}
+	visit(data, v)
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if data[0] != '{' {
+			return
+		}
+		if assigned, ok := v.Addr().Interface().(*AssignedTo); ok {
+			walkJSON(data, reflect.ValueOf(assigned.entity()), visit)
+			return
+		}
+		t := v.Type()
+		if reflect.PointerTo(t).Implements(unmarshalerType) && !isEnvelope(t) {
+			return
+		}
+
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(data, &object); err != nil {
+			return
+		}
+		known := jsonFields(t)
+
+		// Walk the payload of envelopes last, so that it takes precedence
+		// over the top-level object
+		keys := make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, key)
+		}
+		for i, key := range keys {
+			if strings.EqualFold(key, "payload") {
+				keys[i], keys[len(keys)-1] = keys[len(keys)-1], keys[i]
+				break
+			}
+		}
+
+		for _, key := range keys {
+			field, ok := known[strings.ToLower(key)]
+			if !ok {
+				continue
+			}
+			if fv, err := v.FieldByIndexErr(field.Index); err == nil {
+				walkJSON(bytes.TrimSpace(object[key]), fv, visit)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if data[0] != '[' {
+			return
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return
+		}
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			walkJSON(bytes.TrimSpace(items[i]), v.Index(i), visit)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// rawField decodes the field name of the JSON object raw.
+func rawField(t *testing.T, raw json.RawMessage, name string) interface{} {
+	t.Helper()
+
+	var object map[string]interface{}
+	if err := json.Unmarshal(raw, &object); err != nil {
+		t.Fatalf("Raw %q is not a JSON object: %v", raw, err)
+	}
+	return object[name]
+}
+
+func TestCaptureRawJSON(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 2, "rows": [
+			{"id": 1, "byod": true, "model": {"id": 7, "requestable_count": 4}, "assigned_to": {"id": 3, "employee_num": "E-3"}},
+			{"id": 2, "byod": false}
+		]}`)
+	})
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": "success", "messages": "ok", "payload": {"id": 1, "byod": true}}`)
+	})
+
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{CaptureRawJSON: true})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	assets, _, err := client.Assets.List(nil)
+	if err != nil {
+		t.Fatalf("Assets.List returned error: %v", err)
+	}
+	if byod := rawField(t, assets.Rows[0].Raw, "byod"); byod != true {
+		t.Errorf("Rows[0].Raw byod = %v, expected true", byod)
+	}
+	if byod := rawField(t, assets.Rows[1].Raw, "byod"); byod != false {
+		t.Errorf("Rows[1].Raw byod = %v, expected false", byod)
+	}
+	if count := rawField(t, assets.Rows[0].Model.Raw, "requestable_count"); count != 4.0 {
+		t.Errorf("Model.Raw requestable_count = %v, expected %d", count, 4)
+	}
+	if num := rawField(t, assets.Rows[0].AssignedTo.User().Raw, "employee_num"); num != "E-3" {
+		t.Errorf("User.Raw employee_num = %v, expected %q", num, "E-3")
+	}
+
+	// The payload of single-item responses is captured, not the envelope
+	asset, _, err := client.Assets.Patch(1, map[string]interface{}{"name": "x"})
+	if err != nil {
+		t.Fatalf("Assets.Patch returned error: %v", err)
+	}
+	if status := rawField(t, asset.Raw, "status"); status != nil {
+		t.Errorf("Asset.Raw = %s, expected the payload", asset.Raw)
+	}
+	if byod := rawField(t, asset.Raw, "byod"); byod != true {
+		t.Errorf("Asset.Raw byod = %v, expected true", byod)
+	}
+
+	// Streamed rows are captured as they are decoded
+	var streamed []Asset
+	client.Assets.Stream(nil, func(asset Asset) error {
+		streamed = append(streamed, asset)
+		return nil
+	})
+	if len(streamed) != 2 || rawField(t, streamed[0].Raw, "byod") != true {
+		t.Errorf("Assets.Stream did not capture raw JSON: %+v", streamed)
+	}
+}
+
+func TestCaptureRawJSONDisabled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "byod": true}`)
+	})
+
+	asset, _, err := client.Assets.Get(1)
+	if err != nil {
+		t.Fatalf("Assets.Get returned error: %v", err)
+	}
+	if asset.Raw != nil {
+		t.Errorf("Asset.Raw = %s, expected nil", asset.Raw)
+	}
+}
@@ -0,0 +1,161 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sort"
+	"strings"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// GroupBy is a property assets are grouped by when counting them.
+type GroupBy string
+
+const (
+	// ByStatusLabel groups assets by status label.
+	ByStatusLabel GroupBy = "status label"
+
+	// ByModel groups assets by model.
+	ByModel GroupBy = "model"
+
+	// ByCategory groups assets by category.
+	ByCategory GroupBy = "category"
+
+	// ByLocation groups assets by location.
+	ByLocation GroupBy = "location"
+
+	// ByCompany groups assets by company.
+	ByCompany GroupBy = "company"
+)
+
+// allGroups are the groups assets are counted by when Counts is given none.
+var allGroups = []GroupBy{ByStatusLabel, ByModel, ByCategory, ByLocation, ByCompany}
+
+// Count is the number of assets sharing a status label, model, category,
+// location or company.
+type Count struct {
+	// ID is the unique identifier of the status label, model, category,
+	// location or company, or 0 for the assets without one
+	ID int
+
+	// Name is the name of the status label, model, category, location or
+	// company, or "" for the assets without one
+	Name string
+
+	// Assets is the number of assets
+	Assets int
+}
+
+// CountReport is the number of assets in each group.
+type CountReport struct {
+	// Total is the number of assets
+	Total int
+
+	// Groups holds the counts of each group asked for, sorted by number of
+	// assets, largest first, then by name, with the assets without a value
+	// last
+	Groups map[GroupBy][]Count
+}
+
+// By returns the counts of group, or nil if the report was not asked for
+// group.
+func (r *CountReport) By(group GroupBy) []Count {
+	return r.Groups[group]
+}
+
+// Counts returns the number of assets grouped by each of groups, or by
+// every group if none is given.
+//
+// Assets are not listed: Counts reads the number of assets Snipe-IT reports
+// for each status label, model, category, location and company, so that it
+// makes as many requests as there are pages of those, plus one for the
+// total number of assets. The assets that have no value for a group, such
+// as those without a location, are counted in a Count with ID 0. As in
+// Snipe-IT, assets count towards the location they are at, not towards the
+// parents of that location.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-list
+func Counts(ctx context.Context, client *snipeit.Client, groups ...GroupBy) (*CountReport, error) {
+	if len(groups) == 0 {
+		groups = allGroups
+	}
+
+	assets, _, err := client.Assets.ListContext(ctx, &snipeit.ListOptions{Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("reports: counting assets: %w", err)
+	}
+
+	report := &CountReport{Total: assets.Total, Groups: make(map[GroupBy][]Count, len(groups))}
+	for _, group := range groups {
+		if _, ok := report.Groups[group]; ok {
+			continue
+		}
+		counts, err := countBy(ctx, client, group)
+		if err != nil {
+			return nil, err
+		}
+
+		counted := 0
+		for _, count := range counts {
+			counted += count.Assets
+		}
+		sort.SliceStable(counts, func(i, j int) bool {
+			a, b := counts[i], counts[j]
+			if a.Assets != b.Assets {
+				return a.Assets > b.Assets
+			}
+			return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+		})
+		if rest := report.Total - counted; rest > 0 {
+			counts = append(counts, Count{Assets: rest})
+		}
+		report.Groups[group] = counts
+	}
+	return report, nil
+}
+
+// countBy returns the number of assets of each status label, model,
+// category, location or company, as group asks.
+func countBy(ctx context.Context, client *snipeit.Client, group GroupBy) ([]Count, error) {
+	switch group {
+	case ByStatusLabel:
+		return collectCounts(client.StatusLabels.IterateContext(ctx, nil), "status labels", func(label snipeit.StatusLabel) Count {
+			return Count{ID: label.ID, Name: label.Name, Assets: label.AssetsCount}
+		})
+	case ByModel:
+		return collectCounts(client.Models.IterateContext(ctx, nil), "models", func(model snipeit.Model) Count {
+			return Count{ID: model.ID, Name: model.Name, Assets: model.AssetsCount}
+		})
+	case ByCategory:
+		// Accessory, consumable, component and license categories hold no
+		// assets
+		opts := &snipeit.CategoryListOptions{CategoryType: snipeit.CategoryTypeAsset}
+		return collectCounts(client.Categories.IterateContext(ctx, opts), "categories", func(category snipeit.Category) Count {
+			return Count{ID: category.ID, Name: category.Name, Assets: category.AssetsCount}
+		})
+	case ByLocation:
+		return collectCounts(client.Locations.IterateContext(ctx, nil), "locations", func(location snipeit.Location) Count {
+			return Count{ID: location.ID, Name: location.Name, Assets: location.AssetsCount}
+		})
+	case ByCompany:
+		return collectCounts(client.Companies.IterateContext(ctx, nil), "companies", func(company snipeit.Company) Count {
+			return Count{ID: company.ID, Name: company.Name, Assets: company.AssetsCount}
+		})
+	}
+	return nil, fmt.Errorf("reports: cannot count assets by %q", group)
+}
+
+// collectCounts returns the count of each item of seq. what names the items
+// in errors.
+func collectCounts[T any](seq iter.Seq2[T, error], what string, count func(T) Count) ([]Count, error) {
+	var counts []Count
+	for item, err := range seq {
+		if err != nil {
+			return nil, fmt.Errorf("reports: listing %s: %w", what, err)
+		}
+		counts = append(counts, count(item))
+	}
+	return counts, nil
+}
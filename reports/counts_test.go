@@ -0,0 +1,61 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCounts(t *testing.T) {
+	client, mux := setup(t)
+	serveRows(mux, "/api/v1/hardware",
+		`{"id": 1}`, `{"id": 2}`, `{"id": 3}`, `{"id": 4}`, `{"id": 5}`, `{"id": 6}`,
+	)
+	serveRows(mux, "/api/v1/statuslabels",
+		`{"id": 1, "name": "Ready to Deploy", "assets_count": 2}`,
+		`{"id": 2, "name": "Deployed", "assets_count": 4}`,
+		`{"id": 3, "name": "Archived", "assets_count": 0}`,
+	)
+	serveRows(mux, "/api/v1/locations",
+		`{"id": 1, "name": "Warehouse", "assets_count": 1}`,
+		`{"id": 2, "name": "headquarters", "assets_count": 3}`,
+		`{"id": 3, "name": "Annex", "assets_count": 1}`,
+	)
+	mux.HandleFunc("/api/v1/categories", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("category_type"); got != "asset" {
+			t.Errorf("Request category_type = %q, expected %q", got, "asset")
+		}
+		fmt.Fprint(w, `{"total": 1, "rows": [{"id": 1, "name": "Laptops", "assets_count": 6}]}`)
+	})
+
+	report, err := Counts(context.Background(), client, ByLocation, ByStatusLabel, ByLocation)
+	if err != nil {
+		t.Fatalf("Counts returned error: %v", err)
+	}
+
+	if report.Total != 6 || len(report.Groups) != 2 {
+		t.Errorf("Counts returned %d assets in %d groups, expected 6 in 2", report.Total, len(report.Groups))
+	}
+	if got, want := fmt.Sprint(report.By(ByLocation)), "[{2 headquarters 3} {3 Annex 1} {1 Warehouse 1} {0  1}]"; got != want {
+		t.Errorf("Counts returned locations %s, expected %s", got, want)
+	}
+	if got, want := fmt.Sprint(report.By(ByStatusLabel)), "[{2 Deployed 4} {1 Ready to Deploy 2} {3 Archived 0}]"; got != want {
+		t.Errorf("Counts returned status labels %s, expected %s", got, want)
+	}
+	if report.By(ByCompany) != nil {
+		t.Errorf("Counts returned companies %v, expected none", report.By(ByCompany))
+	}
+
+	report, err = Counts(context.Background(), client, ByCategory)
+	if err != nil {
+		t.Fatalf("Counts returned error: %v", err)
+	}
+	if got, want := fmt.Sprint(report.By(ByCategory)), "[{1 Laptops 6}]"; got != want {
+		t.Errorf("Counts returned categories %s, expected %s", got, want)
+	}
+
+	if _, err := Counts(context.Background(), client, "supplier"); err == nil {
+		t.Error("Counts returned no error for an unknown group")
+	}
+}
@@ -0,0 +1,139 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// BookValue is the book value of an asset at a given date.
+type BookValue struct {
+	// Asset is the asset, as listed by the API
+	Asset snipeit.Asset
+
+	// Depreciation is the depreciation of the asset's model, or nil if the
+	// model does not depreciate
+	Depreciation *snipeit.Depreciation
+
+	// PurchaseDate is the date the asset was purchased
+	PurchaseDate time.Time
+
+	// PurchaseCost is the purchase cost of the asset
+	PurchaseCost snipeit.Money
+
+	// MonthsElapsed is the number of whole months from PurchaseDate to the
+	// date of the report
+	MonthsElapsed int
+
+	// MinimumValue is the value below which the asset does not depreciate
+	MinimumValue snipeit.Money
+
+	// Depreciated is the value the asset has lost since its purchase
+	Depreciated snipeit.Money
+
+	// Value is the book value of the asset: PurchaseCost less Depreciated
+	Value snipeit.Money
+
+	// FullyDepreciated reports whether the asset is down to MinimumValue
+	FullyDepreciated bool
+}
+
+// BookValues returns the book value of every asset at asOf, such as the
+// end of a fiscal period.
+//
+// Assets depreciate according to the depreciation of their model, in a
+// straight line from their purchase cost down to the minimum value of the
+// depreciation, losing an equal share of value at each month elapsed since
+// their purchase date, as Snipe-IT computes it. Assets whose model has no
+// depreciation keep their purchase cost. Assets without a purchase cost or
+// purchase date are skipped, as their book value is unknown.
+//
+// Snipe-IT reports the book value of each asset at the time of the
+// request; BookValues computes it at any date. The rows are in the order
+// the API lists the assets.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/depreciations
+func BookValues(ctx context.Context, client *snipeit.Client, asOf time.Time) ([]BookValue, error) {
+	depreciations := make(map[int]*snipeit.Depreciation)
+	for depreciation, err := range client.Depreciations.IterateContext(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("reports: listing depreciations: %w", err)
+		}
+		depreciations[depreciation.ID] = &depreciation
+	}
+
+	// Assets only report the ID and name of their model
+	schedules := make(map[int]*snipeit.Depreciation)
+	for model, err := range client.Models.IterateContext(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("reports: listing models: %w", err)
+		}
+		if model.Depreciation != nil {
+			schedules[model.ID] = depreciations[model.Depreciation.ID]
+		}
+	}
+
+	var values []BookValue
+	for asset, err := range client.Assets.IterateContext(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("reports: listing assets: %w", err)
+		}
+		if asset.PurchaseCost == nil || asset.PurchaseDate == nil || asset.PurchaseDate.IsZero() {
+			continue
+		}
+		values = append(values, bookValue(asset, schedules[asset.Model.ID], asOf))
+	}
+	return values, nil
+}
+
+// bookValue returns the book value of asset at asOf, depreciating
+// according to depreciation, which may be nil.
+func bookValue(asset snipeit.Asset, depreciation *snipeit.Depreciation, asOf time.Time) BookValue {
+	value := BookValue{
+		Asset:         asset,
+		Depreciation:  depreciation,
+		PurchaseDate:  asset.PurchaseDate.Time,
+		PurchaseCost:  *asset.PurchaseCost,
+		MonthsElapsed: monthsBetween(asset.PurchaseDate.Time, asOf),
+		Value:         *asset.PurchaseCost,
+	}
+	value.MinimumValue = value.PurchaseCost
+	value.MinimumValue.Cents = 0
+	value.Depreciated = value.MinimumValue
+	if depreciation == nil {
+		return value
+	}
+
+	cost := value.PurchaseCost.Cents
+	minimum := int64(math.Round(depreciation.DepreciationMin * 100))
+	if depreciation.DepreciationType == snipeit.DepreciationTypePercent {
+		minimum = int64(math.Round(float64(cost) * depreciation.DepreciationMin / 100))
+	}
+	minimum = max(0, min(minimum, cost))
+	value.MinimumValue.Cents = minimum
+
+	remaining := max(0, depreciation.Months-value.MonthsElapsed)
+	if depreciation.Months > 0 {
+		value.Value = value.MinimumValue.Add(prorate(value.PurchaseCost.Sub(value.MinimumValue), int64(remaining), int64(depreciation.Months)))
+	} else {
+		value.Value = value.MinimumValue
+	}
+	value.Depreciated = value.PurchaseCost.Sub(value.Value)
+	value.FullyDepreciated = remaining == 0
+	return value
+}
+
+// monthsBetween returns the number of whole months from the date of from
+// to the date of to, or 0 if to is before from.
+func monthsBetween(from, to time.Time) int {
+	fy, fm, fd := from.Date()
+	ty, tm, td := to.Date()
+	months := (ty-fy)*12 + int(tm-fm)
+	if td < fd {
+		months--
+	}
+	return max(0, months)
+}
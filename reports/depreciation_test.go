@@ -0,0 +1,53 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBookValues(t *testing.T) {
+	client, mux := setup(t)
+	serveRows(mux, "/api/v1/depreciations",
+		`{"id": 1, "name": "Computers", "months": "36 months", "depreciation_min": "100.00"}`,
+		`{"id": 2, "name": "Furniture", "months": "10 months", "depreciation_min": 10, "depreciation_type": "percent"}`,
+	)
+	serveRows(mux, "/api/v1/models",
+		`{"id": 10, "name": "Latitude", "depreciation": {"id": 1, "name": "Computers"}}`,
+		`{"id": 11, "name": "Desk", "depreciation": {"id": 2, "name": "Furniture"}}`,
+		`{"id": 12, "name": "Monitor", "depreciation": null}`,
+	)
+	serveRows(mux, "/api/v1/hardware",
+		`{"id": 1, "asset_tag": "A-1", "model": {"id": 10}, "purchase_cost": "1,300.00", "purchase_date": {"date": "2024-01-20"}}`,
+		`{"id": 2, "asset_tag": "A-2", "model": {"id": 11}, "purchase_cost": 500, "purchase_date": {"date": "2023-01-01"}}`,
+		`{"id": 3, "asset_tag": "A-3", "model": {"id": 12}, "purchase_cost": "200.00", "purchase_date": {"date": "2024-06-01"}}`,
+		`{"id": 4, "asset_tag": "A-4", "model": {"id": 10}, "purchase_date": {"date": "2024-06-01"}}`,
+		`{"id": 5, "asset_tag": "A-5", "model": {"id": 10}, "purchase_cost": "1000.00", "purchase_date": {"date": "2025-03-01"}}`,
+	)
+
+	asOf := time.Date(2025, 1, 15, 17, 0, 0, 0, time.UTC)
+	values, err := BookValues(context.Background(), client, asOf)
+	if err != nil {
+		t.Fatalf("BookValues returned error: %v", err)
+	}
+
+	var got []string
+	for _, value := range values {
+		got = append(got, fmt.Sprintf("%s %d months: %v - %v = %v (min %v, fully %t)",
+			value.Asset.AssetTag, value.MonthsElapsed, value.PurchaseCost, value.Depreciated, value.Value, value.MinimumValue, value.FullyDepreciated))
+	}
+	want := []string{
+		"A-1 11 months: 1300.00 - 366.67 = 933.33 (min 100.00, fully false)",
+		"A-2 24 months: 500.00 - 450.00 = 50.00 (min 50.00, fully true)",
+		"A-3 7 months: 200.00 - 0.00 = 200.00 (min 0.00, fully false)",
+		"A-5 0 months: 1000.00 - 0.00 = 1000.00 (min 100.00, fully false)",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("BookValues returned\n%q\nexpected\n%q", got, want)
+	}
+
+	if values[0].Depreciation == nil || values[0].Depreciation.Name != "Computers" || values[2].Depreciation != nil {
+		t.Errorf("BookValues returned depreciations %+v and %+v, expected Computers and none", values[0].Depreciation, values[2].Depreciation)
+	}
+}
@@ -0,0 +1,196 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// Allocation is how the seats of a license compare with its assignments.
+type Allocation int
+
+const (
+	// FullyAllocated licenses have every seat assigned.
+	FullyAllocated Allocation = iota
+
+	// UnderAllocated licenses have seats that were purchased but are not
+	// assigned, which may be cut at renewal.
+	UnderAllocated
+
+	// OverAllocated licenses have more seats assigned than purchased, which
+	// breaches the terms of the license.
+	OverAllocated
+)
+
+// String returns the name of the allocation.
+func (a Allocation) String() string {
+	switch a {
+	case FullyAllocated:
+		return "fully allocated"
+	case UnderAllocated:
+		return "under-allocated"
+	case OverAllocated:
+		return "over-allocated"
+	}
+	return fmt.Sprintf("Allocation(%d)", int(a))
+}
+
+// LicenseUsage compares the seats of a license with its assignments.
+type LicenseUsage struct {
+	// License is the license, as listed by the API
+	License snipeit.License
+
+	// Seats is the number of seats purchased
+	Seats int
+
+	// Assigned is the number of seats assigned to users or assets
+	Assigned int
+
+	// Unassigned is the number of seats purchased but not assigned, which
+	// is negative for over-allocated licenses
+	Unassigned int
+
+	// Allocation compares Seats and Assigned
+	Allocation Allocation
+
+	// UnassignedCost is the share of the purchase cost of the license paid
+	// for the unassigned seats, or nil if the license has no purchase cost
+	// or no unassigned seats
+	UnassignedCost *snipeit.Money
+}
+
+// CompanyUsage totals the usage of the licenses of a company.
+type CompanyUsage struct {
+	// Company is the company, or nil for the licenses without a company
+	Company *snipeit.Company
+
+	// Seats, Assigned and Unassigned total those of Licenses
+	Seats      int
+	Assigned   int
+	Unassigned int
+
+	// Licenses lists the usage of each license of the company
+	Licenses []LicenseUsage
+}
+
+// ComplianceReport is the result of LicenseCompliance.
+type ComplianceReport struct {
+	// Licenses lists the usage of every license, sorted by name
+	Licenses []LicenseUsage
+}
+
+// LicenseCompliance compares the seats purchased for every license with
+// the seats assigned to users and assets, as tracked by Snipe-IT.
+//
+// The assigned seats are derived from the free seats count the API reports
+// with each license, so the report only needs to list the licenses, not
+// their seats.
+//
+// Use OverAllocated and UnderAllocated to find the licenses that need
+// attention, and ByCompany to break the report down by company.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/licenses
+func LicenseCompliance(ctx context.Context, client *snipeit.Client) (*ComplianceReport, error) {
+	report := &ComplianceReport{}
+	for license, err := range client.Licenses.IterateContext(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("reports: listing licenses: %w", err)
+		}
+		report.Licenses = append(report.Licenses, licenseUsage(license))
+	}
+
+	sort.SliceStable(report.Licenses, func(i, j int) bool {
+		a, b := report.Licenses[i].License, report.Licenses[j].License
+		if na, nb := strings.ToLower(a.Name), strings.ToLower(b.Name); na != nb {
+			return na < nb
+		}
+		return a.ID < b.ID
+	})
+	return report, nil
+}
+
+// licenseUsage returns the usage of license.
+func licenseUsage(license snipeit.License) LicenseUsage {
+	usage := LicenseUsage{
+		License:    license,
+		Seats:      license.Seats,
+		Assigned:   license.Seats - license.FreeSeatsCount,
+		Unassigned: license.FreeSeatsCount,
+	}
+
+	switch {
+	case usage.Unassigned < 0:
+		usage.Allocation = OverAllocated
+	case usage.Unassigned > 0:
+		usage.Allocation = UnderAllocated
+		if license.PurchaseCost != nil && license.Seats > 0 {
+			cost := prorate(*license.PurchaseCost, int64(usage.Unassigned), int64(license.Seats))
+			usage.UnassignedCost = &cost
+		}
+	}
+	return usage
+}
+
+// OverAllocated returns the licenses with more seats assigned than
+// purchased.
+func (r *ComplianceReport) OverAllocated() []LicenseUsage {
+	return r.filter(OverAllocated)
+}
+
+// UnderAllocated returns the licenses with unassigned seats.
+func (r *ComplianceReport) UnderAllocated() []LicenseUsage {
+	return r.filter(UnderAllocated)
+}
+
+// filter returns the licenses with the given allocation.
+func (r *ComplianceReport) filter(allocation Allocation) []LicenseUsage {
+	var licenses []LicenseUsage
+	for _, usage := range r.Licenses {
+		if usage.Allocation == allocation {
+			licenses = append(licenses, usage)
+		}
+	}
+	return licenses
+}
+
+// ByCompany groups the licenses by company, sorted by company name, with
+// the licenses without a company last.
+func (r *ComplianceReport) ByCompany() []CompanyUsage {
+	var companies []CompanyUsage
+	index := make(map[int]int)
+	for _, usage := range r.Licenses {
+		company := usage.License.Company
+		id := 0
+		if company != nil {
+			id = company.ID
+		}
+
+		i, ok := index[id]
+		if !ok {
+			i = len(companies)
+			index[id] = i
+			if id == 0 {
+				company = nil
+			}
+			companies = append(companies, CompanyUsage{Company: company})
+		}
+
+		group := &companies[i]
+		group.Seats += usage.Seats
+		group.Assigned += usage.Assigned
+		group.Unassigned += usage.Unassigned
+		group.Licenses = append(group.Licenses, usage)
+	}
+
+	sort.SliceStable(companies, func(i, j int) bool {
+		a, b := companies[i].Company, companies[j].Company
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+	return companies
+}
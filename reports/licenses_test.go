@@ -0,0 +1,109 @@
+package reports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+func setup(t *testing.T) (*snipeit.Client, *http.ServeMux) {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := snipeit.NewClientWithOptions(server.URL, "test-token", &snipeit.ClientOptions{DisableRetries: true})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	return client, mux
+}
+
+// serveRows serves rows, given as JSON objects, as a paginated list at
+// path.
+func serveRows(mux *http.ServeMux, path string, rows ...string) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		var page []string
+		for i := offset; i < len(rows) && len(page) < limit; i++ {
+			page = append(page, rows[i])
+		}
+		fmt.Fprintf(w, `{"total": %d, "rows": [%s]}`, len(rows), strings.Join(page, ","))
+	})
+}
+
+func TestLicenseCompliance(t *testing.T) {
+	client, mux := setup(t)
+	serveRows(mux, "/api/v1/licenses",
+		`{"id": 1, "name": "Visio", "seats": 10, "free_seats_count": 4, "purchase_cost": "1,000.00", "company": {"id": 2, "name": "Globex"}}`,
+		`{"id": 2, "name": "adobe cc", "seats": 5, "free_seats_count": -2, "company": {"id": 1, "name": "Acme"}}`,
+		`{"id": 3, "name": "Office", "seats": 20, "free_seats_count": 0, "company": {"id": 2, "name": "Globex"}}`,
+		`{"id": 4, "name": "Slack", "seats": 3, "free_seats_count": 1}`,
+	)
+
+	report, err := LicenseCompliance(context.Background(), client)
+	if err != nil {
+		t.Fatalf("LicenseCompliance returned error: %v", err)
+	}
+
+	var got []string
+	for _, usage := range report.Licenses {
+		got = append(got, fmt.Sprintf("%s %d/%d %s", usage.License.Name, usage.Assigned, usage.Seats, usage.Allocation))
+	}
+	want := []string{
+		"adobe cc 7/5 over-allocated",
+		"Office 20/20 fully allocated",
+		"Slack 2/3 under-allocated",
+		"Visio 6/10 under-allocated",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("LicenseCompliance returned %q, expected %q", got, want)
+	}
+
+	if over := report.OverAllocated(); len(over) != 1 || over[0].License.ID != 2 || over[0].Unassigned != -2 {
+		t.Errorf("OverAllocated returned %+v, expected adobe cc short of 2 seats", over)
+	}
+	under := report.UnderAllocated()
+	if len(under) != 2 || under[0].UnassignedCost != nil {
+		t.Fatalf("UnderAllocated returned %+v, expected Slack, without a cost, and Visio", under)
+	}
+	if cost := under[1].UnassignedCost; cost == nil || cost.Cents != 40000 {
+		t.Errorf("Visio has UnassignedCost %v, expected 400.00 for 4 of 10 seats", cost)
+	}
+
+	got = nil
+	for _, company := range report.ByCompany() {
+		name := "none"
+		if company.Company != nil {
+			name = company.Company.Name
+		}
+		got = append(got, fmt.Sprintf("%s %d/%d (%d licenses)", name, company.Assigned, company.Seats, len(company.Licenses)))
+	}
+	want = []string{"Acme 7/5 (1 licenses)", "Globex 26/30 (2 licenses)", "none 2/3 (1 licenses)"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("ByCompany returned %q, expected %q", got, want)
+	}
+}
+
+func TestLicenseComplianceError(t *testing.T) {
+	client, mux := setup(t)
+	mux.HandleFunc("/api/v1/licenses", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"status": "error", "messages": "Server Error"}`))
+	})
+
+	_, err := LicenseCompliance(context.Background(), client)
+	var errResp *snipeit.ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("LicenseCompliance returned %v, expected the API error", err)
+	}
+}
@@ -0,0 +1,30 @@
+// Package reports computes reports over a Snipe-IT inventory that the
+// server does not provide, such as license compliance, low stock, asset
+// counts, the book value of assets and expiring warranties.
+//
+// Each report fetches the resources it needs page by page and returns
+// typed rows, ready to be printed, exported or fed to other automation:
+//
+//	report, err := reports.LicenseCompliance(ctx, client)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, usage := range report.OverAllocated() {
+//	    fmt.Printf("%s: %d seats assigned, %d purchased\n", usage.License.Name, usage.Assigned, usage.Seats)
+//	}
+package reports
+
+import (
+	"github.com/michellepellon/go-snipeit"
+)
+
+// prorate returns the share n/d of m, rounded to the nearest cent.
+func prorate(m snipeit.Money, n, d int64) snipeit.Money {
+	cents := m.Cents * n
+	half := d / 2
+	if cents < 0 {
+		half = -half
+	}
+	m.Cents = (cents + half) / d
+	return m
+}
@@ -0,0 +1,111 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// StockKind is the kind of a stocked item.
+type StockKind string
+
+const (
+	// AccessoryStock items are accessories.
+	AccessoryStock StockKind = "accessory"
+
+	// ConsumableStock items are consumables.
+	ConsumableStock StockKind = "consumable"
+)
+
+// StockItem is an accessory or consumable whose remaining quantity is at
+// or below its minimum quantity.
+type StockItem struct {
+	// Kind is whether the item is an accessory or a consumable
+	Kind StockKind
+
+	// ID is the unique identifier of the accessory or consumable
+	ID int
+
+	// Name is the name of the item
+	Name string
+
+	// Qty is the total quantity of the item
+	Qty int
+
+	// Remaining is the quantity not checked out
+	Remaining int
+
+	// MinQty is the quantity at or below which the item is low on stock
+	MinQty int
+
+	// Shortfall is the number of items missing to get back above MinQty
+	Shortfall int
+
+	// Accessory is the accessory, as listed by the API, for accessories
+	Accessory *snipeit.Accessory
+
+	// Consumable is the consumable, as listed by the API, for consumables
+	Consumable *snipeit.Consumable
+}
+
+// LowStock returns the accessories and consumables whose remaining
+// quantity is at or below their minimum quantity, so that they can be
+// replenished. Items without a minimum quantity are never low on stock.
+//
+// The items are sorted by kind, accessories first, then by name. Use the
+// Accessory or Consumable of an item for its supplier, location and order
+// details.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/accessories
+func LowStock(ctx context.Context, client *snipeit.Client) ([]StockItem, error) {
+	var items []StockItem
+	for accessory, err := range client.Accessories.IterateContext(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("reports: listing accessories: %w", err)
+		}
+		if item, low := stockItem(AccessoryStock, accessory.CommonFields, accessory.Qty, accessory.RemainingQty, accessory.MinQty); low {
+			item.Accessory = &accessory
+			items = append(items, item)
+		}
+	}
+
+	for consumable, err := range client.Consumables.IterateContext(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("reports: listing consumables: %w", err)
+		}
+		if item, low := stockItem(ConsumableStock, consumable.CommonFields, consumable.Qty, consumable.Remaining, consumable.MinAmt); low {
+			item.Consumable = &consumable
+			items = append(items, item)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Kind != b.Kind {
+			return a.Kind == AccessoryStock
+		}
+		if na, nb := strings.ToLower(a.Name), strings.ToLower(b.Name); na != nb {
+			return na < nb
+		}
+		return a.ID < b.ID
+	})
+	return items, nil
+}
+
+// stockItem returns the stock item of an accessory or consumable, and
+// whether it is low on stock.
+func stockItem(kind StockKind, common snipeit.CommonFields, qty, remaining, minQty int) (StockItem, bool) {
+	item := StockItem{
+		Kind:      kind,
+		ID:        common.ID,
+		Name:      common.Name,
+		Qty:       qty,
+		Remaining: remaining,
+		MinQty:    minQty,
+		Shortfall: minQty - remaining + 1,
+	}
+	return item, minQty > 0 && remaining <= minQty
+}
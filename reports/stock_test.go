@@ -0,0 +1,46 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestLowStock(t *testing.T) {
+	client, mux := setup(t)
+	serveRows(mux, "/api/v1/accessories",
+		`{"id": 1, "name": "USB-C Dock", "qty": 20, "remaining_qty": 2, "min_qty": 5, "supplier": {"id": 3, "name": "CDW"}}`,
+		`{"id": 2, "name": "Headset", "qty": 10, "remaining_qty": 6, "min_qty": 5}`,
+		`{"id": 3, "name": "Adapter", "qty": 10, "remaining_qty": 0}`,
+		`{"id": 4, "name": "Charger", "qty": 8, "remaining_qty": 5, "min_qty": 5}`,
+	)
+	serveRows(mux, "/api/v1/consumables",
+		`{"id": 7, "name": "Toner", "qty": 12, "remaining": 1, "min_amt": 2, "item_no": "TN-660"}`,
+		`{"id": 8, "name": "Paper", "qty": 100, "remaining": 40, "min_amt": 10}`,
+	)
+
+	items, err := LowStock(context.Background(), client)
+	if err != nil {
+		t.Fatalf("LowStock returned error: %v", err)
+	}
+
+	var got []string
+	for _, item := range items {
+		got = append(got, fmt.Sprintf("%s %s %d/%d short %d", item.Kind, item.Name, item.Remaining, item.MinQty, item.Shortfall))
+	}
+	want := []string{
+		"accessory Charger 5/5 short 1",
+		"accessory USB-C Dock 2/5 short 4",
+		"consumable Toner 1/2 short 2",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("LowStock returned %q, expected %q", got, want)
+	}
+
+	if dock := items[1]; dock.Accessory == nil || dock.Accessory.Supplier == nil || dock.Accessory.Supplier.Name != "CDW" || dock.Consumable != nil {
+		t.Errorf("USB-C Dock = %+v, expected the accessory with its supplier", dock)
+	}
+	if toner := items[2]; toner.Consumable == nil || toner.Consumable.ItemNo != "TN-660" {
+		t.Errorf("Toner = %+v, expected the consumable with its item number", toner)
+	}
+}
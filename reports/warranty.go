@@ -0,0 +1,79 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// Warranty is the warranty of an asset.
+type Warranty struct {
+	// Asset is the asset, as listed by the API
+	Asset snipeit.Asset
+
+	// PurchaseDate is the date the asset was purchased, when its warranty
+	// started
+	PurchaseDate snipeit.Date
+
+	// Months is the length of the warranty in months
+	Months int
+
+	// Expires is the date the warranty expires: PurchaseDate plus Months
+	Expires snipeit.Date
+
+	// DaysLeft is the number of days from the date of the report to Expires
+	DaysLeft int
+}
+
+// WarrantiesExpiring returns the assets whose warranty expires within the
+// given duration from now, today included, so that they can be renewed.
+// Warranties that have already expired are not included.
+//
+// The warranty of an asset runs for its warranty months from its purchase
+// date; assets without either have no warranty. Snipe-IT cannot filter
+// assets by warranty, so every asset is listed, page by page, subject to
+// the client's rate limiter. Dates are compared in the local time zone.
+//
+// The warranties are sorted by expiry date, earliest first, then by asset
+// tag.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/hardware-list
+func WarrantiesExpiring(ctx context.Context, client *snipeit.Client, within time.Duration) ([]Warranty, error) {
+	now := time.Now()
+	today, end := snipeit.DateOf(now), snipeit.DateOf(now.Add(within))
+
+	var warranties []Warranty
+	for asset, err := range client.Assets.IterateContext(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("reports: listing assets: %w", err)
+		}
+		if asset.WarrantyMonths <= 0 || asset.PurchaseDate == nil || asset.PurchaseDate.IsZero() {
+			continue
+		}
+
+		purchased := snipeit.DateOf(asset.PurchaseDate.Time)
+		expires := purchased.AddMonths(asset.WarrantyMonths)
+		if expires.Before(today) || expires.After(end) {
+			continue
+		}
+		warranties = append(warranties, Warranty{
+			Asset:        asset,
+			PurchaseDate: purchased,
+			Months:       asset.WarrantyMonths,
+			Expires:      expires,
+			DaysLeft:     today.DaysUntil(expires),
+		})
+	}
+
+	sort.SliceStable(warranties, func(i, j int) bool {
+		a, b := warranties[i], warranties[j]
+		if a.Expires != b.Expires {
+			return a.Expires.Before(b.Expires)
+		}
+		return a.Asset.AssetTag < b.Asset.AssetTag
+	})
+	return warranties, nil
+}
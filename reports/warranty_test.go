@@ -0,0 +1,49 @@
+package reports
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+func TestWarrantiesExpiring(t *testing.T) {
+	client, mux := setup(t)
+
+	today := snipeit.DateOf(time.Now())
+	// purchased returns the purchase date of a warranty of the given months
+	// expiring in days
+	purchased := func(days, months int) string {
+		return today.AddDays(days).AddMonths(-months).String()
+	}
+	serveRows(mux, "/api/v1/hardware",
+		fmt.Sprintf(`{"id": 1, "asset_tag": "A-1", "warranty_months": 36, "purchase_date": {"date": %q}}`, purchased(10, 36)),
+		fmt.Sprintf(`{"id": 2, "asset_tag": "A-2", "warranty_months": 36, "purchase_date": {"date": %q}}`, purchased(40, 36)),
+		fmt.Sprintf(`{"id": 3, "asset_tag": "A-3", "warranty_months": 36, "purchase_date": {"date": %q}}`, purchased(-5, 36)),
+		fmt.Sprintf(`{"id": 4, "asset_tag": "A-4", "warranty_months": 24, "purchase_date": {"date": %q}}`, purchased(0, 24)),
+		fmt.Sprintf(`{"id": 5, "asset_tag": "A-5", "purchase_date": {"date": %q}}`, purchased(3, 12)),
+		fmt.Sprintf(`{"id": 6, "asset_tag": "A-6", "warranty_months": "12 months", "purchase_date": {"date": %q}}`, purchased(3, 12)),
+		`{"id": 7, "asset_tag": "A-7", "warranty_months": 12}`,
+	)
+
+	warranties, err := WarrantiesExpiring(context.Background(), client, 30*24*time.Hour)
+	if err != nil {
+		t.Fatalf("WarrantiesExpiring returned error: %v", err)
+	}
+
+	var tags []string
+	for _, warranty := range warranties {
+		tags = append(tags, warranty.Asset.AssetTag)
+		if want := warranty.PurchaseDate.AddMonths(warranty.Months); warranty.Expires != want || warranty.DaysLeft != today.DaysUntil(want) {
+			t.Errorf("%s expires %v in %d days, expected %v in %d days", warranty.Asset.AssetTag, warranty.Expires, warranty.DaysLeft, want, today.DaysUntil(want))
+		}
+	}
+	if fmt.Sprint(tags) != "[A-4 A-6 A-1]" {
+		t.Errorf("WarrantiesExpiring returned %v, expected [A-4 A-6 A-1]", tags)
+	}
+	if len(warranties) == 3 && warranties[1].Months != 12 {
+		t.Errorf("A-6 has a warranty of %d months, expected 12", warranties[1].Months)
+	}
+}
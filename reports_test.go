@@ -0,0 +1,83 @@
+package snipeit
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestReportsActivity(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/reports/activity", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		query := r.URL.Query()
+		if query.Get("item_type") != "asset" {
+			t.Errorf("Request URL query parameter 'item_type' = %v, expected %v", query.Get("item_type"), "asset")
+		}
+		if query.Get("item_id") != "42" {
+			t.Errorf("Request URL query parameter 'item_id' = %v, expected %v", query.Get("item_id"), "42")
+		}
+		if query.Get("action_type") != "checkout" {
+			t.Errorf("Request URL query parameter 'action_type' = %v, expected %v", query.Get("action_type"), "checkout")
+		}
+		if query.Get("start_date") != "2024-01-01" {
+			t.Errorf("Request URL query parameter 'start_date' = %v, expected %v", query.Get("start_date"), "2024-01-01")
+		}
+		if query.Has("end_date") {
+			t.Errorf("Request URL query parameter 'end_date' = %v, expected it to be omitted", query.Get("end_date"))
+		}
+
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{
+					"id": 900,
+					"action_type": "checkout",
+					"item": {"id": 42, "name": "Laptop 42", "type": "asset"},
+					"target": {"id": 7, "name": "Jane Doe", "type": "user"},
+					"admin": {"id": 1, "name": "Admin"},
+					"note": "New laptop",
+					"log_meta": {"location_id": {"old": 1, "new": 2}},
+					"created_at": {"datetime": "2024-01-15 09:30:00", "formatted": "2024-01-15 09:30AM"}
+				}
+			]
+		}`)
+	})
+
+	opts := &ActivityListOptions{
+		ItemType:   "asset",
+		ItemID:     42,
+		ActionType: "checkout",
+		StartDate:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	activity, _, err := client.Reports.Activity(opts)
+	if err != nil {
+		t.Fatalf("Reports.Activity returned error: %v", err)
+	}
+
+	if len(activity.Rows) != 1 {
+		t.Fatalf("Reports.Activity returned %d events, expected %d", len(activity.Rows), 1)
+	}
+
+	event := activity.Rows[0]
+	if event.Item == nil || event.Item.Type != "asset" || event.Item.ID != 42 {
+		t.Errorf("Reports.Activity returned Item = %+v, expected asset 42", event.Item)
+	}
+
+	if event.Target == nil || event.Target.Name != "Jane Doe" {
+		t.Errorf("Reports.Activity returned Target = %+v, expected %q", event.Target, "Jane Doe")
+	}
+
+	if change, ok := event.LogMeta["location_id"]; !ok || change.New != float64(2) {
+		t.Errorf("Reports.Activity returned LogMeta = %+v, expected location_id changed to 2", event.LogMeta)
+	}
+
+	if event.CreatedAt == nil || event.CreatedAt.Hour() != 9 {
+		t.Errorf("Reports.Activity returned CreatedAt = %v, expected 09:30", event.CreatedAt)
+	}
+}
@@ -0,0 +1,45 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// requestIDKey is the context key under which a request ID is stored.
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying id as the correlation ID of
+// requests made with it. The ID is sent in ClientOptions.RequestIDHeader.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the correlation ID carried by ctx, or an empty
+// string if there is none. Hooks can call it with the request's context to
+// get the ID sent with a request.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// newRandomID returns a random 128-bit identifier, hex-encoded, for use
+// as a request ID or idempotency key.
+func newRandomID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// requestID returns the correlation ID sent with req, or an empty string
+// if request IDs are not enabled.
+func (c *Client) requestID(req *http.Request) string {
+	if c.requestIDHeader == "" || req == nil {
+		return ""
+	}
+	return req.Header.Get(c.requestIDHeader)
+}
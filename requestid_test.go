@@ -0,0 +1,90 @@
+package snipeit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClientRequestID(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	var ids []string
+	attempts := 0
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		ids = append(ids, r.Header.Get("X-Request-ID"))
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	var hookIDs []string
+	retryPolicy := DefaultRetryPolicy()
+	retryPolicy.InitialBackoff = time.Millisecond
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{
+		RequestIDHeader: "X-Request-ID",
+		RetryPolicy:     retryPolicy,
+		OnRequest: func(req *http.Request) {
+			hookIDs = append(hookIDs, RequestIDFromContext(req.Context()))
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if _, _, err := client.Assets.Get(1); err != nil {
+		t.Fatalf("Assets.Get returned error: %v", err)
+	}
+
+	if len(ids) != 2 || len(ids[0]) != 32 || ids[0] != ids[1] {
+		t.Errorf("server received request IDs %q, expected the same generated ID on both attempts", ids)
+	}
+	if len(hookIDs) != 2 || hookIDs[0] != ids[0] {
+		t.Errorf("OnRequest saw request IDs %q, expected %q", hookIDs, ids)
+	}
+
+	ids = ids[:0]
+	ctx := WithRequestID(context.Background(), "job-42")
+	if _, _, err := client.Assets.GetContext(ctx, 1); err != nil {
+		t.Fatalf("Assets.GetContext returned error: %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "job-42" {
+		t.Errorf("server received request IDs %q, expected %q", ids, "job-42")
+	}
+}
+
+func TestErrorResponseRequestID(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status": "error", "messages": "Asset does not exist."}`)
+	})
+
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{RequestIDHeader: "X-Request-ID", DisableRetries: true})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	_, _, err = client.Assets.GetContext(WithRequestID(context.Background(), "job-42"), 1)
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("Assets.GetContext error = %v, expected an *ErrorResponse", err)
+	}
+	if errorResponse.RequestID != "job-42" {
+		t.Errorf("ErrorResponse.RequestID = %q, expected %q", errorResponse.RequestID, "job-42")
+	}
+	if !strings.Contains(err.Error(), "job-42") {
+		t.Errorf("ErrorResponse.Error() = %q, expected it to include the request ID", err.Error())
+	}
+}
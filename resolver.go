@@ -0,0 +1,188 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Resolver resolves the names of models, status labels, categories,
+// locations, companies and suppliers to their IDs.
+//
+// The first lookup of a kind of resource lists all resources of that kind
+// and caches their IDs by name, so that later lookups, such as those of
+// an import creating many assets, make no requests. Names are matched
+// ignoring case and surrounding spaces; if several resources share a
+// name, the first listed is used.
+//
+// A Resolver is safe for concurrent use. Concurrent lookups of the same
+// kind share one listing.
+//
+// Usage:
+//
+//	resolver := snipeit.NewResolver(client, 10*time.Minute)
+//	modelID, err := resolver.ModelID(ctx, "MacBook Pro 16")
+type Resolver struct {
+	client *Client
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu    sync.Mutex
+	kinds map[resolverKind]*resolverCache
+}
+
+// resolverKind is a kind of resource resolved by a Resolver.
+type resolverKind string
+
+const (
+	resolveModels       resolverKind = "model"
+	resolveStatusLabels resolverKind = "status label"
+	resolveCategories   resolverKind = "category"
+	resolveLocations    resolverKind = "location"
+	resolveCompanies    resolverKind = "company"
+	resolveSuppliers    resolverKind = "supplier"
+)
+
+// resolverCache holds the IDs of the resources of one kind by normalized
+// name. Its mutex is held while they are listed.
+type resolverCache struct {
+	mu       sync.Mutex
+	ids      map[string]int
+	loadedAt time.Time
+}
+
+// NewResolver returns a Resolver looking names up with client.
+//
+// ttl is how long the resources of a kind are cached once listed; if it
+// is not positive, they are cached until Invalidate is called.
+func NewResolver(client *Client, ttl time.Duration) *Resolver {
+	return &Resolver{
+		client: client,
+		ttl:    ttl,
+		now:    time.Now,
+		kinds:  make(map[resolverKind]*resolverCache),
+	}
+}
+
+// ModelID returns the ID of the asset model named name.
+// If there is none, the error wraps ErrNotFound.
+func (r *Resolver) ModelID(ctx context.Context, name string) (int, error) {
+	return r.resolve(ctx, resolveModels, name, func(ctx context.Context) iter.Seq2[namedID, error] {
+		return namedIDs(r.client.Models.IterateContext(ctx, nil), func(m Model) CommonFields { return m.CommonFields })
+	})
+}
+
+// StatusLabelID returns the ID of the status label named name.
+// If there is none, the error wraps ErrNotFound.
+func (r *Resolver) StatusLabelID(ctx context.Context, name string) (int, error) {
+	return r.resolve(ctx, resolveStatusLabels, name, func(ctx context.Context) iter.Seq2[namedID, error] {
+		return namedIDs(r.client.StatusLabels.IterateContext(ctx, nil), func(l StatusLabel) CommonFields { return l.CommonFields })
+	})
+}
+
+// CategoryID returns the ID of the category named name, of any category
+// type. If there is none, the error wraps ErrNotFound.
+func (r *Resolver) CategoryID(ctx context.Context, name string) (int, error) {
+	return r.resolve(ctx, resolveCategories, name, func(ctx context.Context) iter.Seq2[namedID, error] {
+		return namedIDs(r.client.Categories.IterateContext(ctx, nil), func(c Category) CommonFields { return c.CommonFields })
+	})
+}
+
+// LocationID returns the ID of the location named name.
+// If there is none, the error wraps ErrNotFound.
+func (r *Resolver) LocationID(ctx context.Context, name string) (int, error) {
+	return r.resolve(ctx, resolveLocations, name, func(ctx context.Context) iter.Seq2[namedID, error] {
+		return namedIDs(r.client.Locations.IterateContext(ctx, nil), func(l Location) CommonFields { return l.CommonFields })
+	})
+}
+
+// CompanyID returns the ID of the company named name.
+// If there is none, the error wraps ErrNotFound.
+func (r *Resolver) CompanyID(ctx context.Context, name string) (int, error) {
+	return r.resolve(ctx, resolveCompanies, name, func(ctx context.Context) iter.Seq2[namedID, error] {
+		return namedIDs(r.client.Companies.IterateContext(ctx, nil), func(c Company) CommonFields { return c.CommonFields })
+	})
+}
+
+// SupplierID returns the ID of the supplier named name.
+// If there is none, the error wraps ErrNotFound.
+func (r *Resolver) SupplierID(ctx context.Context, name string) (int, error) {
+	return r.resolve(ctx, resolveSuppliers, name, func(ctx context.Context) iter.Seq2[namedID, error] {
+		return namedIDs(r.client.Suppliers.IterateContext(ctx, nil), func(s Supplier) CommonFields { return s.CommonFields })
+	})
+}
+
+// Invalidate empties the cache, so that the next lookup of each kind of
+// resource lists them again. Call it after creating, renaming or deleting
+// resources.
+func (r *Resolver) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.kinds = make(map[resolverKind]*resolverCache)
+}
+
+// namedID is the name and ID of a resource.
+type namedID struct {
+	name string
+	id   int
+}
+
+// namedIDs returns the names and IDs of the resources of seq, whose
+// CommonFields are returned by common.
+func namedIDs[T any](seq iter.Seq2[T, error], common func(T) CommonFields) iter.Seq2[namedID, error] {
+	return func(yield func(namedID, error) bool) {
+		for item, err := range seq {
+			fields := common(item)
+			if !yield(namedID{fields.Name, fields.ID}, err) {
+				return
+			}
+		}
+	}
+}
+
+// resolve returns the ID of the resource of kind k named name, listing
+// the resources of that kind with list if they are not cached.
+func (r *Resolver) resolve(ctx context.Context, k resolverKind, name string, list func(ctx context.Context) iter.Seq2[namedID, error]) (int, error) {
+	r.mu.Lock()
+	cache, ok := r.kinds[k]
+	if !ok {
+		cache = &resolverCache{}
+		r.kinds[k] = cache
+	}
+	r.mu.Unlock()
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.ids == nil || (r.ttl > 0 && r.now().Sub(cache.loadedAt) >= r.ttl) {
+		ids := make(map[string]int)
+		for item, err := range list(ctx) {
+			if err != nil {
+				return 0, err
+			}
+			key := normalizeName(item.name)
+			if _, ok := ids[key]; !ok {
+				ids[key] = item.id
+			}
+		}
+		cache.ids = ids
+		cache.loadedAt = r.now()
+	}
+
+	id, ok := cache.ids[normalizeName(name)]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s %q", ErrNotFound, k, name)
+	}
+	return id, nil
+}
+
+// normalizeName returns the form of a resource name used to match it,
+// ignoring case and surrounding spaces.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,143 @@
+package snipeit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestResolver(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	requests := 0
+	mux.HandleFunc("/api/v1/models", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		fmt.Fprint(w, `{"total": 2, "rows": [{"id": 7, "name": "MacBook Pro 16"}, {"id": 8, "name": "ThinkPad X1"}]}`)
+	})
+
+	resolver := NewResolver(client, 0)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := resolver.ModelID(ctx, "MacBook Pro 16")
+			if err != nil || id != 7 {
+				t.Errorf("ModelID returned %d, %v, expected %d", id, err, 7)
+			}
+		}()
+	}
+	wg.Wait()
+
+	id, err := resolver.ModelID(ctx, "  thinkpad x1 ")
+	if err != nil || id != 8 {
+		t.Errorf("ModelID returned %d, %v, expected %d", id, err, 8)
+	}
+
+	_, err = resolver.ModelID(ctx, "Surface Pro")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("ModelID returned error %v, expected %v", err, ErrNotFound)
+	}
+
+	if requests != 1 {
+		t.Errorf("Resolver made %d requests, expected %d", requests, 1)
+	}
+
+	resolver.Invalidate()
+	if _, err := resolver.ModelID(ctx, "ThinkPad X1"); err != nil {
+		t.Fatalf("ModelID returned error: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Resolver made %d requests after Invalidate, expected %d", requests, 2)
+	}
+}
+
+func TestResolverTTL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/api/v1/statuslabels", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprintf(w, `{"total": 1, "rows": [{"id": %d, "name": "Ready to Deploy"}]}`, requests)
+	})
+
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	resolver := NewResolver(client, time.Minute)
+	resolver.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	tests := []struct {
+		elapsed  time.Duration
+		expected int
+	}{
+		{0, 1},
+		{30 * time.Second, 1},
+		{time.Minute, 2},
+	}
+
+	for _, tt := range tests {
+		now = now.Add(tt.elapsed)
+		id, err := resolver.StatusLabelID(ctx, "Ready to Deploy")
+		if err != nil || id != tt.expected {
+			t.Errorf("StatusLabelID after %v returned %d, %v, expected %d", tt.elapsed, id, err, tt.expected)
+		}
+	}
+}
+
+func TestResolverKinds(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	for _, path := range []string{"categories", "locations", "companies", "suppliers"} {
+		mux.HandleFunc("/api/v1/"+path, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"total": 1, "rows": [{"id": %d, "name": "%s"}]}`, len(path), path)
+		})
+	}
+
+	resolver := NewResolver(client, 0)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		resolve  func(ctx context.Context, name string) (int, error)
+		expected int
+	}{
+		{"categories", resolver.CategoryID, 10},
+		{"locations", resolver.LocationID, 9},
+		{"companies", resolver.CompanyID, 9},
+		{"suppliers", resolver.SupplierID, 9},
+	}
+
+	for _, tt := range tests {
+		if id, err := tt.resolve(ctx, tt.name); err != nil || id != tt.expected {
+			t.Errorf("resolving %s returned %d, %v, expected %d", tt.name, id, err, tt.expected)
+		}
+	}
+}
+
+func TestResolverListError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/locations", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"status": "error", "messages": "Forbidden"}`)
+	})
+
+	_, err := NewResolver(client, 0).LocationID(context.Background(), "HQ")
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("LocationID returned error %v, expected %v", err, ErrForbidden)
+	}
+}
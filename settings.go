@@ -0,0 +1,233 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// SettingsService handles communication with the settings endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settings
+type SettingsService struct {
+	client *Client
+}
+
+// BackupsResponse represents the API response for the list of backups.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Backups.
+type BackupsResponse struct {
+	Response
+	// Rows contains the list of Backup objects
+	Rows []Backup `json:"rows"`
+}
+
+// LoginAttemptsResponse represents the API response for the login attempt log.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of LoginAttempts.
+type LoginAttemptsResponse struct {
+	Response
+	// Rows contains the list of LoginAttempt objects
+	Rows []LoginAttempt `json:"rows"`
+}
+
+// Backups returns the backup archives stored on the Snipe-IT server.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settingsbackups
+func (s *SettingsService) Backups() (*BackupsResponse, *http.Response, error) {
+	return s.BackupsContext(context.Background())
+}
+
+// BackupsContext returns the backup archives stored on the Snipe-IT server
+// with the provided context.
+//
+// ctx is the context for the request.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settingsbackups
+func (s *SettingsService) BackupsContext(ctx context.Context) (*BackupsResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, "api/v1/settings/backups", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var backups BackupsResponse
+	resp, err := s.client.Do(req, &backups)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &backups, resp, nil
+}
+
+// CreateBackup asks Snipe-IT to create a new backup archive.
+//
+// The backup is created on the server; use Backups to find its filename
+// and DownloadBackup to fetch it.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settingsbackups-1
+func (s *SettingsService) CreateBackup() (*Response, *http.Response, error) {
+	return s.CreateBackupContext(context.Background())
+}
+
+// CreateBackupContext asks Snipe-IT to create a new backup archive
+// with the provided context.
+//
+// ctx is the context for the request. Creating a backup of a large
+// instance can take a while, so a generous deadline is advisable.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settingsbackups-1
+func (s *SettingsService) CreateBackupContext(ctx context.Context) (*Response, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/settings/backups", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response Response
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// DownloadBackup streams a backup archive to w.
+//
+// filename is the name of the backup archive, as returned by Backups.
+// w receives the raw ZIP data.
+//
+// The download is not retried, since a failed attempt may already have
+// written part of the archive to w.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settingsbackupsdownloadfile
+func (s *SettingsService) DownloadBackup(filename string, w io.Writer) (*http.Response, error) {
+	return s.DownloadBackupContext(context.Background(), filename, w)
+}
+
+// DownloadBackupContext streams a backup archive to w with the provided context.
+//
+// ctx is the context for the request.
+// filename is the name of the backup archive, as returned by Backups.
+// w receives the raw ZIP data.
+//
+// The download is not retried, since a failed attempt may already have
+// written part of the archive to w.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settingsbackupsdownloadfile
+func (s *SettingsService) DownloadBackupContext(ctx context.Context, filename string, w io.Writer) (*http.Response, error) {
+	if filename == "" {
+		return nil, errors.New("snipeit: backup filename must not be empty")
+	}
+
+	u := "api/v1/settings/backups/download/" + url.PathEscape(filename)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.DoWithOptions(req, w, &RequestOptions{Context: ctx, DisableRetries: true})
+}
+
+// SettingsTestResult represents the outcome of a settings test endpoint.
+// A failed test is reported as an ErrorResponse carrying the server's message.
+type SettingsTestResult struct {
+	// Message describes the outcome of the test
+	Message string `json:"message"`
+}
+
+// TestLDAP checks that Snipe-IT can connect and bind to the configured LDAP server.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settingsldaptest
+func (s *SettingsService) TestLDAP() (*SettingsTestResult, *http.Response, error) {
+	return s.TestLDAPContext(context.Background())
+}
+
+// TestLDAPContext checks that Snipe-IT can connect and bind to the configured
+// LDAP server with the provided context.
+//
+// ctx is the context for the request.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settingsldaptest
+func (s *SettingsService) TestLDAPContext(ctx context.Context) (*SettingsTestResult, *http.Response, error) {
+	return s.runTest(ctx, http.MethodGet, "api/v1/settings/ldaptest")
+}
+
+// TestSlack sends a test message to the configured Slack webhook.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settingsslacktest
+func (s *SettingsService) TestSlack() (*SettingsTestResult, *http.Response, error) {
+	return s.TestSlackContext(context.Background())
+}
+
+// TestSlackContext sends a test message to the configured Slack webhook
+// with the provided context.
+//
+// ctx is the context for the request.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settingsslacktest
+func (s *SettingsService) TestSlackContext(ctx context.Context) (*SettingsTestResult, *http.Response, error) {
+	return s.runTest(ctx, http.MethodPost, "api/v1/settings/slacktest")
+}
+
+// runTest calls a settings test endpoint. Tests have side effects on
+// external systems, so they are not retried.
+func (s *SettingsService) runTest(ctx context.Context, method, u string) (*SettingsTestResult, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, method, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result SettingsTestResult
+	resp, err := s.client.DoWithOptions(req, &result, &RequestOptions{Context: ctx, DisableRetries: true})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &result, resp, nil
+}
+
+// LoginAttempts returns the successful and failed login attempts recorded by Snipe-IT.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settingslogin-attempts
+func (s *SettingsService) LoginAttempts(opts *ListOptions) (*LoginAttemptsResponse, *http.Response, error) {
+	return s.LoginAttemptsContext(context.Background(), opts)
+}
+
+// LoginAttemptsContext returns the login attempts recorded by Snipe-IT
+// with the provided context.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/settingslogin-attempts
+func (s *SettingsService) LoginAttemptsContext(ctx context.Context, opts *ListOptions) (*LoginAttemptsResponse, *http.Response, error) {
+	u := "api/v1/settings/login-attempts"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var attempts LoginAttemptsResponse
+	resp, err := s.client.Do(req, &attempts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &attempts, resp, nil
+}
@@ -0,0 +1,180 @@
+package snipeit
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSettingsBackups(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/settings/backups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{"filename": "snipe-it-2024-05-01-00-00-00.zip", "filesize": "12.4 MB", "modified_value": 1714521600}
+			]
+		}`)
+	})
+
+	backups, _, err := client.Settings.Backups()
+	if err != nil {
+		t.Fatalf("Settings.Backups returned error: %v", err)
+	}
+
+	if len(backups.Rows) != 1 || backups.Rows[0].Filename != "snipe-it-2024-05-01-00-00-00.zip" {
+		t.Errorf("Settings.Backups returned %+v, expected one backup", backups.Rows)
+	}
+
+	if backups.Rows[0].ModifiedValue != 1714521600 {
+		t.Errorf("Settings.Backups returned ModifiedValue = %d, expected %d", backups.Rows[0].ModifiedValue, 1714521600)
+	}
+}
+
+func TestSettingsCreateBackup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/settings/backups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"status": "success", "messages": "Backup created"}`)
+	})
+
+	response, _, err := client.Settings.CreateBackup()
+	if err != nil {
+		t.Fatalf("Settings.CreateBackup returned error: %v", err)
+	}
+
+	if response.Status != "success" {
+		t.Errorf("Settings.CreateBackup returned Status = %q, expected %q", response.Status, "success")
+	}
+}
+
+func TestSettingsDownloadBackup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/settings/backups/download/backup.zip", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Header().Set("Content-Type", "application/zip")
+		fmt.Fprint(w, "PK\x03\x04archive")
+	})
+
+	var buf bytes.Buffer
+	if _, err := client.Settings.DownloadBackup("backup.zip", &buf); err != nil {
+		t.Fatalf("Settings.DownloadBackup returned error: %v", err)
+	}
+
+	if buf.String() != "PK\x03\x04archive" {
+		t.Errorf("Settings.DownloadBackup wrote %q, expected %q", buf.String(), "PK\x03\x04archive")
+	}
+}
+
+func TestSettingsDownloadBackupNotRetried(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/api/v1/settings/backups/download/backup.zip", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	var buf bytes.Buffer
+	if _, err := client.Settings.DownloadBackup("backup.zip", &buf); err == nil {
+		t.Fatal("Settings.DownloadBackup returned nil error, expected an error")
+	}
+
+	if calls != 1 {
+		t.Errorf("Settings.DownloadBackup made %d requests, expected %d", calls, 1)
+	}
+}
+
+func TestSettingsTestLDAP(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/settings/ldaptest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"message": "It worked! Bound to LDAP server."}`)
+	})
+
+	result, _, err := client.Settings.TestLDAP()
+	if err != nil {
+		t.Fatalf("Settings.TestLDAP returned error: %v", err)
+	}
+
+	if result.Message != "It worked! Bound to LDAP server." {
+		t.Errorf("Settings.TestLDAP returned Message = %q, expected %q", result.Message, "It worked! Bound to LDAP server.")
+	}
+}
+
+func TestSettingsTestSlackFailure(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/api/v1/settings/slacktest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "Invalid webhook endpoint"}`)
+	})
+
+	_, _, err := client.Settings.TestSlack()
+	errorResponse, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Settings.TestSlack returned error %v, expected an *ErrorResponse", err)
+	}
+
+	if errorResponse.Message != "Invalid webhook endpoint" {
+		t.Errorf("Settings.TestSlack returned Message = %q, expected %q", errorResponse.Message, "Invalid webhook endpoint")
+	}
+
+	if calls != 1 {
+		t.Errorf("Settings.TestSlack made %d requests, expected %d", calls, 1)
+	}
+}
+
+func TestSettingsLoginAttempts(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/settings/login-attempts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if r.URL.Query().Get("limit") != "100" {
+			t.Errorf("Request URL query parameter 'limit' = %v, expected %v", r.URL.Query().Get("limit"), "100")
+		}
+
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 2, "username": "admin", "remote_ip": "203.0.113.9", "user_agent": "curl/8.0", "successful": false, "created_at": {"datetime": "2024-05-01 08:00:00", "formatted": "2024-05-01 08:00AM"}},
+				{"id": 1, "username": "jdoe", "remote_ip": "198.51.100.4", "user_agent": "Mozilla/5.0", "successful": true}
+			]
+		}`)
+	})
+
+	attempts, _, err := client.Settings.LoginAttempts(&ListOptions{Limit: 100})
+	if err != nil {
+		t.Fatalf("Settings.LoginAttempts returned error: %v", err)
+	}
+
+	if len(attempts.Rows) != 2 {
+		t.Fatalf("Settings.LoginAttempts returned %d attempts, expected %d", len(attempts.Rows), 2)
+	}
+
+	failed := attempts.Rows[0]
+	if failed.Successful || failed.RemoteIP != "203.0.113.9" || failed.Username != "admin" {
+		t.Errorf("Settings.LoginAttempts returned %+v, expected a failed admin login from 203.0.113.9", failed)
+	}
+
+	if failed.CreatedAt == nil || failed.CreatedAt.Hour() != 8 {
+		t.Errorf("Settings.LoginAttempts returned CreatedAt = %v, expected 08:00", failed.CreatedAt)
+	}
+}
@@ -0,0 +1,746 @@
+package snapshot
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// Kind is a kind of resource held in an archive.
+type Kind string
+
+// Kinds of resources, in the order they are restored.
+const (
+	KindCompanies     Kind = "companies"
+	KindCategories    Kind = "categories"
+	KindManufacturers Kind = "manufacturers"
+	KindSuppliers     Kind = "suppliers"
+	KindLocations     Kind = "locations"
+	KindDepartments   Kind = "departments"
+	KindStatusLabels  Kind = "status_labels"
+	KindFieldsets     Kind = "fieldsets"
+	KindModels        Kind = "models"
+	KindUsers         Kind = "users"
+	KindLicenses      Kind = "licenses"
+	KindAccessories   Kind = "accessories"
+	KindAssets        Kind = "assets"
+)
+
+// RestoreOptions configures a restore.
+type RestoreOptions struct {
+	// MatchExisting, if true, reuses the resources already on the server
+	// instead of creating them: users are matched by username, assets by
+	// asset tag, and other resources by name, ignoring case. By default,
+	// every resource is created, which suits an empty server.
+	MatchExisting bool
+
+	// Password is the password of the restored users, as archives hold
+	// none. If empty, each user gets a random password, which must be
+	// reset before they can log in.
+	Password string
+
+	// SkipCheckouts, if true, leaves the restored assets checked in. By
+	// default, each asset is checked out again to the restored user,
+	// location or asset it was assigned to.
+	SkipCheckouts bool
+}
+
+// Failure is a resource of an archive that could not be restored.
+type Failure struct {
+	// Kind is the kind of the resource
+	Kind Kind
+
+	// ID is the ID of the resource in the archive
+	ID int
+
+	// Name is the name of the resource, its username for a user or its
+	// asset tag for an asset
+	Name string
+
+	// Err is the reason the resource could not be restored
+	Err error
+}
+
+// Error implements the error interface for Failure.
+func (f Failure) Error() string {
+	return fmt.Sprintf("%s %d (%s): %v", f.Kind, f.ID, f.Name, f.Err)
+}
+
+// Unwrap returns the reason the resource could not be restored.
+func (f Failure) Unwrap() error {
+	return f.Err
+}
+
+// Result is the outcome of a restore.
+type Result struct {
+	// IDs maps, for each kind, the IDs of the resources in the archive to
+	// their IDs on the server. Resources that could not be restored are
+	// missing.
+	IDs map[Kind]map[int]int
+
+	// Created and Matched count, for each kind, the resources that were
+	// created and those that were matched to existing resources.
+	Created map[Kind]int
+	Matched map[Kind]int
+
+	// Failures lists the resources that could not be restored, and the
+	// assets that could not be checked out again, in the order of the
+	// restore.
+	Failures []Failure
+}
+
+// ID returns the ID on the server of the resource of the given kind with
+// the given ID in the archive, or 0 if it was not restored.
+func (r *Result) ID(kind Kind, id int) int {
+	return r.IDs[kind][id]
+}
+
+// Err returns an error joining the failures, or nil if every resource was
+// restored.
+func (r *Result) Err() error {
+	errs := make([]error, len(r.Failures))
+	for i, failure := range r.Failures {
+		errs[i] = failure
+	}
+	return errors.Join(errs...)
+}
+
+// Restore recreates the resources of the archive read from r with client.
+// See RestoreArchive.
+func Restore(ctx context.Context, client *snipeit.Client, r io.Reader, opts RestoreOptions) (*Result, error) {
+	archive, err := Read(r)
+	if err != nil {
+		return nil, err
+	}
+	return RestoreArchive(ctx, client, archive, opts)
+}
+
+// RestoreArchive recreates the resources of archive with client.
+//
+// Resources are created in dependency order, and the references between
+// them are mapped from the IDs of the archive to the IDs of the created
+// resources. Locations are created after their parent and users after
+// their manager; department managers are set once users are restored.
+//
+// Custom fieldsets and fields are not created, as their database columns
+// cannot be chosen: create them on the server first. Models are attached
+// to the fieldset of the same name, and the custom field values of assets
+// are set on the field of the same name; values of fields missing on the
+// server are dropped. Users are restored without their groups.
+//
+// Resources that cannot be restored are reported in the Result, and the
+// resources referencing them are restored without the reference. The
+// returned error reports a restore that could not run to completion: an
+// error listing existing resources, or the cancellation of ctx. The
+// Result then holds what was restored so far.
+func RestoreArchive(ctx context.Context, client *snipeit.Client, archive *Archive, opts RestoreOptions) (*Result, error) {
+	r := &restorer{
+		client: client,
+		opts:   opts,
+		result: &Result{
+			IDs:     make(map[Kind]map[int]int),
+			Created: make(map[Kind]int),
+			Matched: make(map[Kind]int),
+		},
+		matched: make(map[Kind]map[int]bool),
+	}
+	return r.result, r.restore(ctx, archive)
+}
+
+// restorer restores an archive.
+type restorer struct {
+	client *snipeit.Client
+	opts   RestoreOptions
+	result *Result
+
+	// matched holds the archive IDs of the resources matched to existing
+	// resources, by kind
+	matched map[Kind]map[int]bool
+
+	// columns maps the lowercase names of the custom fields on the server
+	// to their database columns
+	columns map[string]string
+}
+
+// restore restores every kind of resource of archive in dependency order.
+func (r *restorer) restore(ctx context.Context, archive *Archive) error {
+	c := r.client
+	named := func(common snipeit.CommonFields) (int, string) { return common.ID, common.Name }
+
+	err := restoreAll(ctx, r, KindCompanies, archive.Companies,
+		func(company snipeit.Company) (int, string) { return named(company.CommonFields) },
+		c.Companies.IterateContext(ctx, nil),
+		func(ctx context.Context, company snipeit.Company) (int, error) {
+			company.CommonFields = common(company.CommonFields)
+			company.AssetsCount, company.LicensesCount, company.AccessoriesCount = 0, 0, 0
+			company.ConsumablesCount, company.ComponentsCount, company.UsersCount = 0, 0, 0
+			created, _, err := c.Companies.CreateContext(ctx, company)
+			if err != nil {
+				return 0, err
+			}
+			return created.ID, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	err = restoreAll(ctx, r, KindCategories, archive.Categories,
+		func(category snipeit.Category) (int, string) { return named(category.CommonFields) },
+		c.Categories.IterateContext(ctx, nil),
+		func(ctx context.Context, category snipeit.Category) (int, error) {
+			category.CommonFields = common(category.CommonFields)
+			category.AssetsCount, category.ModelsCount = 0, 0
+			created, _, err := c.Categories.CreateContext(ctx, category)
+			if err != nil {
+				return 0, err
+			}
+			return created.ID, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	err = restoreAll(ctx, r, KindManufacturers, archive.Manufacturers,
+		func(manufacturer snipeit.Manufacturer) (int, string) { return named(manufacturer.CommonFields) },
+		c.Manufacturers.IterateContext(ctx, nil),
+		func(ctx context.Context, manufacturer snipeit.Manufacturer) (int, error) {
+			manufacturer.CommonFields = common(manufacturer.CommonFields)
+			manufacturer.AssetsCount = 0
+			created, _, err := c.Manufacturers.CreateContext(ctx, manufacturer)
+			if err != nil {
+				return 0, err
+			}
+			return created.ID, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	err = restoreAll(ctx, r, KindSuppliers, archive.Suppliers,
+		func(supplier snipeit.Supplier) (int, string) { return named(supplier.CommonFields) },
+		c.Suppliers.IterateContext(ctx, nil),
+		func(ctx context.Context, supplier snipeit.Supplier) (int, error) {
+			supplier.CommonFields = common(supplier.CommonFields)
+			supplier.AssetsCount = 0
+			created, _, err := c.Suppliers.CreateContext(ctx, supplier)
+			if err != nil {
+				return 0, err
+			}
+			return created.ID, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	locations := parentsFirst(archive.Locations,
+		func(location snipeit.Location) int { return location.ID },
+		locationParent)
+	err = restoreAll(ctx, r, KindLocations, locations,
+		func(location snipeit.Location) (int, string) { return named(location.CommonFields) },
+		c.Locations.IterateContext(ctx, nil),
+		func(ctx context.Context, location snipeit.Location) (int, error) {
+			location.ParentID = r.ref(KindLocations, locationParent(location))
+			location.CommonFields = common(location.CommonFields)
+			location.Parent, location.Children, location.AssetsCount = nil, nil, 0
+			created, _, err := c.Locations.CreateContext(ctx, location)
+			if err != nil {
+				return 0, err
+			}
+			return created.ID, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	// Department managers are users, which belong to departments: they are
+	// set once users are restored
+	departments := make(map[int]snipeit.Department)
+	err = restoreAll(ctx, r, KindDepartments, archive.Departments,
+		func(department snipeit.Department) (int, string) { return named(department.CommonFields) },
+		c.Departments.IterateContext(ctx, nil),
+		func(ctx context.Context, department snipeit.Department) (int, error) {
+			department.CompanyID = r.ref(KindCompanies, idOf(department.Company))
+			department.LocationID = r.ref(KindLocations, idOf(department.Location))
+			department.CommonFields = common(department.CommonFields)
+			department.Company, department.Manager, department.Location = nil, nil, nil
+			department.ManagerID, department.UsersCount = 0, 0
+			created, _, err := c.Departments.CreateContext(ctx, department)
+			if err != nil {
+				return 0, err
+			}
+			department.ID = created.ID
+			departments[created.ID] = department
+			return created.ID, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	err = restoreAll(ctx, r, KindStatusLabels, archive.StatusLabels,
+		func(label snipeit.StatusLabel) (int, string) { return named(label.CommonFields) },
+		c.StatusLabels.IterateContext(ctx, nil),
+		func(ctx context.Context, label snipeit.StatusLabel) (int, error) {
+			label.CommonFields = common(label.CommonFields)
+			label.AssetsCount = 0
+			created, _, err := c.StatusLabels.CreateContext(ctx, label)
+			if err != nil {
+				return 0, err
+			}
+			return created.ID, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	if err := r.matchFieldsets(ctx, archive.Fieldsets); err != nil {
+		return err
+	}
+	fieldsets := make(map[int]int)
+	for _, fieldset := range archive.Fieldsets {
+		for _, model := range fieldset.Models.Rows {
+			fieldsets[model.ID] = fieldset.ID
+		}
+	}
+
+	err = restoreAll(ctx, r, KindModels, archive.Models,
+		func(model snipeit.Model) (int, string) { return named(model.CommonFields) },
+		c.Models.IterateContext(ctx, nil),
+		func(ctx context.Context, model snipeit.Model) (int, error) {
+			fieldset, ok := fieldsets[model.ID]
+			if !ok {
+				fieldset = model.FieldsetID
+			}
+			model.FieldsetID = r.ref(KindFieldsets, fieldset)
+			model.CategoryID = r.ref(KindCategories, model.Category.ID)
+			model.ManufacturerID = r.ref(KindManufacturers, model.Manufacturer.ID)
+			model.CommonFields = common(model.CommonFields)
+			model.Category, model.Manufacturer = snipeit.Category{}, snipeit.Manufacturer{}
+			model.AssetsCount = 0
+			created, _, err := c.Models.CreateContext(ctx, model)
+			if err != nil {
+				return 0, err
+			}
+			return created.ID, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	users := parentsFirst(archive.Users,
+		func(user snipeit.User) int { return user.ID },
+		func(user snipeit.User) int { return idOf(user.Manager) })
+	err = restoreAll(ctx, r, KindUsers, users,
+		func(user snipeit.User) (int, string) { return user.ID, user.Username },
+		c.Users.IterateContext(ctx, nil),
+		func(ctx context.Context, user snipeit.User) (int, error) {
+			password, err := r.password()
+			if err != nil {
+				return 0, err
+			}
+			user.Password, user.PasswordConfirmation = password, password
+			user.ManagerID = r.ref(KindUsers, idOf(user.Manager))
+			user.DepartmentID = r.ref(KindDepartments, idOf(user.Department))
+			user.CompanyID = r.ref(KindCompanies, idOf(user.Company))
+			user.LocationID = r.ref(KindLocations, idOf(user.Location))
+			user.CommonFields = common(user.CommonFields)
+			user.Manager, user.Department, user.Company, user.Location = nil, nil, nil, nil
+			user.Groups, user.LastLogin = nil, nil
+			created, _, err := c.Users.CreateContext(ctx, user)
+			if err != nil {
+				return 0, err
+			}
+			return created.ID, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	for _, department := range archive.Departments {
+		id := r.ref(KindDepartments, department.ID)
+		managerID := r.ref(KindUsers, idOf(department.Manager))
+		created, ok := departments[id]
+		if !ok || managerID == 0 {
+			continue
+		}
+		created.ManagerID = managerID
+		if _, _, err := c.Departments.UpdateContext(ctx, id, created); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			r.fail(KindDepartments, department.ID, department.Name, fmt.Errorf("setting manager: %w", err))
+		}
+	}
+
+	err = restoreAll(ctx, r, KindLicenses, archive.Licenses,
+		func(license snipeit.License) (int, string) { return named(license.CommonFields) },
+		c.Licenses.IterateContext(ctx, nil),
+		func(ctx context.Context, license snipeit.License) (int, error) {
+			license.CategoryID = r.ref(KindCategories, license.Category.ID)
+			license.ManufacturerID = r.ref(KindManufacturers, license.Manufacturer.ID)
+			license.SupplierID = r.ref(KindSuppliers, idOf(license.Supplier))
+			license.CompanyID = r.ref(KindCompanies, idOf(license.Company))
+			license.CommonFields = common(license.CommonFields)
+			license.Category, license.Manufacturer = snipeit.Category{}, snipeit.Manufacturer{}
+			license.Supplier, license.Company = nil, nil
+			license.FreeSeatsCount = 0
+			created, _, err := c.Licenses.CreateContext(ctx, license)
+			if err != nil {
+				return 0, err
+			}
+			return created.ID, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	err = restoreAll(ctx, r, KindAccessories, archive.Accessories,
+		func(accessory snipeit.Accessory) (int, string) { return named(accessory.CommonFields) },
+		c.Accessories.IterateContext(ctx, nil),
+		func(ctx context.Context, accessory snipeit.Accessory) (int, error) {
+			accessory.CategoryID = r.ref(KindCategories, accessory.Category.ID)
+			accessory.ManufacturerID = r.ref(KindManufacturers, accessory.Manufacturer.ID)
+			accessory.SupplierID = r.ref(KindSuppliers, idOf(accessory.Supplier))
+			accessory.LocationID = r.ref(KindLocations, idOf(accessory.Location))
+			accessory.CompanyID = r.ref(KindCompanies, idOf(accessory.Company))
+			accessory.CommonFields = common(accessory.CommonFields)
+			accessory.Category, accessory.Manufacturer = snipeit.Category{}, snipeit.Manufacturer{}
+			accessory.Supplier, accessory.Location, accessory.Company = nil, nil, nil
+			accessory.RemainingQty = 0
+			created, _, err := c.Accessories.CreateContext(ctx, accessory)
+			if err != nil {
+				return 0, err
+			}
+			return created.ID, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	if err := r.loadColumns(ctx, archive.Assets); err != nil {
+		return err
+	}
+	err = restoreAll(ctx, r, KindAssets, archive.Assets,
+		func(asset snipeit.Asset) (int, string) { return asset.ID, asset.AssetTag },
+		c.Assets.IterateContext(ctx, nil),
+		func(ctx context.Context, asset snipeit.Asset) (int, error) {
+			created, _, err := c.Assets.CreateContext(ctx, r.assetRequest(asset))
+			if err != nil {
+				return 0, err
+			}
+			return created.ID, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	if r.opts.SkipCheckouts {
+		return nil
+	}
+	return r.checkout(ctx, archive.Assets)
+}
+
+// restoreAll restores items, the resources of one kind, in order. key
+// returns the archive ID of an item and the name it is matched by,
+// existing lists the resources already on the server, for MatchExisting,
+// and create creates an item and returns its ID on the server.
+func restoreAll[T any](ctx context.Context, r *restorer, kind Kind, items []T, key func(item T) (int, string), existing iter.Seq2[T, error], create func(ctx context.Context, item T) (int, error)) error {
+	var found map[string]int
+	if r.opts.MatchExisting && len(items) > 0 {
+		found = make(map[string]int)
+		for item, err := range existing {
+			if err != nil {
+				return fmt.Errorf("snapshot: listing existing %s: %w", kind, err)
+			}
+			id, name := key(item)
+			if _, ok := found[normalize(name)]; !ok && name != "" {
+				found[normalize(name)] = id
+			}
+		}
+	}
+
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		id, name := key(item)
+		if newID, ok := found[normalize(name)]; ok && name != "" {
+			r.match(kind, id, newID)
+			continue
+		}
+
+		newID, err := create(ctx, item)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			return ctx.Err()
+		case err != nil:
+			r.fail(kind, id, name, err)
+		default:
+			r.set(kind, id, newID)
+			r.result.Created[kind]++
+		}
+	}
+	return nil
+}
+
+// matchFieldsets maps the fieldsets of the archive to the fieldsets of the
+// same name on the server, which are never created.
+func (r *restorer) matchFieldsets(ctx context.Context, fieldsets []snipeit.Fieldset) error {
+	if len(fieldsets) == 0 {
+		return nil
+	}
+
+	existing := make(map[string]int)
+	for fieldset, err := range r.client.Fieldsets.IterateContext(ctx, nil) {
+		if err != nil {
+			return fmt.Errorf("snapshot: listing existing %s: %w", KindFieldsets, err)
+		}
+		existing[normalize(fieldset.Name)] = fieldset.ID
+	}
+
+	for _, fieldset := range fieldsets {
+		if id, ok := existing[normalize(fieldset.Name)]; ok {
+			r.match(KindFieldsets, fieldset.ID, id)
+		} else {
+			r.fail(KindFieldsets, fieldset.ID, fieldset.Name, errors.New("no fieldset of this name on the server; create it before restoring"))
+		}
+	}
+	return nil
+}
+
+// loadColumns loads the database columns of the custom fields on the
+// server, if assets have custom field values.
+func (r *restorer) loadColumns(ctx context.Context, assets []snipeit.Asset) error {
+	r.columns = make(map[string]string)
+	for _, asset := range assets {
+		if len(asset.CustomFields) == 0 {
+			continue
+		}
+		for field, err := range r.client.Fields.IterateContext(ctx, nil) {
+			if err != nil {
+				return fmt.Errorf("snapshot: listing custom fields: %w", err)
+			}
+			r.columns[normalize(field.Name)] = field.DBColumnName
+		}
+		return nil
+	}
+	return nil
+}
+
+// assetRequest returns the request creating asset on the server.
+func (r *restorer) assetRequest(asset snipeit.Asset) snipeit.AssetCreateRequest {
+	req := snipeit.AssetCreateRequest{
+		ModelID:        r.ref(KindModels, asset.Model.ID),
+		StatusID:       r.ref(KindStatusLabels, asset.StatusLabel.ID),
+		AssetTag:       asset.AssetTag,
+		Name:           asset.Name,
+		Serial:         asset.Serial,
+		CompanyID:      r.ref(KindCompanies, idOf(asset.Company)),
+		RTDLocationID:  r.ref(KindLocations, idOf(asset.RTDLocation)),
+		SupplierID:     r.ref(KindSuppliers, idOf(asset.Supplier)),
+		OrderNumber:    asset.OrderNumber,
+		PurchaseCost:   asset.PurchaseCost,
+		WarrantyMonths: asset.WarrantyMonths,
+		Requestable:    bool(asset.Requestable),
+		Notes:          asset.Notes,
+	}
+
+	// The location of a checked-out asset follows what it is assigned to
+	if asset.AssignedTo == nil {
+		req.LocationID = r.ref(KindLocations, idOf(asset.Location))
+	}
+	if asset.PurchaseDate != nil && !asset.PurchaseDate.IsZero() {
+		date := snipeit.DateOf(asset.PurchaseDate.Time)
+		req.PurchaseDate = &date
+	}
+	if asset.NextAuditDate != nil && !asset.NextAuditDate.IsZero() {
+		date := snipeit.DateOf(asset.NextAuditDate.Time)
+		req.NextAuditDate = &date
+	}
+
+	for name, value := range asset.CustomFields {
+		if column, ok := r.columns[normalize(name)]; ok && value.Value != "" {
+			if req.CustomFieldValues == nil {
+				req.CustomFieldValues = make(map[string]string)
+			}
+			req.CustomFieldValues[column] = value.Value
+		}
+	}
+	return req
+}
+
+// checkout checks the created assets out again to what they were assigned
+// to in the archive. Assets matched to existing assets are left as they are.
+func (r *restorer) checkout(ctx context.Context, assets []snipeit.Asset) error {
+	for _, asset := range assets {
+		id := r.ref(KindAssets, asset.ID)
+		if asset.AssignedTo == nil || id == 0 || r.matched[KindAssets][asset.ID] {
+			continue
+		}
+
+		var opts snipeit.CheckoutOptions
+		var target int
+		switch asset.AssignedTo.Kind() {
+		case snipeit.AssignedTypeUser:
+			target = r.ref(KindUsers, asset.AssignedTo.ID())
+			opts.CheckoutToUser = target
+		case snipeit.AssignedTypeLocation:
+			target = r.ref(KindLocations, asset.AssignedTo.ID())
+			opts.CheckoutToLocation = target
+		case snipeit.AssignedTypeAsset:
+			target = r.ref(KindAssets, asset.AssignedTo.ID())
+			opts.CheckoutToAsset = target
+		}
+		if target == 0 {
+			r.fail(KindAssets, asset.ID, asset.AssetTag, fmt.Errorf("checkout: %s %q was not restored", asset.AssignedTo.Kind(), asset.AssignedTo.Name()))
+			continue
+		}
+
+		if asset.LastCheckout != nil && !asset.LastCheckout.IsZero() {
+			opts.CheckoutAt = snipeit.DateOf(asset.LastCheckout.Time)
+		}
+		if asset.ExpectedCheckin != nil && !asset.ExpectedCheckin.IsZero() {
+			opts.ExpectedCheckin = snipeit.DateOf(asset.ExpectedCheckin.Time)
+		}
+
+		if _, _, err := r.client.Assets.CheckoutWithOptionsContext(ctx, id, opts); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			r.fail(KindAssets, asset.ID, asset.AssetTag, fmt.Errorf("checkout: %w", err))
+		}
+	}
+	return nil
+}
+
+// password returns the password of a restored user.
+func (r *restorer) password() (string, error) {
+	if r.opts.Password != "" {
+		return r.opts.Password, nil
+	}
+	b := make([]byte, 18)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// ref returns the ID on the server of the resource of kind with the given
+// archive ID, or 0 if there is none or it was not restored.
+func (r *restorer) ref(kind Kind, id int) int {
+	if id == 0 {
+		return 0
+	}
+	return r.result.IDs[kind][id]
+}
+
+// set records that the resource of kind with the given archive ID has
+// newID on the server.
+func (r *restorer) set(kind Kind, id, newID int) {
+	ids, ok := r.result.IDs[kind]
+	if !ok {
+		ids = make(map[int]int)
+		r.result.IDs[kind] = ids
+	}
+	ids[id] = newID
+}
+
+// match records that the resource of kind with the given archive ID was
+// matched to the existing resource newID.
+func (r *restorer) match(kind Kind, id, newID int) {
+	r.set(kind, id, newID)
+	r.result.Matched[kind]++
+	if r.matched[kind] == nil {
+		r.matched[kind] = make(map[int]bool)
+	}
+	r.matched[kind][id] = true
+}
+
+// fail records that a resource could not be restored.
+func (r *restorer) fail(kind Kind, id int, name string, err error) {
+	r.result.Failures = append(r.result.Failures, Failure{Kind: kind, ID: id, Name: name, Err: err})
+}
+
+// common returns the common fields of a resource to create: its name and
+// notes.
+func common(fields snipeit.CommonFields) snipeit.CommonFields {
+	return snipeit.CommonFields{Name: fields.Name, Notes: fields.Notes}
+}
+
+// normalize returns the form of a name used to match resources.
+func normalize(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// locationParent returns the archive ID of the parent of location, or 0.
+func locationParent(location snipeit.Location) int {
+	if location.ParentID != 0 {
+		return location.ParentID
+	}
+	return idOf(location.Parent)
+}
+
+// idOf returns the ID of a related resource, or 0 if there is none.
+func idOf(related interface{}) int {
+	switch related := related.(type) {
+	case *snipeit.Company:
+		if related != nil {
+			return related.ID
+		}
+	case *snipeit.Department:
+		if related != nil {
+			return related.ID
+		}
+	case *snipeit.Location:
+		if related != nil {
+			return related.ID
+		}
+	case *snipeit.Supplier:
+		if related != nil {
+			return related.ID
+		}
+	case *snipeit.User:
+		if related != nil {
+			return related.ID
+		}
+	}
+	return 0
+}
+
+// parentsFirst returns items ordered so that each item comes after its
+// parent, keeping their order otherwise. id returns the archive ID of an
+// item, and parent the archive ID of its parent, or 0. Items in a cycle
+// come last.
+func parentsFirst[T any](items []T, id, parent func(item T) int) []T {
+	present := make(map[int]bool, len(items))
+	for _, item := range items {
+		present[id(item)] = true
+	}
+
+	done := make(map[int]bool, len(items))
+	ordered := make([]T, 0, len(items))
+	remaining := items
+	for len(remaining) > 0 {
+		var next []T
+		for _, item := range remaining {
+			if p := parent(item); p == 0 || !present[p] || done[p] {
+				ordered = append(ordered, item)
+				done[id(item)] = true
+			} else {
+				next = append(next, item)
+			}
+		}
+		if len(next) == len(remaining) {
+			return append(ordered, next...)
+		}
+		remaining = next
+	}
+	return ordered
+}
@@ -0,0 +1,151 @@
+// Package snapshot saves the inventory of a Snipe-IT instance to a single
+// JSON archive and recreates it on the same or another instance, as an
+// application-level backup independent of the server's database backups.
+//
+// Dump writes every asset, user, license and accessory, along with the
+// reference data they use (companies, departments, categories,
+// manufacturers, suppliers, locations, status labels, models and custom
+// fieldsets):
+//
+//	file, _ := os.Create("snipeit-snapshot.json")
+//	defer file.Close()
+//	if err := snapshot.Dump(ctx, client, file); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// Restore recreates the contents of an archive, mapping the IDs of the
+// archive to the IDs of the resources it creates, so that every reference
+// between them is preserved:
+//
+//	file, _ := os.Open("snipeit-snapshot.json")
+//	result, err := snapshot.Restore(ctx, client, file, snapshot.RestoreOptions{MatchExisting: true})
+//	if err != nil {
+//	    log.Fatal(err) // the archive could not be read
+//	}
+//	for _, failure := range result.Failures {
+//	    log.Print(failure)
+//	}
+//
+// Archives do not hold passwords, files, uploaded images, license seat
+// assignments, accessory checkouts or the history of the activity log,
+// which the API does not expose or cannot recreate.
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"time"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// FormatVersion is the version of the archive format written by Dump.
+// Read accepts archives of this version and earlier.
+const FormatVersion = 1
+
+// Archive is the contents of a snapshot.
+type Archive struct {
+	// Version is the version of the archive format
+	Version int `json:"version"`
+
+	// CreatedAt is when the snapshot was taken
+	CreatedAt time.Time `json:"created_at"`
+
+	Companies     []snipeit.Company      `json:"companies"`
+	Departments   []snipeit.Department   `json:"departments"`
+	Categories    []snipeit.Category     `json:"categories"`
+	Manufacturers []snipeit.Manufacturer `json:"manufacturers"`
+	Suppliers     []snipeit.Supplier     `json:"suppliers"`
+	Locations     []snipeit.Location     `json:"locations"`
+	StatusLabels  []snipeit.StatusLabel  `json:"status_labels"`
+	Fieldsets     []snipeit.Fieldset     `json:"fieldsets"`
+	Models        []snipeit.Model        `json:"models"`
+	Users         []snipeit.User         `json:"users"`
+	Licenses      []snipeit.License      `json:"licenses"`
+	Accessories   []snipeit.Accessory    `json:"accessories"`
+	Assets        []snipeit.Asset        `json:"assets"`
+}
+
+// Dump writes a snapshot of the inventory of client to w as a JSON Archive.
+//
+// Resources are written as they are fetched, so that large inventories are
+// never held in memory at once. An error stops the dump and is returned;
+// w then holds an incomplete archive, which Read rejects.
+func Dump(ctx context.Context, client *snipeit.Client, w io.Writer) error {
+	createdAt, _ := json.Marshal(time.Now().UTC())
+	aw := &archiveWriter{w: w}
+	aw.printf("{\n%q: %d,\n%q: %s", "version", FormatVersion, "created_at", createdAt)
+
+	writeSection(aw, "companies", client.Companies.IterateContext(ctx, nil))
+	writeSection(aw, "departments", client.Departments.IterateContext(ctx, nil))
+	writeSection(aw, "categories", client.Categories.IterateContext(ctx, nil))
+	writeSection(aw, "manufacturers", client.Manufacturers.IterateContext(ctx, nil))
+	writeSection(aw, "suppliers", client.Suppliers.IterateContext(ctx, nil))
+	writeSection(aw, "locations", client.Locations.IterateContext(ctx, nil))
+	writeSection(aw, "status_labels", client.StatusLabels.IterateContext(ctx, nil))
+	writeSection(aw, "fieldsets", client.Fieldsets.IterateContext(ctx, nil))
+	writeSection(aw, "models", client.Models.IterateContext(ctx, nil))
+	writeSection(aw, "users", client.Users.IterateContext(ctx, nil))
+	writeSection(aw, "licenses", client.Licenses.IterateContext(ctx, nil))
+	writeSection(aw, "accessories", client.Accessories.IterateContext(ctx, nil))
+	writeSection(aw, "assets", client.Assets.IterateContext(ctx, nil))
+
+	aw.printf("\n}\n")
+	return aw.err
+}
+
+// Read reads an Archive written by Dump from r.
+func Read(r io.Reader) (*Archive, error) {
+	var archive Archive
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return nil, fmt.Errorf("snapshot: reading archive: %w", err)
+	}
+	if archive.Version < 1 || archive.Version > FormatVersion {
+		return nil, fmt.Errorf("snapshot: unsupported archive version %d", archive.Version)
+	}
+	return &archive, nil
+}
+
+// archiveWriter writes an archive, keeping the first error.
+type archiveWriter struct {
+	w   io.Writer
+	err error
+}
+
+// printf writes to the archive unless an error occurred.
+func (aw *archiveWriter) printf(format string, args ...interface{}) {
+	if aw.err == nil {
+		_, aw.err = fmt.Fprintf(aw.w, format, args...)
+	}
+}
+
+// writeSection writes the resources of seq as the array named name, one
+// resource per line.
+func writeSection[T any](aw *archiveWriter, name string, seq iter.Seq2[T, error]) {
+	if aw.err != nil {
+		return
+	}
+
+	aw.printf(",\n%q: [", name)
+	sep := "\n"
+	for item, err := range seq {
+		if err != nil {
+			aw.err = fmt.Errorf("snapshot: listing %s: %w", name, err)
+			return
+		}
+		data, err := json.Marshal(item)
+		if err != nil {
+			aw.err = fmt.Errorf("snapshot: encoding %s: %w", name, err)
+			return
+		}
+		aw.printf("%s%s", sep, data)
+		if aw.err != nil {
+			return
+		}
+		sep = ",\n"
+	}
+	aw.printf("\n]")
+}
@@ -0,0 +1,348 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// inventory is a Snipe-IT instance served by a test server, holding the
+// resources of each endpoint as JSON objects.
+type inventory struct {
+	mu        sync.Mutex
+	nextID    int
+	resources map[string][]map[string]interface{}
+	checkouts map[int]map[string]interface{}
+}
+
+// add adds resource, which must have an ID, to endpoint.
+func (inv *inventory) add(endpoint string, resource map[string]interface{}) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	inv.resources[endpoint] = append(inv.resources[endpoint], resource)
+}
+
+// find returns the resource of endpoint whose key is value, or nil.
+func (inv *inventory) find(endpoint, key string, value interface{}) map[string]interface{} {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	for _, resource := range inv.resources[endpoint] {
+		if resource[key] == value {
+			return resource
+		}
+	}
+	return nil
+}
+
+// id returns the ID of the resource of endpoint whose key is value, or 0.
+func (inv *inventory) id(endpoint, key string, value interface{}) float64 {
+	resource := inv.find(endpoint, key, value)
+	if resource == nil {
+		return 0
+	}
+	return resource["id"].(float64)
+}
+
+// ServeHTTP lists, creates and updates the resources, and records asset
+// checkouts. Resources named "Broken" are rejected.
+func (inv *inventory) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/")
+	endpoint := parts[0]
+	var body map[string]interface{}
+	if r.Body != nil {
+		json.NewDecoder(r.Body).Decode(&body)
+	}
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		query := r.URL.Query()
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		resources := inv.resources[endpoint]
+		rows := []map[string]interface{}{}
+		for i := offset; i < len(resources) && len(rows) < limit; i++ {
+			rows = append(rows, resources[i])
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"total": len(resources), "rows": rows})
+
+	case len(parts) == 1 && r.Method == http.MethodPost:
+		if body["name"] == "Broken" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"status": "error", "messages": map[string]interface{}{"name": []string{"The name is invalid."}}})
+			return
+		}
+		body["id"] = float64(inv.nextID)
+		inv.nextID++
+		inv.resources[endpoint] = append(inv.resources[endpoint], body)
+		json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "payload": body})
+
+	case len(parts) == 2 && r.Method == http.MethodPut:
+		id, _ := strconv.Atoi(parts[1])
+		for _, resource := range inv.resources[endpoint] {
+			if resource["id"] == float64(id) {
+				for key, value := range body {
+					if key != "id" {
+						resource[key] = value
+					}
+				}
+				json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "payload": resource})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+
+	case len(parts) == 3 && parts[2] == "checkout" && r.Method == http.MethodPost:
+		id, _ := strconv.Atoi(parts[1])
+		inv.checkouts[id] = body
+		json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "payload": map[string]interface{}{"asset": id}})
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// setup starts a test server for a new inventory, whose created resources
+// get IDs from firstID on.
+func setup(t *testing.T, firstID int) (*snipeit.Client, *inventory) {
+	t.Helper()
+	inv := &inventory{
+		nextID:    firstID,
+		resources: make(map[string][]map[string]interface{}),
+		checkouts: make(map[int]map[string]interface{}),
+	}
+	server := httptest.NewServer(inv)
+	t.Cleanup(server.Close)
+
+	client, err := snipeit.NewClientWithOptions(server.URL, "test-token", &snipeit.ClientOptions{DisableRetries: true})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	return client, inv
+}
+
+// ref returns a reference to the resource with the given ID, as the API
+// nests related resources.
+func ref(id int) map[string]interface{} {
+	return map[string]interface{}{"id": id}
+}
+
+func TestDumpRestore(t *testing.T) {
+	sourceClient, source := setup(t, 1000)
+	source.add("companies", map[string]interface{}{"id": 1, "name": "Acme"})
+	source.add("categories", map[string]interface{}{"id": 2, "name": "Laptops", "category_type": "asset"})
+	source.add("manufacturers", map[string]interface{}{"id": 3, "name": "Dell"})
+	source.add("suppliers", map[string]interface{}{"id": 4, "name": "CDW"})
+	// Children may be listed before their parent
+	source.add("locations", map[string]interface{}{"id": 6, "name": "Floor 2", "parent": map[string]interface{}{"id": 5, "name": "HQ"}})
+	source.add("locations", map[string]interface{}{"id": 5, "name": "HQ"})
+	source.add("departments", map[string]interface{}{"id": 7, "name": "IT", "company": ref(1), "location": ref(5), "manager": ref(21)})
+	source.add("statuslabels", map[string]interface{}{"id": 8, "name": "Ready", "type": "deployable"})
+	source.add("fieldsets", map[string]interface{}{"id": 9, "name": "Laptop", "models": map[string]interface{}{"total": 1, "rows": []interface{}{map[string]interface{}{"id": 10, "name": "Latitude"}}}})
+	source.add("models", map[string]interface{}{"id": 10, "name": "Latitude", "category": ref(2), "manufacturer": ref(3)})
+	source.add("users", map[string]interface{}{"id": 22, "username": "bob", "manager": ref(21), "department": ref(7), "company": ref(1), "location": ref(6), "groups": map[string]interface{}{"total": 1, "rows": []interface{}{map[string]interface{}{"id": 1, "name": "Admins"}}}})
+	source.add("users", map[string]interface{}{"id": 21, "username": "alice", "department": ref(7)})
+	source.add("licenses", map[string]interface{}{"id": 30, "name": "Office", "seats": 5, "category": ref(2), "manufacturer": ref(3), "supplier": ref(4), "company": ref(1)})
+	source.add("accessories", map[string]interface{}{"id": 31, "name": "Dock", "qty": 3, "category": ref(2), "manufacturer": ref(3), "location": ref(5)})
+	source.add("hardware", map[string]interface{}{
+		"id": 40, "asset_tag": "A-1", "model": ref(10), "status_label": ref(8),
+		"location": ref(6), "rtd_location": ref(5),
+		"assigned_to":   map[string]interface{}{"id": 22, "type": "user", "username": "bob", "name": "Bob"},
+		"last_checkout": map[string]interface{}{"datetime": "2024-02-01 10:00:00"},
+		"purchase_date": map[string]interface{}{"date": "2024-01-15"},
+		"custom_fields": map[string]interface{}{"Warranty Provider": map[string]interface{}{"field": "_snipeit_warranty_1", "value": "Dell ProSupport"}},
+	})
+	source.add("hardware", map[string]interface{}{"id": 41, "asset_tag": "A-2", "model": ref(10), "status_label": ref(8), "location": ref(6)})
+
+	targetClient, target := setup(t, 100)
+	target.add("fieldsets", map[string]interface{}{"id": 50, "name": "Laptop"})
+	target.add("fields", map[string]interface{}{"id": 51, "name": "Warranty Provider", "db_column_name": "_snipeit_warranty_provider_4"})
+
+	var buf bytes.Buffer
+	if err := Dump(context.Background(), sourceClient, &buf); err != nil {
+		t.Fatalf("Dump returned error: %v", err)
+	}
+	archive, err := Read(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("Read returned error: %v", err)
+	}
+	if len(archive.Locations) != 2 || len(archive.Users) != 2 || len(archive.Assets) != 2 {
+		t.Errorf("Read returned %d locations, %d users and %d assets, expected 2 of each", len(archive.Locations), len(archive.Users), len(archive.Assets))
+	}
+
+	result, err := Restore(context.Background(), targetClient, &buf, RestoreOptions{Password: "correct-horse"})
+	if err != nil {
+		t.Fatalf("Restore returned error: %v", err)
+	}
+	if err := result.Err(); err != nil {
+		t.Errorf("Restore reported failures: %v", err)
+	}
+	if result.Created[KindAssets] != 2 || result.Matched[KindFieldsets] != 1 {
+		t.Errorf("Restore created %d assets and matched %d fieldsets, expected 2 and 1", result.Created[KindAssets], result.Matched[KindFieldsets])
+	}
+
+	acme := target.id("companies", "name", "Acme")
+	laptops := target.id("categories", "name", "Laptops")
+	hq := target.id("locations", "name", "HQ")
+	floor := target.id("locations", "name", "Floor 2")
+	it := target.id("departments", "name", "IT")
+	alice := target.id("users", "username", "alice")
+	bob := target.id("users", "username", "bob")
+	model := target.id("models", "name", "Latitude")
+	if acme == 0 || laptops == 0 || hq == 0 || floor == 0 || it == 0 || alice == 0 || bob == 0 || model == 0 {
+		t.Fatalf("Restore did not create every resource: %v", target.resources)
+	}
+	if got := result.ID(KindUsers, 22); got != int(bob) {
+		t.Errorf("Result.ID of bob = %d, expected %v", got, bob)
+	}
+
+	checks := []struct {
+		endpoint, key string
+		value         interface{}
+		field         string
+		want          interface{}
+	}{
+		{"categories", "name", "Laptops", "category_type", "asset"},
+		{"locations", "name", "Floor 2", "parent_id", hq},
+		{"departments", "name", "IT", "company_id", acme},
+		{"departments", "name", "IT", "location_id", hq},
+		{"departments", "name", "IT", "manager_id", alice},
+		{"models", "name", "Latitude", "category_id", laptops},
+		{"models", "name", "Latitude", "fieldset_id", float64(50)},
+		{"users", "username", "bob", "manager_id", alice},
+		{"users", "username", "bob", "department_id", it},
+		{"users", "username", "bob", "location_id", floor},
+		{"users", "username", "bob", "password", "correct-horse"},
+		{"users", "username", "bob", "groups", nil},
+		{"licenses", "name", "Office", "company_id", acme},
+		{"licenses", "name", "Office", "supplier_id", target.id("suppliers", "name", "CDW")},
+		{"accessories", "name", "Dock", "location_id", hq},
+		{"hardware", "asset_tag", "A-1", "model_id", model},
+		{"hardware", "asset_tag", "A-1", "status_id", target.id("statuslabels", "name", "Ready")},
+		{"hardware", "asset_tag", "A-1", "rtd_location_id", hq},
+		{"hardware", "asset_tag", "A-1", "location_id", nil},
+		{"hardware", "asset_tag", "A-1", "purchase_date", "2024-01-15"},
+		{"hardware", "asset_tag", "A-1", "_snipeit_warranty_provider_4", "Dell ProSupport"},
+		{"hardware", "asset_tag", "A-2", "location_id", floor},
+	}
+	for _, check := range checks {
+		resource := target.find(check.endpoint, check.key, check.value)
+		if got := resource[check.field]; got != check.want {
+			t.Errorf("%s %v has %s = %v, expected %v", check.endpoint, check.value, check.field, got, check.want)
+		}
+	}
+
+	checkout := target.checkouts[int(target.id("hardware", "asset_tag", "A-1"))]
+	if checkout["assigned_user"] != bob || checkout["checkout_at"] != "2024-02-01" {
+		t.Errorf("A-1 checked out with %v, expected to bob on 2024-02-01", checkout)
+	}
+	if len(target.checkouts) != 1 {
+		t.Errorf("Restore checked out %d assets, expected 1", len(target.checkouts))
+	}
+}
+
+func TestRestoreMatchExisting(t *testing.T) {
+	client, target := setup(t, 100)
+	target.add("companies", map[string]interface{}{"id": 50, "name": " ACME "})
+	target.add("users", map[string]interface{}{"id": 51, "username": "bob"})
+
+	archive := &Archive{
+		Version:   FormatVersion,
+		Companies: []snipeit.Company{{CommonFields: snipeit.CommonFields{ID: 1, Name: "Acme"}}},
+		Users: []snipeit.User{
+			{CommonFields: snipeit.CommonFields{ID: 2}, Username: "bob"},
+			{CommonFields: snipeit.CommonFields{ID: 3}, Username: "carol", Company: &snipeit.Company{CommonFields: snipeit.CommonFields{ID: 1}}},
+		},
+	}
+
+	result, err := RestoreArchive(context.Background(), client, archive, RestoreOptions{MatchExisting: true})
+	if err != nil {
+		t.Fatalf("RestoreArchive returned error: %v", err)
+	}
+
+	if result.ID(KindCompanies, 1) != 50 || result.ID(KindUsers, 2) != 51 {
+		t.Errorf("RestoreArchive mapped Acme to %d and bob to %d, expected the existing 50 and 51", result.ID(KindCompanies, 1), result.ID(KindUsers, 2))
+	}
+	if result.Matched[KindUsers] != 1 || result.Created[KindUsers] != 1 || result.Created[KindCompanies] != 0 {
+		t.Errorf("RestoreArchive created %v and matched %v, expected only carol created", result.Created, result.Matched)
+	}
+
+	carol := target.find("users", "username", "carol")
+	if carol == nil || carol["company_id"] != float64(50) {
+		t.Fatalf("carol = %v, expected her created in company 50", carol)
+	}
+	if password, _ := carol["password"].(string); len(password) < 16 || carol["password_confirmation"] != password {
+		t.Errorf("carol has password %q, expected a random password", password)
+	}
+}
+
+func TestRestoreFailures(t *testing.T) {
+	client, target := setup(t, 100)
+
+	archive := &Archive{
+		Version:    FormatVersion,
+		Categories: []snipeit.Category{{CommonFields: snipeit.CommonFields{ID: 2, Name: "Broken"}, CategoryType: "asset"}},
+		Fieldsets:  []snipeit.Fieldset{{CommonFields: snipeit.CommonFields{ID: 9, Name: "Missing"}}},
+		Models: []snipeit.Model{{
+			CommonFields: snipeit.CommonFields{ID: 10, Name: "Latitude"},
+			Category:     snipeit.Category{CommonFields: snipeit.CommonFields{ID: 2}},
+			FieldsetID:   9,
+		}},
+	}
+
+	result, err := RestoreArchive(context.Background(), client, archive, RestoreOptions{})
+	if err != nil {
+		t.Fatalf("RestoreArchive returned error: %v", err)
+	}
+
+	if len(result.Failures) != 2 {
+		t.Fatalf("RestoreArchive reported %v, expected the category and the fieldset", result.Failures)
+	}
+	var errResp *snipeit.ErrorResponse
+	if failure := result.Failures[0]; failure.Kind != KindCategories || failure.ID != 2 || !errors.As(failure, &errResp) {
+		t.Errorf("Failures[0] = %+v, expected the API error creating the category", failure)
+	}
+	if failure := result.Failures[1]; failure.Kind != KindFieldsets || failure.Name != "Missing" {
+		t.Errorf("Failures[1] = %+v, expected the missing fieldset", failure)
+	}
+	if !errors.As(result.Err(), new(Failure)) {
+		t.Errorf("Result.Err() = %v, expected it to join the failures", result.Err())
+	}
+
+	model := target.find("models", "name", "Latitude")
+	if model == nil || model["category_id"] != nil || model["fieldset_id"] != nil {
+		t.Errorf("Model = %v, expected it created without category and fieldset", model)
+	}
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		archive string
+		wantErr bool
+	}{
+		{"current", `{"version": 1, "assets": [{"id": 1, "asset_tag": "A-1"}]}`, false},
+		{"newer", `{"version": 2}`, true},
+		{"missing version", `{"assets": []}`, true},
+		{"truncated", "{\n\"version\": 1,\n\"assets\": [\n{\"id\": 1}", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tt.archive))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Read returned error %v, expected error: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
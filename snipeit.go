@@ -34,19 +34,30 @@ import (
     "fmt"
     "io"
     "math/rand"
+    "mime/multipart"
     "net/http"
     "net/url"
+    "os"
     "reflect"
+    "sort"
     "strings"
     "time"
 
     "github.com/google/go-querystring/query"
 )
 
+// Version is the version of this client library.
+const Version = "0.1.0"
+
+// defaultUserAgent is the User-Agent sent when ClientOptions.UserAgent is empty.
+const defaultUserAgent = "go-snipeit/" + Version
+
 // Client manages communication with the Snipe-IT API.
 //
 // Each service of the Snipe-IT API is exposed as a field on the Client struct.
 // For example, to access the assets endpoint, use client.Assets.
+// Client implements ClientInterface, whose methods return the services as
+// interfaces that can be mocked in tests.
 type Client struct {
     // HTTP client used to communicate with the API
     client  *http.Client
@@ -57,10 +68,103 @@ type Client struct {
     // Base URL for API requests
     BaseURL *url.URL
 
+    // User-Agent header sent with every request
+    userAgent string
+
+    // Additional headers sent with every request
+    headers http.Header
+
+    // Header carrying a correlation ID on every request, if enabled
+    requestIDHeader string
+
+    // KeepRawBody, if true, leaves response bodies readable
+    keepRawBody bool
+
+    // Offline queue for mutating requests, if enabled
+    queue *offlineQueue
+
+    // StrictDecoding, if true, reports response fields the models lack
+    strictDecoding bool
+
+    // CaptureRawJSON, if true, keeps the JSON each model was decoded from
+    captureRawJSON bool
+
+    // ValidateRequests, if true, validates request bodies before sending
+    validateRequests bool
+
+    // Time zone of times sent without one, if not UTC
+    serverTimezone *time.Location
+
+    // Locks serializing FindOrCreate calls for the same name, shared with
+    // derived clients
+    nameLocks *nameLocks
+
+    // Hooks notified of requests, responses and retries
+    onRequest  func(req *http.Request)
+    onResponse func(req *http.Request, resp *http.Response, err error, elapsed time.Duration)
+    onRetry    func(attempt int, req *http.Request, resp *http.Response, err error, wait time.Duration)
+
     // Services for different parts of the Snipe-IT API
+    // Accessories is the service for interacting with the accessories endpoint
+    Accessories *AccessoriesService
+
     // Assets is the service for interacting with the assets endpoint
     Assets *AssetsService
 
+    // Categories is the service for interacting with the categories endpoint
+    Categories *CategoriesService
+
+    // Companies is the service for interacting with the companies endpoint
+    Companies *CompaniesService
+
+    // Consumables is the service for interacting with the consumables endpoint
+    Consumables *ConsumablesService
+
+    // Departments is the service for interacting with the departments endpoint
+    Departments *DepartmentsService
+
+    // Depreciations is the service for interacting with the depreciations endpoint
+    Depreciations *DepreciationsService
+
+    // Fields is the service for interacting with the custom fields endpoint
+    Fields *CustomFieldsService
+
+    // Fieldsets is the service for interacting with the custom fieldsets endpoint
+    Fieldsets *FieldsetsService
+
+    // Kits is the service for interacting with the predefined kits endpoint
+    Kits *KitsService
+
+    // Licenses is the service for interacting with the licenses endpoint
+    Licenses *LicensesService
+
+    // Locations is the service for interacting with the locations endpoint
+    Locations *LocationsService
+
+    // Maintenances is the service for interacting with the asset maintenances endpoint
+    Maintenances *MaintenancesService
+
+    // Manufacturers is the service for interacting with the manufacturers endpoint
+    Manufacturers *ManufacturersService
+
+    // Models is the service for interacting with the asset models endpoint
+    Models *ModelsService
+
+    // Reports is the service for interacting with the reports endpoint
+    Reports *ReportsService
+
+    // Settings is the service for interacting with the settings endpoint
+    Settings *SettingsService
+
+    // StatusLabels is the service for interacting with the status labels endpoint
+    StatusLabels *StatusLabelsService
+
+    // Suppliers is the service for interacting with the suppliers endpoint
+    Suppliers *SuppliersService
+
+    // Users is the service for interacting with the users endpoint
+    Users *UsersService
+
     // Rate limiter for controlling request frequency
     rateLimiter RateLimiter
     
@@ -69,6 +173,17 @@ type Client struct {
     
     // DisableRetries, if true, disables automatic retries for failed requests
     disableRetries bool
+
+    // Cache for GET responses, if enabled, and how long they are reused
+    cache    Cache
+    cacheTTL time.Duration
+
+    // Keys of the cached responses by URL path
+    cacheIndex *cacheIndex
+
+    // Debug, if true, dumps every request and response to dumper
+    debug  bool
+    dumper *wireDumper
 }
 
 // NewClient returns a new Snipe-IT API client.
@@ -145,12 +260,53 @@ func NewClientWithOptions(baseURL, token string, options *ClientOptions) (*Clien
     
     c.client = options.HTTPClient
     if c.client == nil {
-        c.client = &http.Client{}
+        c.client = newHTTPClient(options)
+    }
+    
+    // Use the provided transport, keeping the HTTP client's other settings
+    if options.Transport != nil {
+        httpClient := *c.client
+        httpClient.Transport = options.Transport
+        c.client = &httpClient
+    }
+    
+    // Configure TLS
+    if options.TLS != nil {
+        c.client, err = tlsHTTPClient(c.client, options.TLS)
+        if err != nil {
+            return nil, err
+        }
     }
     
     c.token = "Bearer " + token
     c.BaseURL = baseEndpoint
     
+    c.userAgent = options.UserAgent
+    if c.userAgent == "" {
+        c.userAgent = defaultUserAgent
+    }
+    c.headers = options.Headers.Clone()
+    c.requestIDHeader = options.RequestIDHeader
+    c.keepRawBody = options.KeepRawBody
+    c.strictDecoding = options.StrictDecoding
+    c.captureRawJSON = options.CaptureRawJSON
+    c.validateRequests = options.ValidateRequests
+    c.serverTimezone = options.ServerTimezone
+    c.nameLocks = &nameLocks{}
+    if options.OfflineQueue != nil {
+        c.queue = &offlineQueue{store: options.OfflineQueue}
+    }
+    c.onRequest = options.OnRequest
+    c.onResponse = options.OnResponse
+    c.onRetry = options.OnRetry
+    
+    // Configure the response cache
+    c.cache = options.Cache
+    c.cacheTTL = options.CacheTTL
+    if c.cache != nil {
+        c.cacheIndex = &cacheIndex{}
+    }
+    
     // Configure rate limiting
     c.rateLimiter = options.RateLimiter
     
@@ -162,12 +318,44 @@ func NewClientWithOptions(baseURL, token string, options *ClientOptions) (*Clien
         c.retryPolicy = options.RetryPolicy
     }
     
+    // Configure wire dumps
+    c.debug = options.Debug
+    dumpWriter := options.DumpWriter
+    if dumpWriter == nil {
+        dumpWriter = os.Stderr
+    }
+    c.dumper = &wireDumper{w: dumpWriter}
+    
     // Initialize services
-    c.Assets = &AssetsService{client: c}
+    c.initServices()
     
     return c, nil
 }
 
+// initServices creates the services of the client, which all share it.
+func (c *Client) initServices() {
+    c.Accessories = &AccessoriesService{client: c}
+    c.Assets = &AssetsService{client: c}
+    c.Categories = &CategoriesService{client: c}
+    c.Companies = &CompaniesService{client: c}
+    c.Consumables = &ConsumablesService{client: c}
+    c.Departments = &DepartmentsService{client: c}
+    c.Depreciations = &DepreciationsService{client: c}
+    c.Fields = &CustomFieldsService{client: c}
+    c.Fieldsets = &FieldsetsService{client: c}
+    c.Kits = &KitsService{client: c}
+    c.Licenses = &LicensesService{client: c}
+    c.Locations = &LocationsService{client: c}
+    c.Maintenances = &MaintenancesService{client: c}
+    c.Manufacturers = &ManufacturersService{client: c}
+    c.Models = &ModelsService{client: c}
+    c.Reports = &ReportsService{client: c}
+    c.Settings = &SettingsService{client: c}
+    c.StatusLabels = &StatusLabelsService{client: c}
+    c.Suppliers = &SuppliersService{client: c}
+    c.Users = &UsersService{client: c}
+}
+
 // DoWithOptions sends an API request with the provided request options and returns the API response.
 //
 // req is the HTTP request to send.
@@ -177,12 +365,18 @@ func NewClientWithOptions(baseURL, token string, options *ClientOptions) (*Clien
 // If opts is nil, the client's default options will be used.
 // If opts.Context is nil, the request's context will be used.
 //
-// If the response status code is not in the 2xx range, an ErrorResponse is returned.
+// If the response status code is not in the 2xx range, or the response body is a
+// JSON object with a status of "error", an ErrorResponse is returned.
 // Otherwise, if v is not nil, the response body is JSON decoded into v.
 //
 // The provided request and returned response are for debugging purposes only and
 // should not be directly modified.
 func (c *Client) DoWithOptions(req *http.Request, v interface{}, opts *RequestOptions) (*http.Response, error) {
+    // Send mutating requests through the offline queue, if enabled
+    if c.queue != nil && isMutating(req.Method) && (opts == nil || !opts.skipQueue) {
+        return c.doQueued(req, v, opts)
+    }
+    
     ctx := req.Context()
     if opts != nil && opts.Context != nil {
         ctx = opts.Context
@@ -190,6 +384,17 @@ func (c *Client) DoWithOptions(req *http.Request, v interface{}, opts *RequestOp
     
     req = req.WithContext(ctx)
     
+    // Add the headers for this request, on a copy so req is left untouched
+    if opts != nil && len(opts.Headers) > 0 {
+        req = req.Clone(ctx)
+        setHeaders(req.Header, opts.Headers)
+    }
+    
+    // Answer GET requests from the cache while their response is fresh
+    if cached := c.freshResponse(req, v); cached != nil {
+        return newCacheHit(req, cached), c.decode(req, cached.Body, v)
+    }
+    
     // Apply rate limiting if configured
     if c.rateLimiter != nil {
         if err := c.rateLimiter.Wait(ctx); err != nil {
@@ -203,9 +408,47 @@ func (c *Client) DoWithOptions(req *http.Request, v interface{}, opts *RequestOp
         disableRetries = true
     }
     
+    // Only retry requests that are safe to repeat, unless the caller allows it
+    if c.retryPolicy != nil && !c.retryPolicy.retryable(req.Method) && (opts == nil || !opts.AllowRetry) {
+        disableRetries = true
+    }
+    
+    // Attach a correlation ID, from the context or generated, to the request
+    // and its context
+    if c.requestIDHeader != "" && req.Header.Get(c.requestIDHeader) == "" {
+        id := RequestIDFromContext(ctx)
+        if id == "" {
+            var err error
+            id, err = newRandomID()
+            if err != nil {
+                return nil, err
+            }
+            ctx = WithRequestID(ctx, id)
+        }
+        req = req.Clone(ctx)
+        req.Header.Set(c.requestIDHeader, id)
+    }
+    
+    // Send an idempotency key with requests that create or change resources,
+    // so that a server or proxy supporting it can discard duplicates
+    if name := c.retryPolicy.idempotencyKeyHeader(req.Method); name != "" && req.Header.Get(name) == "" {
+        key, err := newRandomID()
+        if err != nil {
+            return nil, err
+        }
+        req = req.Clone(ctx)
+        req.Header.Set(name, key)
+    }
+    
+    // Determine if this request should be dumped for debugging
+    dumper := c.requestDumper(opts)
+    
+    // Determine if the response body should remain readable
+    keepRawBody := c.keepRawBody || (opts != nil && opts.KeepRawBody)
+    
     // If retries are disabled or no retry policy is set, just make a single request
     if disableRetries || c.retryPolicy == nil {
-        return c.doOnce(ctx, req, v)
+        return c.doOnce(ctx, req, v, dumper, keepRawBody)
     }
     
     // Initialize retry variables
@@ -218,7 +461,8 @@ func (c *Client) DoWithOptions(req *http.Request, v interface{}, opts *RequestOp
     backoff := retryPolicy.InitialBackoff
     
     // Make the initial request
-    resp, err = c.doOnce(ctx, req, v)
+    start := time.Now()
+    resp, err = c.doOnce(ctx, req, v, dumper, keepRawBody)
     
     // Retry loop
     for retries := 0; retries < retryPolicy.MaxRetries; retries++ {
@@ -228,32 +472,16 @@ func (c *Client) DoWithOptions(req *http.Request, v interface{}, opts *RequestOp
             break
         }
         
-        // Log retry attempt (could use a logger here instead of fmt)
-        //fmt.Printf("Retrying request to %s after error: %v (attempt %d/%d)\n", 
-        //    req.URL.String(), err, retries+1, retryPolicy.MaxRetries)
-        
-        // Wait before retrying
+        // Determine how long to wait before retrying
+        var waitTime time.Duration
         if retryAfter > 0 {
             // Use the Retry-After header value
-            waitTime := retryAfter
-            select {
-            case <-ctx.Done():
-                return resp, ctx.Err()
-            case <-time.After(waitTime):
-                // Continue with retry
-            }
+            waitTime = retryAfter
         } else {
             // Calculate backoff with jitter
             jitterRange := backoff.Seconds() * retryPolicy.Jitter
             jitter := time.Duration(rand.Float64() * jitterRange * float64(time.Second))
-            waitTime := backoff - jitter
-            
-            select {
-            case <-ctx.Done():
-                return resp, ctx.Err()
-            case <-time.After(waitTime):
-                // Continue with retry
-            }
+            waitTime = backoff - jitter
             
             // Increase backoff for next time
             backoff = time.Duration(float64(backoff) * retryPolicy.BackoffMultiplier)
@@ -262,6 +490,24 @@ func (c *Client) DoWithOptions(req *http.Request, v interface{}, opts *RequestOp
             }
         }
         
+        // Give up if waiting would exceed the retry budget
+        if retryPolicy.MaxElapsedTime > 0 && time.Since(start)+waitTime > retryPolicy.MaxElapsedTime {
+            return resp, retryBudgetError(err)
+        }
+        
+        // Notify the application of the retry
+        if c.onRetry != nil {
+            c.onRetry(retries+1, req, resp, err, waitTime)
+        }
+        
+        // Wait before retrying
+        select {
+        case <-ctx.Done():
+            return resp, ctx.Err()
+        case <-time.After(waitTime):
+            // Continue with retry
+        }
+        
         // Create a new request for each retry to ensure a fresh request
         retryReq := req.Clone(ctx)
         
@@ -274,16 +520,53 @@ func (c *Client) DoWithOptions(req *http.Request, v interface{}, opts *RequestOp
             retryReq.Body = body
         }
         
+        // Retries are rate limited like the initial request
+        if c.rateLimiter != nil {
+            if err := c.rateLimiter.Wait(ctx); err != nil {
+                return resp, err
+            }
+        }
+        
         // Make the retry request
-        resp, err = c.doOnce(ctx, retryReq, v)
+        resp, err = c.doOnce(ctx, retryReq, v, dumper, keepRawBody)
     }
     
     return resp, err
 }
 
 // doOnce performs a single API request without any retry logic.
-func (c *Client) doOnce(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
-    resp, err := c.client.Do(req)
+// If dumper is not nil, the request and response are dumped to it.
+// If keepRawBody is true, the body of the returned response can be read
+// again, unless it was written to v.
+// The OnRequest and OnResponse hooks are called around the attempt.
+func (c *Client) doOnce(ctx context.Context, req *http.Request, v interface{}, dumper *wireDumper, keepRawBody bool) (resp *http.Response, err error) {
+    // Revalidate cached responses to GET requests that decode JSON
+    var key string
+    var cached *CachedResponse
+    if c.cacheable(req, v) {
+        key = cacheKey(req)
+        if entry, ok := c.cache.Get(key); ok && entry.hasValidators() {
+            cached = entry
+            req = req.Clone(ctx)
+            addValidators(req, cached)
+        }
+    }
+
+    if c.onRequest != nil {
+        c.onRequest(req)
+    }
+    if c.onResponse != nil {
+        start := time.Now()
+        defer func() {
+            c.onResponse(req, resp, err, time.Since(start))
+        }()
+    }
+
+    if dumper != nil {
+        dumper.dumpRequest(req)
+    }
+
+    resp, err = c.client.Do(req)
     if err != nil {
         // If the error is due to context cancellation or deadline exceeded,
         // return that specific error
@@ -296,58 +579,187 @@ func (c *Client) doOnce(ctx context.Context, req *http.Request, v interface{}) (
     }
     defer resp.Body.Close()
 
+    if dumper != nil {
+        dumper.dumpResponse(resp)
+    }
+
+    // A 304 Not Modified response is a cache hit
+    if cached != nil && resp.StatusCode == http.StatusNotModified {
+        c.cache.Set(key, cached.refreshed())
+        if keepRawBody {
+            resp.Body = io.NopCloser(bytes.NewReader(cached.Body))
+        }
+        return resp, c.decode(req, cached.Body, v)
+    }
+
     // If StatusCode is not in the 200 range, something went wrong
-    if c := resp.StatusCode; 200 > c || c > 299 {
-        errorResponse := &ErrorResponse{Response: resp}
+    if code := resp.StatusCode; 200 > code || code > 299 {
+        errorResponse := &ErrorResponse{Response: resp, RequestID: c.requestID(req)}
         data, err := io.ReadAll(resp.Body)
         if err == nil && data != nil {
+            errorResponse.Body = data
             json.Unmarshal(data, errorResponse)
         }
+        if keepRawBody {
+            resp.Body = io.NopCloser(bytes.NewReader(data))
+        }
         return resp, errorResponse
     }
 
-    if v != nil {
-        if w, ok := v.(io.Writer); ok {
-            _, err = io.Copy(w, resp.Body)
-        } else {
-            decErr := json.NewDecoder(resp.Body).Decode(v)
-            if decErr == io.EOF {
-                decErr = nil // Ignore EOF errors caused by an empty response body
-            }
-            if decErr != nil {
-                err = decErr
+    if w, ok := v.(io.Writer); ok {
+        _, err = io.Copy(w, resp.Body)
+        return resp, err
+    }
+
+    // Streams decode the body as it arrives, so it is not kept
+    if stream, ok := v.(streamDecoder); ok {
+        err = stream.decodeStream(resp.Body, c.streamHook(req))
+        var errorResponse *ErrorResponse
+        if errors.As(err, &errorResponse) {
+            errorResponse.Response = resp
+            errorResponse.RequestID = c.requestID(req)
+        }
+        return resp, err
+    }
+
+    data, err := io.ReadAll(resp.Body)
+    if err != nil {
+        return resp, err
+    }
+    if keepRawBody {
+        resp.Body = io.NopCloser(bytes.NewReader(data))
+    }
+
+    // Snipe-IT reports many failures, such as checking out an asset that
+    // is already deployed, with a 2xx status and a status of "error"
+    if errorResponse := statusError(resp, data); errorResponse != nil {
+        errorResponse.RequestID = c.requestID(req)
+        return resp, errorResponse
+    }
+
+    if key != "" {
+        if entry := c.newCachedResponse(resp, data); entry != nil {
+            c.cache.Set(key, entry)
+            c.cacheIndex.add(req.URL, key)
+        }
+    }
+
+    // A successful write makes the cached responses it may have changed
+    // stale
+    if c.cache != nil && req.Method != http.MethodGet && req.Method != http.MethodHead {
+        c.cacheIndex.evict(c.cache, req.URL)
+    }
+
+    return resp, c.decode(req, data, v)
+}
+
+// decode decodes data, the body of the response to req, into v. In strict
+// decoding mode, it reports fields that v does not model, and it keeps the
+// raw JSON of each model if CaptureRawJSON is set.
+func (c *Client) decode(req *http.Request, data []byte, v interface{}) error {
+    if err := decodeJSON(data, v); err != nil || v == nil || len(bytes.TrimSpace(data)) == 0 {
+        return err
+    }
+
+    if c.serverTimezone != nil {
+        localizeTimes(data, v, c.serverTimezone)
+    }
+
+    if c.strictDecoding {
+        if err := checkUnknownFields(req, data, v); err != nil {
+            return err
+        }
+    }
+    if c.captureRawJSON {
+        captureRawJSON(data, v)
+    }
+    return nil
+}
+
+// streamHook returns the function called with each item decoded from a
+// stream of responses to req, which applies the server time zone, strict
+// decoding and raw JSON capture like decode, or nil if none is enabled.
+func (c *Client) streamHook(req *http.Request) func(data []byte, v interface{}, path string) error {
+    if !c.strictDecoding && !c.captureRawJSON && c.serverTimezone == nil {
+        return nil
+    }
+
+    return func(data []byte, v interface{}, path string) error {
+        if c.serverTimezone != nil {
+            localizeTimes(data, v, c.serverTimezone)
+        }
+        if c.strictDecoding {
+            if err := checkUnknownFieldsAt(req, data, v, path); err != nil {
+                return err
             }
         }
+        if c.captureRawJSON {
+            captureRawJSON(data, v)
+        }
+        return nil
+    }
+}
+
+// decodeJSON decodes data into v, unless v is nil.
+// An empty body is not an error.
+func decodeJSON(data []byte, v interface{}) error {
+    if v == nil {
+        return nil
     }
 
-    return resp, err
+    err := json.NewDecoder(bytes.NewReader(data)).Decode(v)
+    if err == io.EOF {
+        err = nil // Ignore EOF errors caused by an empty response body
+    }
+    return retryLenient(data, v, err)
+}
+
+// statusError returns an ErrorResponse if data is a JSON object whose
+// status field is "error", and nil otherwise.
+func statusError(resp *http.Response, data []byte) *ErrorResponse {
+    trimmed := bytes.TrimSpace(data)
+    if len(trimmed) == 0 || trimmed[0] != '{' {
+        return nil
+    }
+
+    var envelope struct {
+        Status interface{} `json:"status"`
+    }
+    if err := json.Unmarshal(trimmed, &envelope); err != nil || envelope.Status != "error" {
+        return nil
+    }
+
+    errorResponse := &ErrorResponse{Response: resp, Body: data}
+    json.Unmarshal(trimmed, errorResponse)
+    return errorResponse
 }
 
 // shouldRetry determines if a request should be retried based on the response, error, and retry policy.
 func (c *Client) shouldRetry(resp *http.Response, err error, policy *RetryPolicy) (bool, time.Duration) {
-    // Don't retry if there's no error and the response is in the 2xx range
-    if err == nil && resp != nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+    // A custom decision replaces the rules below; Retry-After is still honored
+    if policy.RetryIf != nil {
+        if !policy.RetryIf(resp, err) {
+            return false, 0
+        }
+        return true, retryAfter(resp, policy)
+    }
+    
+    // Don't retry if the response is in the 2xx range. The request reached
+    // the server, so any error comes from the body (e.g., a status of "error")
+    // and retrying could repeat an action that was already applied.
+    if resp != nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
         return false, 0
     }
     
     // Check for retryable status codes
     if resp != nil && policy.RetryableStatusCodes[resp.StatusCode] {
-        // Check for Retry-After header
-        if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
-            // Try to parse as seconds
-            if seconds, err := time.ParseDuration(retryAfter + "s"); err == nil {
-                return true, seconds
-            }
-            
-            // Try to parse as HTTP date
-            if date, err := time.Parse(time.RFC1123, retryAfter); err == nil {
-                delay := time.Until(date)
-                if delay > 0 {
-                    return true, delay
-                }
-            }
-        }
-        return true, 0
+        return true, retryAfter(resp, policy)
+    }
+    
+    // Don't retry API errors whose status code is not retryable
+    var errorResponse *ErrorResponse
+    if errors.As(err, &errorResponse) {
+        return false, 0
     }
     
     // Retry on network errors, except for context cancellation
@@ -391,6 +803,12 @@ func (c *Client) newRequestWithContext(ctx context.Context, method, urlStr strin
         return nil, err
     }
 
+    if v, ok := body.(validator); ok && c.validateRequests {
+        if err := v.Validate(); err != nil {
+            return nil, err
+        }
+    }
+
     var buf io.ReadWriter
     if body != nil {
         buf = new(bytes.Buffer)
@@ -409,28 +827,203 @@ func (c *Client) newRequestWithContext(ctx context.Context, method, urlStr strin
     req.Header.Set("Accept", "application/json")
     req.Header.Set("Content-Type", "application/json")
     req.Header.Set("Authorization", c.token)
+    req.Header.Set("User-Agent", c.userAgent)
+    setHeaders(req.Header, c.headers)
 
     return req, nil
 }
 
+// newUploadRequest creates a multipart/form-data API request that uploads a file.
+//
+// ctx is the context for the request.
+// urlStr is the URL path relative to the BaseURL (e.g., "api/v1/licenses/1/files").
+// field is the form field name for the file (e.g., "file[]").
+// filename is the name of the file as sent to the server.
+// r is read to the end and buffered, so the request can be retried.
+// params are additional form fields to send alongside the file.
+//
+// The resulting request will include the proper authentication headers.
+func (c *Client) newUploadRequest(ctx context.Context, urlStr, field, filename string, r io.Reader, params map[string]string) (*http.Request, error) {
+    u, err := c.BaseURL.Parse(strings.TrimPrefix(urlStr, "/"))
+    if err != nil {
+        return nil, err
+    }
+
+    buf := new(bytes.Buffer)
+    mw := multipart.NewWriter(buf)
+    part, err := mw.CreateFormFile(field, filename)
+    if err != nil {
+        return nil, err
+    }
+    if _, err := io.Copy(part, r); err != nil {
+        return nil, err
+    }
+    for name, value := range params {
+        if err := mw.WriteField(name, value); err != nil {
+            return nil, err
+        }
+    }
+    if err := mw.Close(); err != nil {
+        return nil, err
+    }
+
+    req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), buf)
+    if err != nil {
+        return nil, err
+    }
+    req.Header.Set("Accept", "application/json")
+    req.Header.Set("Content-Type", mw.FormDataContentType())
+    req.Header.Set("Authorization", c.token)
+    req.Header.Set("User-Agent", c.userAgent)
+    setHeaders(req.Header, c.headers)
+
+    return req, nil
+}
+
+// setHeaders sets the headers in src on dst, replacing any existing values.
+func setHeaders(dst, src http.Header) {
+    for name, values := range src {
+        dst.Del(name)
+        for _, value := range values {
+            dst.Add(name, value)
+        }
+    }
+}
+
+// Sentinel errors matched by an ErrorResponse, based on its HTTP status code.
+// Use errors.Is to check for them:
+//
+//	if errors.Is(err, snipeit.ErrNotFound) { ... }
+var (
+    // ErrNotFound is matched by 404 Not Found responses
+    ErrNotFound = errors.New("snipeit: resource not found")
+
+    // ErrUnauthorized is matched by 401 Unauthorized responses
+    ErrUnauthorized = errors.New("snipeit: unauthorized")
+
+    // ErrForbidden is matched by 403 Forbidden responses
+    ErrForbidden = errors.New("snipeit: forbidden")
+
+    // ErrRateLimited is matched by 429 Too Many Requests responses
+    ErrRateLimited = errors.New("snipeit: rate limited")
+
+    // ErrValidation is matched by 422 Unprocessable Entity responses,
+    // which Snipe-IT returns when the submitted data is invalid
+    ErrValidation = errors.New("snipeit: validation failed")
+)
+
+// statusErrors maps HTTP status codes to the sentinel errors they match.
+var statusErrors = map[int]error{
+    http.StatusNotFound:            ErrNotFound,
+    http.StatusUnauthorized:        ErrUnauthorized,
+    http.StatusForbidden:           ErrForbidden,
+    http.StatusTooManyRequests:     ErrRateLimited,
+    http.StatusUnprocessableEntity: ErrValidation,
+}
+
 // ErrorResponse represents an error response from the Snipe-IT API.
 //
 // Snipe-IT API error responses typically contain a message explaining
 // what went wrong, which is captured in the Message field.
+// An ErrorResponse matches the sentinel error for its status code, such as
+// ErrNotFound, with errors.Is.
 type ErrorResponse struct {
     // Response is the HTTP response that generated the error
     Response *http.Response
     
     // Message is the error message returned by the Snipe-IT API
     Message  string `json:"message"`
+
+    // FieldErrors holds the validation errors for each rejected field,
+    // keyed by field name, when the API returns messages as an object
+    FieldErrors map[string][]string `json:"-"`
+
+    // Body is the raw response body, for logging failures verbatim
+    Body []byte `json:"-"`
+
+    // RequestID is the correlation ID sent with the request, if
+    // ClientOptions.RequestIDHeader is set
+    RequestID string `json:"-"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler for ErrorResponse.
+//
+// Snipe-IT reports errors in either a "message" or a "messages" field.
+// "messages" is a string for most errors, but an object mapping each
+// field name to one or more messages on validation failure; the object
+// form is decoded into FieldErrors.
+func (e *ErrorResponse) UnmarshalJSON(data []byte) error {
+    var body struct {
+        Message  string          `json:"message"`
+        Messages json.RawMessage `json:"messages"`
+    }
+    if err := json.Unmarshal(data, &body); err != nil {
+        return err
+    }
+    e.Message = body.Message
+
+    var message string
+    var fields map[string]json.RawMessage
+    switch {
+    case len(body.Messages) == 0 || string(body.Messages) == "null":
+    case json.Unmarshal(body.Messages, &message) == nil:
+        if e.Message == "" {
+            e.Message = message
+        }
+    case json.Unmarshal(body.Messages, &fields) == nil:
+        e.FieldErrors = make(map[string][]string, len(fields))
+        for field, raw := range fields {
+            var messages []string
+            if err := json.Unmarshal(raw, &messages); err != nil {
+                var single string
+                if err := json.Unmarshal(raw, &single); err != nil {
+                    return fmt.Errorf("snipeit: decoding messages for field %q: %w", field, err)
+                }
+                messages = []string{single}
+            }
+            e.FieldErrors[field] = messages
+        }
+    }
+
+    return nil
 }
 
 // Error returns a string representation of the error.
 // It implements the error interface.
 func (e *ErrorResponse) Error() string {
-    return fmt.Sprintf("%v %v: %d %v",
+    msg := fmt.Sprintf("%v %v: %d %v",
         e.Response.Request.Method, e.Response.Request.URL,
         e.Response.StatusCode, e.Message)
+
+    if len(e.FieldErrors) > 0 {
+        fields := make([]string, 0, len(e.FieldErrors))
+        for field := range e.FieldErrors {
+            fields = append(fields, field)
+        }
+        sort.Strings(fields)
+
+        details := make([]string, len(fields))
+        for i, field := range fields {
+            details[i] = field + ": " + strings.Join(e.FieldErrors[field], " ")
+        }
+        msg = strings.TrimSpace(msg) + " (" + strings.Join(details, "; ") + ")"
+    }
+
+    if e.RequestID != "" {
+        msg = strings.TrimSpace(msg) + " [request ID " + e.RequestID + "]"
+    }
+
+    return msg
+}
+
+// Is reports whether target is the sentinel error for the response's
+// status code. It allows errors.Is(err, ErrNotFound) and similar checks.
+func (e *ErrorResponse) Is(target error) bool {
+    if e.Response == nil {
+        return false
+    }
+    sentinel, ok := statusErrors[e.Response.StatusCode]
+    return ok && target == sentinel
 }
 
 // Do sends an API request and returns the API response.
@@ -440,7 +1033,8 @@ func (e *ErrorResponse) Error() string {
 // If v implements the io.Writer interface, the raw response body will be written to it
 // without attempting to parse it as JSON.
 //
-// If the response status code is not in the 2xx range, an ErrorResponse is returned.
+// If the response status code is not in the 2xx range, or the response body is a
+// JSON object with a status of "error", an ErrorResponse is returned.
 // Otherwise, if v is not nil, the response body is JSON decoded into v.
 //
 // The provided request and returned response are for debugging purposes only and
@@ -457,7 +1051,8 @@ func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 // If v implements the io.Writer interface, the raw response body will be written to it
 // without attempting to parse it as JSON.
 //
-// If the response status code is not in the 2xx range, an ErrorResponse is returned.
+// If the response status code is not in the 2xx range, or the response body is a
+// JSON object with a status of "error", an ErrorResponse is returned.
 // Otherwise, if v is not nil, the response body is JSON decoded into v.
 //
 // The provided request and returned response are for debugging purposes only and
@@ -500,3 +1095,20 @@ func (c *Client) AddOptions(s string, opt interface{}) (string, error) {
     u.RawQuery = qs.Encode()
     return u.String(), nil
 }
+
+// retryAfter returns the wait requested by the Retry-After header of resp,
+// capped by policy.MaxRetryAfter, or zero if there is none.
+func retryAfter(resp *http.Response, policy *RetryPolicy) time.Duration {
+    if resp == nil {
+        return 0
+    }
+    
+    delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+    if !ok {
+        return 0
+    }
+    if policy.MaxRetryAfter > 0 && delay > policy.MaxRetryAfter {
+        delay = policy.MaxRetryAfter
+    }
+    return delay
+}
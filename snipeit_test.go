@@ -1,11 +1,15 @@
 package snipeit
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -180,6 +184,54 @@ func TestNewClientWithHTTPClient(t *testing.T) {
 	}
 }
 
+// roundTripperFunc adapts a function to an http.RoundTripper.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewClientWithTransport(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	trips := 0
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		trips++
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	httpClient := &http.Client{
+		Timeout: 5 * time.Second,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{HTTPClient: httpClient, Transport: transport})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if _, _, err := client.Assets.Get(1); err != nil {
+		t.Fatalf("Assets.Get returned error: %v", err)
+	}
+
+	if trips != 1 {
+		t.Errorf("transport handled %d requests, expected %d", trips, 1)
+	}
+	if client.client.Timeout != 5*time.Second || client.client.CheckRedirect == nil {
+		t.Error("NewClientWithOptions did not keep the HTTP client's timeout and redirect policy")
+	}
+	if httpClient.Transport != nil {
+		t.Error("NewClientWithOptions modified the provided HTTP client")
+	}
+}
+
 func TestNewRequest(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -245,6 +297,7 @@ func TestNewRequest(t *testing.T) {
 				"Accept":        "application/json",
 				"Content-Type":  "application/json",
 				"Authorization": client.token,
+				"User-Agent":    "go-snipeit/" + Version,
 			}
 			for header, value := range expectedHeaders {
 				if got := req.Header.Get(header); got != value {
@@ -261,6 +314,75 @@ func TestNewRequest(t *testing.T) {
 	}
 }
 
+func TestClientUserAgent(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "User-Agent", "inventory-sync/2.1")
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{UserAgent: "inventory-sync/2.1"})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if _, _, err := client.Assets.Get(1); err != nil {
+		t.Fatalf("Assets.Get returned error: %v", err)
+	}
+}
+
+func TestClientHeaders(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	var got []http.Header
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Clone())
+		fmt.Fprint(w, `{"total": 0, "rows": []}`)
+	})
+
+	headers := http.Header{}
+	headers.Set("X-Trace-Id", "client")
+	headers.Set("Proxy-Authorization", "Basic cHJveHk6c2VjcmV0")
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{Headers: headers})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	req, _ := client.newRequest("GET", "api/v1/hardware", nil)
+	requestHeaders := http.Header{}
+	requestHeaders.Set("X-Trace-Id", "request")
+	requestHeaders.Set("X-Request-Only", "yes")
+	if _, err := client.DoWithOptions(req, nil, &RequestOptions{Headers: requestHeaders}); err != nil {
+		t.Fatalf("DoWithOptions returned error: %v", err)
+	}
+
+	if _, _, err := client.Assets.List(nil); err != nil {
+		t.Fatalf("Assets.List returned error: %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("server received %d requests, expected %d", len(got), 2)
+	}
+
+	if got[0].Get("X-Trace-Id") != "request" || got[0].Get("X-Request-Only") != "yes" {
+		t.Errorf("first request headers = %v, expected the per-request headers", got[0])
+	}
+	if got[0].Get("Proxy-Authorization") != "Basic cHJveHk6c2VjcmV0" {
+		t.Errorf("first request Proxy-Authorization = %q, expected the client header", got[0].Get("Proxy-Authorization"))
+	}
+
+	if got[1].Get("X-Trace-Id") != "client" || got[1].Get("X-Request-Only") != "" {
+		t.Errorf("second request headers = %v, expected only the client headers", got[1])
+	}
+
+	if req.Header.Get("X-Request-Only") != "" {
+		t.Errorf("DoWithOptions modified the request headers")
+	}
+}
+
 func TestDo(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -320,6 +442,201 @@ func TestDo(t *testing.T) {
 	}
 }
 
+func TestDoNoRetryOnClientError(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"status":"error","messages":"Asset does not exist."}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("Error creating client: %v", err)
+	}
+
+	req, err := client.newRequest("GET", "/test", nil)
+	if err != nil {
+		t.Fatalf("Error creating request: %v", err)
+	}
+
+	if _, err := client.Do(req, nil); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Do returned error %v, expected %v", err, ErrNotFound)
+	}
+
+	if attempts != 1 {
+		t.Errorf("Expected %d attempts, got %d", 1, attempts)
+	}
+}
+
+func TestDoStatusErrorWithSuccessCode(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/api/v1/hardware/1/checkout", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"status": "error", "messages": "That asset is not available for checkout!", "payload": {"asset": "LAPTOP-001"}}`)
+	})
+
+	var response AssetResponse
+	req, _ := client.newRequest("POST", "api/v1/hardware/1/checkout", nil)
+	resp, err := client.Do(req, &response)
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("Do() error = %v, expected an *ErrorResponse", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Do() status = %d, expected %d", resp.StatusCode, http.StatusOK)
+	}
+
+	if errorResponse.Message != "That asset is not available for checkout!" {
+		t.Errorf("ErrorResponse.Message = %q, expected %q", errorResponse.Message, "That asset is not available for checkout!")
+	}
+
+	if requests != 1 {
+		t.Errorf("Do() made %d requests, expected %d", requests, 1)
+	}
+}
+
+func TestDoKeepRawBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	const body = `{"id": 1, "name": "Laptop"}`
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	})
+
+	var asset Asset
+	req, _ := client.newRequest("GET", "api/v1/hardware/1", nil)
+	resp, err := client.DoWithOptions(req, &asset, &RequestOptions{KeepRawBody: true})
+	if err != nil {
+		t.Fatalf("DoWithOptions returned error: %v", err)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading the response body returned error: %v", err)
+	}
+	if string(raw) != body {
+		t.Errorf("response body = %q, expected %q", raw, body)
+	}
+	if asset.Name != "Laptop" {
+		t.Errorf("DoWithOptions decoded Name = %q, expected %q", asset.Name, "Laptop")
+	}
+}
+
+func TestErrorResponseBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.disableRetries = true
+
+	const body = `<html><body>Whoops, looks like something went wrong.</body></html>`
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, body)
+	})
+
+	_, _, err := client.Assets.Get(1)
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("Assets.Get error = %v, expected an *ErrorResponse", err)
+	}
+	if string(errorResponse.Body) != body {
+		t.Errorf("ErrorResponse.Body = %q, expected %q", errorResponse.Body, body)
+	}
+}
+
+func TestErrorResponseIs(t *testing.T) {
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrForbidden, ErrRateLimited, ErrValidation}
+
+	tests := []struct {
+		status   int
+		expected error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusUnprocessableEntity, ErrValidation},
+		{http.StatusInternalServerError, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+			client.disableRetries = true
+
+			mux.HandleFunc("/api/v1/error", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, `{"message":"error"}`)
+			})
+
+			req, _ := client.newRequest("GET", "api/v1/error", nil)
+			_, err := client.Do(req, nil)
+			err = fmt.Errorf("wrapped: %w", err)
+
+			for _, sentinel := range sentinels {
+				if got := errors.Is(err, sentinel); got != (sentinel == tt.expected) {
+					t.Errorf("errors.Is(err, %v) = %v, expected %v", sentinel, got, !got)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorResponseFieldErrors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.disableRetries = true
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"status": "error", "messages": {"asset_tag": ["The asset tag field is required."], "model_id": "The selected model id is invalid."}, "payload": null}`)
+	})
+
+	req, _ := client.newRequest("POST", "api/v1/hardware", nil)
+	_, err := client.Do(req, nil)
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("Do() error type = %T, expected *ErrorResponse", err)
+	}
+
+	expected := map[string][]string{
+		"asset_tag": {"The asset tag field is required."},
+		"model_id":  {"The selected model id is invalid."},
+	}
+	if !reflect.DeepEqual(errorResponse.FieldErrors, expected) {
+		t.Errorf("ErrorResponse.FieldErrors = %v, expected %v", errorResponse.FieldErrors, expected)
+	}
+
+	if !strings.Contains(err.Error(), "asset_tag: The asset tag field is required.; model_id: The selected model id is invalid.") {
+		t.Errorf("ErrorResponse.Error() = %q, expected it to list the field errors", err.Error())
+	}
+}
+
+func TestErrorResponseMessagesString(t *testing.T) {
+	var errorResponse ErrorResponse
+	if err := json.Unmarshal([]byte(`{"status": "error", "messages": "Asset does not exist."}`), &errorResponse); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	if errorResponse.Message != "Asset does not exist." {
+		t.Errorf("ErrorResponse.Message = %q, expected %q", errorResponse.Message, "Asset does not exist.")
+	}
+
+	if errorResponse.FieldErrors != nil {
+		t.Errorf("ErrorResponse.FieldErrors = %v, expected nil", errorResponse.FieldErrors)
+	}
+}
+
 func TestAddOptions(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
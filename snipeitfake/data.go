@@ -0,0 +1,76 @@
+package snipeitfake
+
+import "github.com/michellepellon/go-snipeit"
+
+// Word lists used to build realistic names. They are deliberately small;
+// uniqueness comes from IDs, serials and asset tags rather than names.
+
+var manufacturerNames = []string{
+	"Apple", "Dell", "Lenovo", "HP", "Microsoft", "Samsung", "Cisco", "Logitech", "Asus", "Acer",
+}
+
+var modelSeries = []string{
+	"ProBook", "Latitude", "ThinkPad", "EliteBook", "Surface", "Precision", "OptiPlex", "Galaxy", "ZenBook", "Aspire",
+}
+
+var categoryNames = map[snipeit.CategoryType][]string{
+	snipeit.CategoryTypeAsset:      {"Laptops", "Desktops", "Monitors", "Phones", "Tablets", "Servers", "Printers"},
+	snipeit.CategoryTypeAccessory:  {"Keyboards", "Mice", "Headsets", "Docking Stations", "Webcams"},
+	snipeit.CategoryTypeConsumable: {"Printer Ink", "Paper", "Batteries", "Cables"},
+	snipeit.CategoryTypeComponent:  {"RAM", "Hard Drives", "SSDs", "Power Supplies"},
+	snipeit.CategoryTypeLicense:    {"Office Suite", "Operating Systems", "Design Software", "Antivirus"},
+}
+
+type statusLabelData struct {
+	name       string
+	statusType snipeit.StatusType
+	statusMeta snipeit.StatusMeta
+}
+
+var statusLabels = []statusLabelData{
+	{"Ready to Deploy", snipeit.StatusTypeDeployable, snipeit.StatusMetaDeployable},
+	{"Deployed", snipeit.StatusTypeDeployable, snipeit.StatusMetaDeployed},
+	{"Pending", snipeit.StatusTypePending, snipeit.StatusMetaPending},
+	{"Out for Repair", snipeit.StatusTypeUndeployable, snipeit.StatusMetaUndeployable},
+	{"Archived", snipeit.StatusTypeArchived, snipeit.StatusMetaArchived},
+}
+
+type cityData struct {
+	name     string
+	state    string
+	country  string
+	currency string
+}
+
+var cities = []cityData{
+	{"New York", "NY", "US", "USD"},
+	{"San Francisco", "CA", "US", "USD"},
+	{"Austin", "TX", "US", "USD"},
+	{"Toronto", "ON", "CA", "CAD"},
+	{"London", "", "GB", "GBP"},
+	{"Berlin", "", "DE", "EUR"},
+	{"Sydney", "NSW", "AU", "AUD"},
+}
+
+var locationSuffixes = []string{"HQ", "Office", "Warehouse", "Datacenter", "Branch"}
+
+var streetNames = []string{"Main Street", "Market Street", "Oak Avenue", "Park Road", "High Street", "Elm Street"}
+
+var supplierNames = []string{"CDW", "Insight", "SHI", "Connection", "Newegg Business", "B and H", "Staples"}
+
+var licenseProducts = []string{"Office Suite", "Design Studio", "Endpoint Protection", "Developer Tools", "Backup Agent"}
+
+var firstNames = []string{
+	"Alex", "Jordan", "Taylor", "Morgan", "Casey", "Riley", "Jamie", "Avery", "Quinn", "Sam",
+	"Priya", "Wei", "Mateo", "Amara", "Yuki", "Omar", "Elena", "Kofi", "Ingrid", "Luca",
+}
+
+var lastNames = []string{
+	"Smith", "Johnson", "Garcia", "Nguyen", "Patel", "Kim", "Muller", "Rossi", "Okafor", "Silva",
+	"Tanaka", "Hansen", "Cohen", "Ivanova", "Martin", "Lopez", "Chen", "Singh", "Brown", "Dubois",
+}
+
+var jobTitles = []string{
+	"Software Engineer", "Account Manager", "IT Technician", "Designer", "Product Manager",
+	"Data Analyst", "HR Generalist", "Sales Representative", "Support Specialist", "Accountant",
+}
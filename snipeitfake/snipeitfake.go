@@ -0,0 +1,302 @@
+// Package snipeitfake generates realistic, randomized Snipe-IT models.
+//
+// The generated values are suitable for seeding fake servers, load tests and
+// demos: serial numbers and asset tags are well-formed and unique within a
+// Generator, dates are consistent with each other, and nested relations
+// (model, category, manufacturer, status label, location, supplier) are
+// populated.
+//
+// Usage:
+//
+//	gen := snipeitfake.New(42)
+//	assets := gen.Assets(100)
+//	user := gen.User()
+//
+// A Generator created with the same seed always produces the same sequence of
+// values. Generators are not safe for concurrent use.
+package snipeitfake
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// Generator produces randomized Snipe-IT models.
+type Generator struct {
+	rand    *rand.Rand
+	now     time.Time
+	ids     map[string]int
+	serials map[string]bool
+}
+
+// New returns a Generator seeded with seed.
+func New(seed int64) *Generator {
+	return &Generator{
+		rand:    rand.New(rand.NewSource(seed)),
+		now:     time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC),
+		ids:     make(map[string]int),
+		serials: make(map[string]bool),
+	}
+}
+
+// nextID returns the next sequential ID for the given kind of model.
+func (g *Generator) nextID(kind string) int {
+	g.ids[kind]++
+	return g.ids[kind]
+}
+
+// pick returns a random element of choices.
+func (g *Generator) pick(choices []string) string {
+	return choices[g.rand.Intn(len(choices))]
+}
+
+// alphanumeric returns a random string of n upper-case letters and digits,
+// leaving out characters that are easily confused on printed labels.
+func (g *Generator) alphanumeric(n int) string {
+	const charset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = charset[g.rand.Intn(len(charset))]
+	}
+	return string(b)
+}
+
+// pastTime returns a random time within maxAge before the generator's reference time.
+func (g *Generator) pastTime(maxAge time.Duration) *snipeit.SnipeTime {
+	age := time.Duration(g.rand.Int63n(int64(maxAge)))
+	return &snipeit.SnipeTime{Time: g.now.Add(-age).Truncate(time.Second)}
+}
+
+// common returns CommonFields with a fresh ID and the given name.
+func (g *Generator) common(kind, name string) snipeit.CommonFields {
+	created := g.pastTime(3 * 365 * 24 * time.Hour)
+	sinceCreated := time.Duration(g.rand.Int63n(int64(g.now.Sub(created.Time)) + 1))
+	return snipeit.CommonFields{
+		ID:        g.nextID(kind),
+		Name:      name,
+		CreatedAt: created,
+		UpdatedAt: &snipeit.SnipeTime{Time: created.Add(sinceCreated).Truncate(time.Second)},
+	}
+}
+
+// Serial returns a random 12-character manufacturer-style serial number
+// that has not been returned by this Generator before.
+func (g *Generator) Serial() string {
+	for {
+		serial := g.alphanumeric(12)
+		if !g.serials[serial] {
+			g.serials[serial] = true
+			return serial
+		}
+	}
+}
+
+// AssetTag returns a unique asset tag of the form "ASSET-000123".
+func (g *Generator) AssetTag() string {
+	return fmt.Sprintf("ASSET-%06d", g.nextID("asset_tag"))
+}
+
+// Manufacturer returns a random manufacturer.
+func (g *Generator) Manufacturer() snipeit.Manufacturer {
+	name := g.pick(manufacturerNames)
+	domain := strings.ToLower(strings.ReplaceAll(name, " ", ""))
+	return snipeit.Manufacturer{
+		CommonFields: g.common("manufacturer", name),
+		URL:          "https://www." + domain + ".com",
+		SupportURL:   "https://support." + domain + ".com",
+		SupportEmail: "support@" + domain + ".com",
+	}
+}
+
+// Category returns a random category of the given type.
+// If categoryType is empty, snipeit.CategoryTypeAsset is used.
+func (g *Generator) Category(categoryType snipeit.CategoryType) snipeit.Category {
+	if categoryType == "" {
+		categoryType = snipeit.CategoryTypeAsset
+	}
+	names, ok := categoryNames[categoryType]
+	if !ok {
+		names = categoryNames[snipeit.CategoryTypeAsset]
+	}
+	return snipeit.Category{
+		CommonFields: g.common("category", g.pick(names)),
+		CategoryType: categoryType,
+		EULA:         g.rand.Intn(4) == 0,
+		Checkout:     g.rand.Intn(2) == 0,
+		Checkin:      g.rand.Intn(2) == 0,
+	}
+}
+
+// Model returns a random asset model with its category and manufacturer populated.
+func (g *Generator) Model() snipeit.Model {
+	manufacturer := g.Manufacturer()
+	category := g.Category(snipeit.CategoryTypeAsset)
+	name := fmt.Sprintf("%s %s %d", manufacturer.Name, g.pick(modelSeries), 10+g.rand.Intn(90))
+	return snipeit.Model{
+		CommonFields: g.common("model", name),
+		ModelNumber:  g.alphanumeric(2) + "-" + g.alphanumeric(4),
+		Category:     category,
+		Manufacturer: manufacturer,
+		EOL:          12 * (2 + g.rand.Intn(4)),
+	}
+}
+
+// StatusLabel returns a random status label.
+func (g *Generator) StatusLabel() snipeit.StatusLabel {
+	s := statusLabels[g.rand.Intn(len(statusLabels))]
+	return snipeit.StatusLabel{
+		CommonFields: g.common("status_label", s.name),
+		Type:         s.statusType,
+		StatusType:   s.statusType,
+		StatusMeta:   s.statusMeta,
+	}
+}
+
+// Location returns a random location.
+func (g *Generator) Location() snipeit.Location {
+	city := cities[g.rand.Intn(len(cities))]
+	return snipeit.Location{
+		CommonFields: g.common("location", city.name+" "+g.pick(locationSuffixes)),
+		Address:      fmt.Sprintf("%d %s", 1+g.rand.Intn(999), g.pick(streetNames)),
+		City:         city.name,
+		State:        city.state,
+		Country:      city.country,
+		Zip:          fmt.Sprintf("%05d", g.rand.Intn(100000)),
+		Currency:     city.currency,
+	}
+}
+
+// Supplier returns a random supplier.
+func (g *Generator) Supplier() snipeit.Supplier {
+	name := g.pick(supplierNames)
+	domain := strings.ToLower(strings.ReplaceAll(name, " ", ""))
+	return snipeit.Supplier{
+		CommonFields: g.common("supplier", name),
+		ContactName:  g.pick(firstNames) + " " + g.pick(lastNames),
+		Phone:        g.phone(),
+		Email:        "sales@" + domain + ".com",
+		URL:          "https://www." + domain + ".com",
+	}
+}
+
+// User returns a random user with a unique username and email address.
+func (g *Generator) User() snipeit.User {
+	first := g.pick(firstNames)
+	last := g.pick(lastNames)
+	common := g.common("user", first+" "+last)
+	id := common.ID
+	username := fmt.Sprintf("%s.%s%d", strings.ToLower(first), strings.ToLower(last), id)
+	return snipeit.User{
+		CommonFields: common,
+		Username:     username,
+		Email:        username + "@example.com",
+		FirstName:    first,
+		LastName:     last,
+		Phone:        g.phone(),
+		JobTitle:     g.pick(jobTitles),
+		Employee:     fmt.Sprintf("E%05d", id),
+		Activated:    g.rand.Intn(10) != 0,
+	}
+}
+
+// Users returns n random users.
+func (g *Generator) Users(n int) []snipeit.User {
+	users := make([]snipeit.User, n)
+	for i := range users {
+		users[i] = g.User()
+	}
+	return users
+}
+
+// Asset returns a random asset with its model, status label, category,
+// manufacturer, supplier and location populated. Deployed assets are
+// assigned to a random user.
+func (g *Generator) Asset() snipeit.Asset {
+	model := g.Model()
+	status := g.StatusLabel()
+	purchased := g.pastTime(4 * 365 * 24 * time.Hour)
+	purchased.Time = purchased.Truncate(24 * time.Hour)
+
+	asset := snipeit.Asset{
+		CommonFields:   g.common("asset", ""),
+		AssetTag:       g.AssetTag(),
+		Serial:         g.Serial(),
+		Model:          model,
+		ModelNumber:    model.ModelNumber,
+		StatusLabel:    status,
+		Category:       model.Category,
+		Manufacturer:   model.Manufacturer,
+		Supplier:       ptr(g.Supplier()),
+		Location:       ptr(g.Location()),
+		PurchaseDate:   purchased,
+		PurchaseCost:   &snipeit.Money{Cents: int64(100*(200+g.rand.Intn(3000)) + g.rand.Intn(100))},
+		WarrantyMonths: 12 * (1 + g.rand.Intn(3)),
+	}
+	asset.Name = fmt.Sprintf("%s-%s", strings.ToUpper(asset.Category.Name[:3]), asset.Serial[len(asset.Serial)-6:])
+	asset.Available = status.StatusMeta == snipeit.StatusMetaDeployable
+
+	if status.StatusMeta == snipeit.StatusMetaDeployed {
+		user := g.User()
+		asset.AssignedTo = snipeit.AssignedToUser(&user)
+		asset.AssignedType = snipeit.AssignedTypeUser
+	}
+
+	return asset
+}
+
+// Assets returns n random assets.
+func (g *Generator) Assets(n int) []snipeit.Asset {
+	assets := make([]snipeit.Asset, n)
+	for i := range assets {
+		assets[i] = g.Asset()
+	}
+	return assets
+}
+
+// License returns a random license with its category, manufacturer and
+// supplier populated. Some of its seats are already checked out.
+func (g *Generator) License() snipeit.License {
+	manufacturer := g.Manufacturer()
+	category := g.Category(snipeit.CategoryTypeLicense)
+	purchased := g.pastTime(2 * 365 * 24 * time.Hour)
+	purchased.Time = purchased.Truncate(24 * time.Hour)
+	seats := 5 * (1 + g.rand.Intn(40))
+
+	return snipeit.License{
+		CommonFields:   g.common("license", manufacturer.Name+" "+g.pick(licenseProducts)),
+		ProductKey:     g.alphanumeric(5) + "-" + g.alphanumeric(5) + "-" + g.alphanumeric(5) + "-" + g.alphanumeric(5),
+		Category:       category,
+		Manufacturer:   manufacturer,
+		Supplier:       ptr(g.Supplier()),
+		PurchaseDate:   purchased,
+		PurchaseCost:   &snipeit.Money{Cents: int64(100 * seats * (20 + g.rand.Intn(200)))},
+		ExpirationDate: &snipeit.SnipeTime{Time: purchased.AddDate(1+g.rand.Intn(3), 0, 0)},
+		Seats:          seats,
+		FreeSeatsCount: g.rand.Intn(seats + 1),
+		Maintained:     g.rand.Intn(2) == 0,
+		Reassignable:   g.rand.Intn(4) != 0,
+	}
+}
+
+// Licenses returns n random licenses.
+func (g *Generator) Licenses(n int) []snipeit.License {
+	licenses := make([]snipeit.License, n)
+	for i := range licenses {
+		licenses[i] = g.License()
+	}
+	return licenses
+}
+
+// phone returns a random phone number.
+func (g *Generator) phone() string {
+	return fmt.Sprintf("+1-%03d-555-%04d", 200+g.rand.Intn(800), g.rand.Intn(10000))
+}
+
+// ptr returns a pointer to a copy of v.
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,79 @@
+package snipeitfake
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGeneratorDeterministic(t *testing.T) {
+	a := New(42).Assets(5)
+	b := New(42).Assets(5)
+
+	if !reflect.DeepEqual(a, b) {
+		t.Error("Assets generated with the same seed differ")
+	}
+}
+
+func TestGeneratorAssets(t *testing.T) {
+	gen := New(1)
+	assets := gen.Assets(200)
+
+	tags := make(map[string]bool)
+	serials := make(map[string]bool)
+	for _, asset := range assets {
+		if tags[asset.AssetTag] {
+			t.Errorf("Duplicate asset tag %q", asset.AssetTag)
+		}
+		tags[asset.AssetTag] = true
+
+		if serials[asset.Serial] {
+			t.Errorf("Duplicate serial %q", asset.Serial)
+		}
+		serials[asset.Serial] = true
+
+		if asset.Model.ID == 0 || asset.StatusLabel.ID == 0 || asset.Category.ID == 0 {
+			t.Errorf("Asset %d is missing nested relations: %+v", asset.ID, asset)
+		}
+
+		if asset.PurchaseDate == nil || asset.CreatedAt == nil || asset.UpdatedAt == nil {
+			t.Fatalf("Asset %d is missing dates", asset.ID)
+		}
+
+		if asset.UpdatedAt.Before(asset.CreatedAt.Time) {
+			t.Errorf("Asset %d UpdatedAt %v is before CreatedAt %v", asset.ID, asset.UpdatedAt, asset.CreatedAt)
+		}
+
+		deployed := asset.StatusLabel.IsDeployed()
+		if deployed != (asset.AssignedTo != nil && asset.AssignedTo.User() != nil) {
+			t.Errorf("Asset %d with status %q has AssignedTo = %v", asset.ID, asset.StatusLabel.Name, asset.AssignedTo)
+		}
+	}
+}
+
+func TestGeneratorUsers(t *testing.T) {
+	users := New(7).Users(50)
+
+	usernames := make(map[string]bool)
+	for _, user := range users {
+		if usernames[user.Username] {
+			t.Errorf("Duplicate username %q", user.Username)
+		}
+		usernames[user.Username] = true
+
+		if user.Email != user.Username+"@example.com" {
+			t.Errorf("User Email = %q, expected %q", user.Email, user.Username+"@example.com")
+		}
+	}
+}
+
+func TestGeneratorLicenses(t *testing.T) {
+	for _, license := range New(3).Licenses(50) {
+		if license.FreeSeatsCount > license.Seats {
+			t.Errorf("License %d has %d free seats out of %d", license.ID, license.FreeSeatsCount, license.Seats)
+		}
+
+		if license.ExpirationDate == nil || !license.ExpirationDate.After(license.PurchaseDate.Time) {
+			t.Errorf("License %d expires %v, before its purchase date %v", license.ID, license.ExpirationDate, license.PurchaseDate)
+		}
+	}
+}
@@ -0,0 +1,392 @@
+package snipeittest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// assetFields are the keys of asset requests that decode into the fields
+// of snipeit.Asset of the same name.
+var assetFields = []string{
+	"asset_tag", "name", "serial", "order_number", "notes", "purchase_date",
+	"purchase_cost", "warranty_months", "next_audit_date", "requestable",
+}
+
+// AddAsset stores asset and returns it as stored. If its ID is 0, the next
+// free ID is assigned.
+func (s *Server) AddAsset(asset snipeit.Asset) snipeit.Asset {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if asset.ID == 0 {
+		asset.ID = s.nextID()
+	}
+	s.reserveID(asset.ID)
+	stored := clone(asset)
+	s.assets[asset.ID] = &stored
+	return clone(stored)
+}
+
+// Asset returns the stored asset with the given ID, if any.
+func (s *Server) Asset(id int) (snipeit.Asset, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	asset, ok := s.assets[id]
+	if !ok {
+		return snipeit.Asset{}, false
+	}
+	return clone(*asset), true
+}
+
+// Assets returns the stored assets in ascending ID order.
+func (s *Server) Assets() []snipeit.Asset {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	assets := make([]snipeit.Asset, 0, len(s.assets))
+	for _, id := range sortedIDs(s.assets) {
+		assets = append(assets, clone(*s.assets[id]))
+	}
+	return assets
+}
+
+// registerAssets registers the handlers of the hardware endpoints.
+func (s *Server) registerAssets(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/hardware", s.listAssets)
+	mux.HandleFunc("POST /api/v1/hardware", s.createAsset)
+	mux.HandleFunc("GET /api/v1/hardware/{id}", s.getAsset)
+	mux.HandleFunc("PUT /api/v1/hardware/{id}", s.updateAsset)
+	mux.HandleFunc("PATCH /api/v1/hardware/{id}", s.updateAsset)
+	mux.HandleFunc("DELETE /api/v1/hardware/{id}", s.deleteAsset)
+	mux.HandleFunc("GET /api/v1/hardware/bytag/{tag}", s.getAssetByTag)
+	mux.HandleFunc("GET /api/v1/hardware/byserial/{serial}", s.getAssetsBySerial)
+	mux.HandleFunc("POST /api/v1/hardware/{id}/checkout", s.checkoutAsset)
+	mux.HandleFunc("POST /api/v1/hardware/{id}/checkin", s.checkinAsset)
+}
+
+func (s *Server) listAssets(w http.ResponseWriter, r *http.Request) {
+	p := parsePage(r)
+	query := r.URL.Query()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rows := []snipeit.Asset{}
+	for _, id := range sortedIDs(s.assets) {
+		asset := s.assets[id]
+		if !p.matches(asset.AssetTag, asset.Name, asset.Serial, asset.Model.Name) {
+			continue
+		}
+		if status := query.Get("status_id"); status != "" && status != fmt.Sprint(asset.StatusLabel.ID) {
+			continue
+		}
+		rows = append(rows, *asset)
+	}
+	writeList(w, p, rows)
+}
+
+func (s *Server) getAsset(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "Asset")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	asset, ok := s.assets[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Asset not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, asset)
+}
+
+func (s *Server) getAssetByTag(w http.ResponseWriter, r *http.Request) {
+	tag := r.PathValue("tag")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, asset := range s.assets {
+		if strings.EqualFold(asset.AssetTag, tag) {
+			writeJSON(w, http.StatusOK, asset)
+			return
+		}
+	}
+	writeError(w, http.StatusOK, "Asset does not exist.")
+}
+
+func (s *Server) getAssetsBySerial(w http.ResponseWriter, r *http.Request) {
+	serial := r.PathValue("serial")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rows := []snipeit.Asset{}
+	for _, id := range sortedIDs(s.assets) {
+		if asset := s.assets[id]; asset.Serial == serial {
+			rows = append(rows, *asset)
+		}
+	}
+	writeList(w, page{limit: len(rows)}, rows)
+}
+
+func (s *Server) createAsset(w http.ResponseWriter, r *http.Request) {
+	b, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var asset snipeit.Asset
+	errs := s.applyAsset(&asset, b)
+	if asset.Model.ID == 0 {
+		errs["model_id"] = append(errs["model_id"], "The model id field is required.")
+	}
+	if asset.StatusLabel.ID == 0 {
+		errs["status_id"] = append(errs["status_id"], "The status id field is required.")
+	}
+	if asset.AssetTag == "" {
+		errs["asset_tag"] = append(errs["asset_tag"], "The asset tag field is required.")
+	}
+	if len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+
+	asset.ID = s.nextID()
+	asset.CreatedAt = now()
+	asset.UpdatedAt = asset.CreatedAt
+	s.assets[asset.ID] = &asset
+	writeSuccess(w, "Asset created successfully.", asset)
+}
+
+func (s *Server) updateAsset(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "Asset")
+	if !ok {
+		return
+	}
+	b, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.assets[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Asset not found")
+		return
+	}
+
+	asset := clone(*stored)
+	if errs := s.applyAsset(&asset, b); len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+
+	asset.UpdatedAt = now()
+	s.assets[id] = &asset
+	writeSuccess(w, "Asset updated successfully.", asset)
+}
+
+func (s *Server) deleteAsset(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "Asset")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.assets[id]; !ok {
+		writeError(w, http.StatusNotFound, "Asset not found")
+		return
+	}
+	delete(s.assets, id)
+	writeSuccess(w, "Asset deleted successfully.", nil)
+}
+
+func (s *Server) checkoutAsset(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "Asset")
+	if !ok {
+		return
+	}
+	b, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.assets[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Asset not found")
+		return
+	}
+	if stored.AssignedTo != nil {
+		writeError(w, http.StatusOK, "That asset is not available for checkout!")
+		return
+	}
+
+	asset := clone(*stored)
+	targetType, _ := b.string("checkout_to_type")
+	targetID, _ := b.id("assigned_" + targetType)
+	switch snipeit.AssignedType(targetType) {
+	case snipeit.AssignedTypeUser:
+		user, ok := s.users[targetID]
+		if !ok {
+			writeValidationErrors(w, map[string][]string{"assigned_user": {"The selected assigned user is invalid."}})
+			return
+		}
+		asset.AssignedTo = snipeit.AssignedToUser(user)
+	case snipeit.AssignedTypeAsset:
+		target, ok := s.assets[targetID]
+		if !ok || targetID == id {
+			writeValidationErrors(w, map[string][]string{"assigned_asset": {"The selected assigned asset is invalid."}})
+			return
+		}
+		asset.AssignedTo = snipeit.AssignedToAsset(target)
+	case snipeit.AssignedTypeLocation:
+		if targetID <= 0 {
+			writeValidationErrors(w, map[string][]string{"assigned_location": {"The selected assigned location is invalid."}})
+			return
+		}
+		location := &snipeit.Location{CommonFields: snipeit.CommonFields{ID: targetID}}
+		asset.AssignedTo = snipeit.AssignedToLocation(location)
+		asset.Location = location
+	default:
+		writeValidationErrors(w, map[string][]string{"checkout_to_type": {"The checkout to type field is required."}})
+		return
+	}
+	asset.AssignedTo = clone(asset.AssignedTo)
+	asset.AssignedType = snipeit.AssignedType(targetType)
+
+	errs := b.decode(&asset, "name", "expected_checkin")
+	if len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+	asset.LastCheckout = now()
+	asset.CheckoutCounter++
+	asset.UpdatedAt = asset.LastCheckout
+	s.assets[id] = &asset
+	writeSuccess(w, "Asset checked out successfully.", asset)
+}
+
+func (s *Server) checkinAsset(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "Asset")
+	if !ok {
+		return
+	}
+	b, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.assets[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Asset not found")
+		return
+	}
+	if stored.AssignedTo == nil {
+		writeError(w, http.StatusOK, "That asset is not checked out.")
+		return
+	}
+
+	asset := clone(*stored)
+	asset.AssignedTo = nil
+	asset.AssignedType = ""
+	asset.ExpectedCheckin = nil
+	asset.Location = asset.RTDLocation
+	if locationID, ok := b.id("location_id"); ok && locationID != 0 {
+		asset.Location = &snipeit.Location{CommonFields: snipeit.CommonFields{ID: locationID}}
+	}
+	if statusID, ok := b.id("status_id"); ok && statusID != 0 {
+		asset.StatusLabel = snipeit.StatusLabel{CommonFields: snipeit.CommonFields{ID: statusID}}
+	}
+	asset.CheckinCounter++
+	asset.UpdatedAt = now()
+	s.assets[id] = &asset
+	writeSuccess(w, "Asset checked in successfully.", asset)
+}
+
+// applyAsset sets the fields of asset from the body of a create or update
+// request, and returns the validation errors of the body. The caller must
+// hold s.mu.
+func (s *Server) applyAsset(asset *snipeit.Asset, b body) map[string][]string {
+	errs := b.decode(asset, assetFields...)
+
+	if id, ok := b.id("model_id"); ok {
+		asset.Model = snipeit.Model{CommonFields: snipeit.CommonFields{ID: id}}
+	}
+	if id, ok := b.id("status_id"); ok {
+		asset.StatusLabel = snipeit.StatusLabel{CommonFields: snipeit.CommonFields{ID: id}}
+	}
+	if id, ok := b.id("company_id"); ok {
+		asset.Company = nil
+		if id != 0 {
+			asset.Company = &snipeit.Company{CommonFields: snipeit.CommonFields{ID: id}}
+		}
+	}
+	if id, ok := b.id("location_id"); ok {
+		asset.Location = nil
+		if id != 0 {
+			asset.Location = &snipeit.Location{CommonFields: snipeit.CommonFields{ID: id}}
+		}
+	}
+	if id, ok := b.id("rtd_location_id"); ok {
+		asset.RTDLocation = nil
+		if id != 0 {
+			asset.RTDLocation = &snipeit.Location{CommonFields: snipeit.CommonFields{ID: id}}
+		}
+	}
+	if id, ok := b.id("supplier_id"); ok {
+		asset.Supplier = nil
+		if id != 0 {
+			asset.Supplier = &snipeit.Supplier{CommonFields: snipeit.CommonFields{ID: id}}
+		}
+	}
+
+	for key := range b {
+		if !strings.HasPrefix(key, "_snipeit_") {
+			continue
+		}
+		value, _ := b.string(key)
+		if asset.CustomFields == nil {
+			asset.CustomFields = make(snipeit.CustomFields)
+		}
+		name := key
+		for fieldName, field := range asset.CustomFields {
+			if field.Field == key {
+				name = fieldName
+			}
+		}
+		field := asset.CustomFields[name]
+		field.Field = key
+		field.Value = value
+		asset.CustomFields[name] = field
+	}
+
+	if asset.AssetTag != "" {
+		for _, other := range s.assets {
+			if other.ID != asset.ID && strings.EqualFold(other.AssetTag, asset.AssetTag) {
+				errs["asset_tag"] = append(errs["asset_tag"], "The asset tag must be unique.")
+				break
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,221 @@
+package snipeittest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// licenseFields are the keys of license requests that decode into the
+// fields of snipeit.License of the same name.
+var licenseFields = []string{
+	"name", "product_key", "category_id", "manufacturer_id", "supplier_id",
+	"company_id", "order_number", "purchase_order", "purchase_date",
+	"purchase_cost", "expiration_date", "termination_date", "license_name",
+	"license_email", "seats", "min_amt", "maintained", "reassignable", "notes",
+}
+
+// AddLicense stores license and returns it as stored. If its ID is 0, the
+// next free ID is assigned.
+func (s *Server) AddLicense(license snipeit.License) snipeit.License {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if license.ID == 0 {
+		license.ID = s.nextID()
+	}
+	s.reserveID(license.ID)
+	stored := clone(license)
+	s.licenses[license.ID] = &stored
+	return clone(stored)
+}
+
+// License returns the stored license with the given ID, if any.
+func (s *Server) License(id int) (snipeit.License, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	license, ok := s.licenses[id]
+	if !ok {
+		return snipeit.License{}, false
+	}
+	return clone(*license), true
+}
+
+// Licenses returns the stored licenses in ascending ID order.
+func (s *Server) Licenses() []snipeit.License {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	licenses := make([]snipeit.License, 0, len(s.licenses))
+	for _, id := range sortedIDs(s.licenses) {
+		licenses = append(licenses, clone(*s.licenses[id]))
+	}
+	return licenses
+}
+
+// registerLicenses registers the handlers of the licenses endpoints.
+func (s *Server) registerLicenses(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/licenses", s.listLicenses)
+	mux.HandleFunc("POST /api/v1/licenses", s.createLicense)
+	mux.HandleFunc("GET /api/v1/licenses/{id}", s.getLicense)
+	mux.HandleFunc("PUT /api/v1/licenses/{id}", s.updateLicense)
+	mux.HandleFunc("PATCH /api/v1/licenses/{id}", s.updateLicense)
+	mux.HandleFunc("DELETE /api/v1/licenses/{id}", s.deleteLicense)
+}
+
+func (s *Server) listLicenses(w http.ResponseWriter, r *http.Request) {
+	p := parsePage(r)
+	query := r.URL.Query()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rows := []snipeit.License{}
+	for _, id := range sortedIDs(s.licenses) {
+		license := s.licenses[id]
+		if !p.matches(license.Name, license.ProductKey, license.LicenseName, license.OrderNumber) {
+			continue
+		}
+		if category := query.Get("category_id"); category != "" && category != fmt.Sprint(license.Category.ID) {
+			continue
+		}
+		rows = append(rows, *license)
+	}
+	writeList(w, p, rows)
+}
+
+func (s *Server) getLicense(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "License")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	license, ok := s.licenses[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "License not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, license)
+}
+
+func (s *Server) createLicense(w http.ResponseWriter, r *http.Request) {
+	b, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var license snipeit.License
+	errs := applyLicense(&license, b)
+	if license.Name == "" {
+		errs["name"] = append(errs["name"], "The name field is required.")
+	}
+	if license.Seats < 1 {
+		errs["seats"] = append(errs["seats"], "The seats must be at least 1.")
+	}
+	if license.Category.ID == 0 {
+		errs["category_id"] = append(errs["category_id"], "The category id field is required.")
+	}
+	if len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+
+	license.ID = s.nextID()
+	license.FreeSeatsCount = license.Seats
+	license.CreatedAt = now()
+	license.UpdatedAt = license.CreatedAt
+	s.licenses[license.ID] = &license
+	writeSuccess(w, "License created successfully.", license)
+}
+
+func (s *Server) updateLicense(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "License")
+	if !ok {
+		return
+	}
+	b, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.licenses[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "License not found")
+		return
+	}
+
+	license := clone(*stored)
+	errs := applyLicense(&license, b)
+	used := stored.Seats - stored.FreeSeatsCount
+	if license.Seats < max(used, 1) {
+		errs["seats"] = append(errs["seats"], fmt.Sprintf("The seats must be at least %d.", max(used, 1)))
+	}
+	if len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+
+	license.FreeSeatsCount = license.Seats - used
+	license.UpdatedAt = now()
+	s.licenses[id] = &license
+	writeSuccess(w, "License updated successfully.", license)
+}
+
+func (s *Server) deleteLicense(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "License")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	license, ok := s.licenses[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "License not found")
+		return
+	}
+	if license.FreeSeatsCount < license.Seats {
+		writeError(w, http.StatusOK, "This license still has seats checked out and cannot be deleted.")
+		return
+	}
+	delete(s.licenses, id)
+	writeSuccess(w, "License deleted successfully.", nil)
+}
+
+// applyLicense sets the fields of license from the body of a create or
+// update request, and returns the validation errors of the body.
+func applyLicense(license *snipeit.License, b body) map[string][]string {
+	errs := b.decode(license, licenseFields...)
+
+	if _, ok := b["category_id"]; ok {
+		license.Category = snipeit.Category{CommonFields: snipeit.CommonFields{ID: license.CategoryID}}
+	}
+	if _, ok := b["manufacturer_id"]; ok {
+		license.Manufacturer = snipeit.Manufacturer{CommonFields: snipeit.CommonFields{ID: license.ManufacturerID}}
+	}
+	if _, ok := b["supplier_id"]; ok {
+		license.Supplier = nil
+		if license.SupplierID != 0 {
+			license.Supplier = &snipeit.Supplier{CommonFields: snipeit.CommonFields{ID: license.SupplierID}}
+		}
+	}
+	if _, ok := b["company_id"]; ok {
+		license.Company = nil
+		if license.CompanyID != 0 {
+			license.Company = &snipeit.Company{CommonFields: snipeit.CommonFields{ID: license.CompanyID}}
+		}
+	}
+	return errs
+}
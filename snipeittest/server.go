@@ -0,0 +1,389 @@
+// Package snipeittest provides an in-memory fake Snipe-IT server for tests.
+//
+// A Server implements the hardware, users and licenses endpoints of the
+// API over an in-memory store, with pagination, search and the validation
+// errors Snipe-IT returns, so that code using the snipeit package can be
+// tested without stubbing individual handlers:
+//
+//	server := snipeittest.NewServer()
+//	defer server.Close()
+//
+//	server.AddUser(snipeit.User{Username: "alice"})
+//	client := server.Client()
+//	created, _, err := client.Assets.Create(snipeit.AssetCreateRequest{
+//	    ModelID: 7, StatusID: 1, AssetTag: "AT-1",
+//	})
+//
+// Failures can be injected to test error handling:
+//
+//	server.Fail(snipeittest.Failure{Method: http.MethodPost, Path: "/api/v1/hardware", Status: http.StatusTooManyRequests})
+//
+// Requests must carry the server's Token. Resources that the server does
+// not store, such as models, status labels and locations, are referenced
+// by ID only and are not checked to exist. Unknown IDs are answered with
+// 404 Not Found, and unknown asset tags, like Snipe-IT, with a 200
+// response whose status is "error".
+package snipeittest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// DefaultToken is the API token a Server accepts unless Token is changed.
+const DefaultToken = "test-token"
+
+// defaultLimit is the page size of list endpoints when the request sets
+// none, as in Snipe-IT.
+const defaultLimit = 50
+
+// Failure describes requests that a Server fails instead of handling.
+type Failure struct {
+	// Method is the method of the requests to fail, or empty for any
+	Method string
+
+	// Path is a prefix of the paths of the requests to fail (e.g.,
+	// "/api/v1/hardware"), or empty for any
+	Path string
+
+	// Status is the status code of the response. Default: 500.
+	Status int
+
+	// Body is the body of the response. Default: a JSON object with a
+	// status of "error".
+	Body string
+
+	// Times is the number of requests to fail, after which the failure is
+	// removed. If 0, every matching request fails until ClearFailures is
+	// called.
+	Times int
+}
+
+// Server is a fake Snipe-IT server backed by an in-memory store.
+// It is safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the server, for snipeit.NewClient
+	URL string
+
+	// Token is the API token requests must carry. Default: DefaultToken.
+	Token string
+
+	server *httptest.Server
+
+	mu       sync.Mutex
+	assets   map[int]*snipeit.Asset
+	users    map[int]*snipeit.User
+	licenses map[int]*snipeit.License
+	lastID   int
+	failures []*Failure
+	requests []string
+}
+
+// NewServer starts and returns a Server with an empty store. The caller
+// should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		Token:    DefaultToken,
+		assets:   make(map[int]*snipeit.Asset),
+		users:    make(map[int]*snipeit.User),
+		licenses: make(map[int]*snipeit.License),
+	}
+
+	mux := http.NewServeMux()
+	s.registerAssets(mux)
+	s.registerUsers(mux)
+	s.registerLicenses(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "Endpoint not found")
+	})
+
+	s.server = httptest.NewServer(s.middleware(mux))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a client of the server. Retries are disabled, so that
+// injected failures are returned at once.
+func (s *Server) Client() *snipeit.Client {
+	client, err := snipeit.NewClientWithOptions(s.URL, s.Token, &snipeit.ClientOptions{DisableRetries: true})
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// Fail makes the server fail the requests described by f.
+func (s *Server) Fail(f Failure) {
+	if f.Status == 0 {
+		f.Status = http.StatusInternalServerError
+	}
+	if f.Body == "" {
+		f.Body = `{"status": "error", "messages": "Injected failure", "payload": null}`
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, &f)
+}
+
+// ClearFailures removes the failures added with Fail.
+func (s *Server) ClearFailures() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = nil
+}
+
+// Requests returns the requests the server received, oldest first, as
+// their method and path (e.g., "GET /api/v1/hardware").
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// middleware records requests, checks their token and applies failures
+// before passing them to next.
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+		token := s.Token
+		failure := s.failure(r)
+		s.mu.Unlock()
+
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message": "Unauthenticated."}`)
+			return
+		}
+		if failure != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(failure.Status)
+			fmt.Fprint(w, failure.Body)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// failure returns the failure matching r, if any, counting r against it.
+// The caller must hold s.mu.
+func (s *Server) failure(r *http.Request) *Failure {
+	for i, f := range s.failures {
+		if (f.Method != "" && f.Method != r.Method) || !strings.HasPrefix(r.URL.Path, f.Path) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.failures = append(s.failures[:i:i], s.failures[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+// nextID returns the ID of a new resource. IDs are unique across
+// resource types. The caller must hold s.mu.
+func (s *Server) nextID() int {
+	s.lastID++
+	return s.lastID
+}
+
+// reserveID records that id is used. The caller must hold s.mu.
+func (s *Server) reserveID(id int) {
+	if id > s.lastID {
+		s.lastID = id
+	}
+}
+
+// now returns the time stamped on created and updated resources.
+func now() *snipeit.SnipeTime {
+	return &snipeit.SnipeTime{Time: time.Now().UTC().Truncate(time.Second)}
+}
+
+// clone returns a deep copy of v, made by encoding and decoding it.
+func clone[T any](v T) T {
+	var copied T
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(data, &copied); err != nil {
+		panic(err)
+	}
+	return copied
+}
+
+// sortedIDs returns the keys of m in ascending order.
+func sortedIDs[T any](m map[int]T) []int {
+	ids := make([]int, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// page is the pagination and search of a list request.
+type page struct {
+	limit  int
+	offset int
+	search string
+	desc   bool
+}
+
+// parsePage returns the pagination and search of r.
+func parsePage(r *http.Request) page {
+	query := r.URL.Query()
+	p := page{limit: defaultLimit, search: strings.ToLower(query.Get("search"))}
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 {
+		p.limit = limit
+	}
+	if offset, err := strconv.Atoi(query.Get("offset")); err == nil && offset > 0 {
+		p.offset = offset
+	}
+	p.desc = strings.EqualFold(query.Get("order"), "desc")
+	return p
+}
+
+// matches reports whether any of values contains the search term of p.
+func (p page) matches(values ...string) bool {
+	if p.search == "" {
+		return true
+	}
+	for _, value := range values {
+		if strings.Contains(strings.ToLower(value), p.search) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeList writes the page p of rows, which are in ascending ID order.
+func writeList[T any](w http.ResponseWriter, p page, rows []T) {
+	if p.desc {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+	total := len(rows)
+	start := min(p.offset, total)
+	end := min(start+p.limit, total)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"total": total, "rows": rows[start:end]})
+}
+
+// writeJSON writes v as a JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeSuccess writes the response of a successful mutation.
+func writeSuccess(w http.ResponseWriter, message string, payload interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"status": "success", "messages": message, "payload": payload})
+}
+
+// writeError writes an error response whose messages are message.
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{"status": "error", "messages": message, "payload": nil})
+}
+
+// writeValidationErrors writes the response Snipe-IT sends when it
+// rejects the submitted fields: a 200 response whose status is "error",
+// with the messages for each field.
+func writeValidationErrors(w http.ResponseWriter, fields map[string][]string) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"status": "error", "messages": fields, "payload": nil})
+}
+
+// pathID returns the {id} wildcard of r, or writes a 404 response and
+// returns false if it is not a number.
+func pathID(w http.ResponseWriter, r *http.Request, resource string) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, resource+" not found")
+		return 0, false
+	}
+	return id, true
+}
+
+// body is the decoded JSON body of a create or update request.
+type body map[string]json.RawMessage
+
+// readBody decodes the JSON object of r, or writes a 400 response and
+// returns false if it is not one.
+func readBody(w http.ResponseWriter, r *http.Request) (body, bool) {
+	var b body
+	if err := json.NewDecoder(r.Body).Decode(&b); err != nil || b == nil {
+		writeError(w, http.StatusBadRequest, "Request body must be a JSON object")
+		return nil, false
+	}
+	return b, true
+}
+
+// id returns the ID under key, 0 if it is null, and whether key is set.
+// IDs may be numbers or numeric strings.
+func (b body) id(key string) (int, bool) {
+	raw, ok := b[key]
+	if !ok {
+		return 0, false
+	}
+	var number json.Number
+	if err := json.Unmarshal(raw, &number); err != nil {
+		var text string
+		json.Unmarshal(raw, &text)
+		number = json.Number(text)
+	}
+	id, _ := strconv.Atoi(number.String())
+	return id, true
+}
+
+// string returns the string under key, and whether key is set. Numbers
+// and booleans are returned in their JSON form, and null as "".
+func (b body) string(key string) (string, bool) {
+	raw, ok := b[key]
+	if !ok {
+		return "", false
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil && string(raw) != "null" {
+		value = string(raw)
+	}
+	return value, true
+}
+
+// decode decodes the values of keys in b into the fields of v, leaving
+// the other fields as they are. Keys whose value does not decode, such as
+// a string where a number is expected, are returned as validation errors.
+func (b body) decode(v interface{}, keys ...string) map[string][]string {
+	errs := make(map[string][]string)
+	for _, key := range keys {
+		raw, ok := b[key]
+		if !ok {
+			continue
+		}
+		data, _ := json.Marshal(map[string]json.RawMessage{key: raw})
+		if err := json.Unmarshal(data, v); err != nil {
+			errs[key] = append(errs[key], fmt.Sprintf("The %s field is invalid.", strings.ReplaceAll(key, "_", " ")))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,239 @@
+package snipeittest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+func TestServerAssets(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	created, _, err := client.Assets.Create(snipeit.AssetCreateRequest{ModelID: 7, StatusID: 1, AssetTag: "AT-1", Serial: "SN1"})
+	if err != nil {
+		t.Fatalf("Assets.Create returned error: %v", err)
+	}
+	if created.ID == 0 || created.Model.ID != 7 || created.AssetTag != "AT-1" {
+		t.Errorf("Assets.Create returned %+v, expected model 7 and tag AT-1", created.Asset)
+	}
+
+	patched, _, err := client.Assets.Patch(created.ID, map[string]interface{}{"name": "Laptop", "_snipeit_ram_1": "16GB"})
+	if err != nil {
+		t.Fatalf("Assets.Patch returned error: %v", err)
+	}
+	if patched.Name != "Laptop" || patched.AssetTag != "AT-1" {
+		t.Errorf("Assets.Patch returned %+v, expected name Laptop and tag AT-1", patched.Asset)
+	}
+
+	got, _, err := client.Assets.GetAssetByTag("AT-1")
+	if err != nil {
+		t.Fatalf("Assets.GetAssetByTag returned error: %v", err)
+	}
+	if field, ok := got.CustomFields.Column("_snipeit_ram_1"); got.ID != created.ID || !ok || field.Value != "16GB" {
+		t.Errorf("Assets.GetAssetByTag returned %+v, expected asset %d with RAM 16GB", got.Asset, created.ID)
+	}
+
+	stored, ok := server.Asset(created.ID)
+	if !ok || stored.Name != "Laptop" {
+		t.Errorf("Server.Asset returned %+v, %v, expected the patched asset", stored, ok)
+	}
+
+	if _, err := client.Assets.Delete(created.ID); err != nil {
+		t.Fatalf("Assets.Delete returned error: %v", err)
+	}
+	if _, _, err := client.Assets.Get(created.ID); !errors.Is(err, snipeit.ErrNotFound) {
+		t.Errorf("Assets.Get of a deleted asset returned %v, expected ErrNotFound", err)
+	}
+}
+
+func TestServerAssetsValidation(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.AddAsset(snipeit.Asset{AssetTag: "AT-1"})
+
+	_, _, err := client.Assets.Create(snipeit.AssetCreateRequest{ModelID: 7, StatusID: 1, AssetTag: "AT-1"})
+	var errorResponse *snipeit.ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("Assets.Create of a duplicate tag returned %v, expected an *ErrorResponse", err)
+	}
+	if len(server.Assets()) != 1 {
+		t.Errorf("Server.Assets returned %d assets, expected 1", len(server.Assets()))
+	}
+
+	if _, _, err := client.Assets.GetAssetByTag("AT-2"); err == nil {
+		t.Error("Assets.GetAssetByTag of an unknown tag returned no error")
+	}
+}
+
+func TestServerAssetsPagination(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	var want []string
+	for i := 1; i <= 7; i++ {
+		tag := fmt.Sprintf("AT-%d", i)
+		server.AddAsset(snipeit.Asset{AssetTag: tag})
+		want = append(want, tag)
+	}
+
+	page, _, err := client.Assets.List(&snipeit.ListOptions{Limit: 3, Offset: 3})
+	if err != nil {
+		t.Fatalf("Assets.List returned error: %v", err)
+	}
+	if page.Total != 7 || len(page.Rows) != 3 || page.Rows[0].AssetTag != "AT-4" {
+		t.Errorf("Assets.List returned total %d and %d rows, expected 7 and 3 from AT-4", page.Total, len(page.Rows))
+	}
+
+	var tags []string
+	for asset, err := range client.Assets.Iterate(&snipeit.ListOptions{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("Assets.Iterate returned error: %v", err)
+		}
+		tags = append(tags, asset.AssetTag)
+	}
+	if !reflect.DeepEqual(tags, want) {
+		t.Errorf("Assets.Iterate returned %v, expected %v", tags, want)
+	}
+}
+
+func TestServerAssetsCheckoutCheckin(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	user := server.AddUser(snipeit.User{Username: "alice", FirstName: "Alice"})
+	asset := server.AddAsset(snipeit.Asset{AssetTag: "AT-1"})
+
+	checkout := map[string]interface{}{"checkout_to_type": "user", "assigned_user": user.ID}
+	if _, _, err := client.Assets.Checkout(asset.ID, checkout); err != nil {
+		t.Fatalf("Assets.Checkout returned error: %v", err)
+	}
+	stored, _ := server.Asset(asset.ID)
+	if stored.AssignedTo == nil || stored.AssignedTo.ID() != user.ID || stored.CheckoutCounter != 1 {
+		t.Errorf("Asset after checkout = %+v, expected it assigned to user %d", stored, user.ID)
+	}
+
+	if _, _, err := client.Assets.Checkout(asset.ID, checkout); err == nil {
+		t.Error("Assets.Checkout of a checked out asset returned no error")
+	}
+
+	if _, _, err := client.Assets.Checkin(asset.ID, map[string]interface{}{}); err != nil {
+		t.Fatalf("Assets.Checkin returned error: %v", err)
+	}
+	stored, _ = server.Asset(asset.ID)
+	if stored.AssignedTo != nil || stored.CheckinCounter != 1 {
+		t.Errorf("Asset after checkin = %+v, expected it unassigned", stored)
+	}
+}
+
+func TestServerUsers(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	user := server.AddUser(snipeit.User{Username: "alice", FirstName: "Alice", LastName: "Smith"})
+	if user.Name != "Alice Smith" {
+		t.Errorf("Server.AddUser returned name %q, expected %q", user.Name, "Alice Smith")
+	}
+
+	result, err := client.Ping()
+	if err != nil {
+		t.Fatalf("Client.Ping returned error: %v", err)
+	}
+	if result.UserID != user.ID {
+		t.Errorf("Client.Ping returned user %d, expected %d", result.UserID, user.ID)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/v1/users?search=smith", nil)
+	req.Header.Set("Authorization", "Bearer "+server.Token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET /api/v1/users returned error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("GET /api/v1/users returned status %d, expected 200", resp.StatusCode)
+	}
+}
+
+func TestServerLicenses(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	license := snipeit.License{Seats: 10, CategoryID: 4}
+	license.Name = "Office"
+	created, _, err := client.Licenses.Create(license)
+	if err != nil {
+		t.Fatalf("Licenses.Create returned error: %v", err)
+	}
+	if created.FreeSeatsCount != 10 || created.Category.ID != 4 {
+		t.Errorf("Licenses.Create returned %+v, expected 10 free seats in category 4", created.License)
+	}
+
+	got, _, err := client.Licenses.Get(created.ID)
+	if err != nil {
+		t.Fatalf("Licenses.Get returned error: %v", err)
+	}
+	if got.Name != "Office" {
+		t.Errorf("Licenses.Get returned name %q, expected Office", got.Name)
+	}
+
+	invalid := snipeit.License{CategoryID: 4}
+	invalid.Name = "Invalid"
+	if _, _, err := client.Licenses.Create(invalid); err == nil {
+		t.Error("Licenses.Create without seats returned no error")
+	}
+}
+
+func TestServerFail(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	asset := server.AddAsset(snipeit.Asset{AssetTag: "AT-1"})
+	server.Fail(Failure{Method: http.MethodGet, Path: "/api/v1/hardware", Status: http.StatusTooManyRequests, Times: 1})
+
+	if _, _, err := client.Assets.Get(asset.ID); !errors.Is(err, snipeit.ErrRateLimited) {
+		t.Errorf("Assets.Get returned %v, expected ErrRateLimited", err)
+	}
+	if _, _, err := client.Assets.Get(asset.ID); err != nil {
+		t.Errorf("Assets.Get after the failure returned error: %v", err)
+	}
+
+	server.Fail(Failure{Path: "/api/v1/hardware"})
+	if _, _, err := client.Assets.Get(asset.ID); err == nil {
+		t.Error("Assets.Get returned no error, expected an injected failure")
+	}
+	server.ClearFailures()
+	if _, _, err := client.Assets.Get(asset.ID); err != nil {
+		t.Errorf("Assets.Get after ClearFailures returned error: %v", err)
+	}
+
+	want := []string{"GET /api/v1/hardware/1", "GET /api/v1/hardware/1", "GET /api/v1/hardware/1", "GET /api/v1/hardware/1"}
+	if got := server.Requests(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Server.Requests returned %v, expected %v", got, want)
+	}
+}
+
+func TestServerUnauthorized(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	client, err := snipeit.NewClientWithOptions(server.URL, "wrong", &snipeit.ClientOptions{DisableRetries: true})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	if _, _, err := client.Assets.List(nil); !errors.Is(err, snipeit.ErrUnauthorized) {
+		t.Errorf("Assets.List returned %v, expected ErrUnauthorized", err)
+	}
+}
@@ -0,0 +1,275 @@
+package snipeittest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// userFields are the keys of user requests that decode into the fields of
+// snipeit.User of the same name.
+var userFields = []string{
+	"username", "email", "first_name", "last_name", "phone", "jobtitle",
+	"employee_num", "activated", "notes", "remote", "vip", "start_date",
+	"end_date", "manager_id", "department_id", "company_id", "location_id",
+}
+
+// AddUser stores user and returns it as stored. If its ID is 0, the next
+// free ID is assigned.
+func (s *Server) AddUser(user snipeit.User) snipeit.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if user.ID == 0 {
+		user.ID = s.nextID()
+	}
+	s.reserveID(user.ID)
+	if user.Name == "" {
+		user.Name = strings.TrimSpace(user.FirstName + " " + user.LastName)
+	}
+	stored := clone(user)
+	s.users[user.ID] = &stored
+	return clone(stored)
+}
+
+// User returns the stored user with the given ID, if any.
+func (s *Server) User(id int) (snipeit.User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[id]
+	if !ok {
+		return snipeit.User{}, false
+	}
+	return clone(*user), true
+}
+
+// Users returns the stored users in ascending ID order.
+func (s *Server) Users() []snipeit.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users := make([]snipeit.User, 0, len(s.users))
+	for _, id := range sortedIDs(s.users) {
+		users = append(users, clone(*s.users[id]))
+	}
+	return users
+}
+
+// registerUsers registers the handlers of the users endpoints.
+func (s *Server) registerUsers(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/users", s.listUsers)
+	mux.HandleFunc("POST /api/v1/users", s.createUser)
+	mux.HandleFunc("GET /api/v1/users/me", s.getAPIUser)
+	mux.HandleFunc("GET /api/v1/users/{id}", s.getUser)
+	mux.HandleFunc("PUT /api/v1/users/{id}", s.updateUser)
+	mux.HandleFunc("PATCH /api/v1/users/{id}", s.updateUser)
+	mux.HandleFunc("DELETE /api/v1/users/{id}", s.deleteUser)
+	mux.HandleFunc("GET /api/v1/users/{id}/assets", s.listUserAssets)
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	p := parsePage(r)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rows := []snipeit.User{}
+	for _, id := range sortedIDs(s.users) {
+		user := s.users[id]
+		if p.matches(user.Username, user.Name, user.Email, user.Employee) {
+			rows = append(rows, *user)
+		}
+	}
+	writeList(w, p, rows)
+}
+
+// getAPIUser answers the request for the user owning the API token: the
+// user with the lowest ID, or a placeholder if there is none.
+func (s *Server) getAPIUser(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ids := sortedIDs(s.users); len(ids) > 0 {
+		writeJSON(w, http.StatusOK, s.users[ids[0]])
+		return
+	}
+	writeJSON(w, http.StatusOK, snipeit.User{CommonFields: snipeit.CommonFields{ID: 1, Name: "API User"}, Username: "api"})
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "User")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "User not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, user)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	b, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user := snipeit.User{Activated: true}
+	errs := s.applyUser(&user, b)
+	if user.Username == "" {
+		errs["username"] = append(errs["username"], "The username field is required.")
+	}
+	if user.FirstName == "" {
+		errs["first_name"] = append(errs["first_name"], "The first name field is required.")
+	}
+	if len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+
+	user.ID = s.nextID()
+	user.CreatedAt = now()
+	user.UpdatedAt = user.CreatedAt
+	s.users[user.ID] = &user
+	writeSuccess(w, "User created successfully.", user)
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "User")
+	if !ok {
+		return
+	}
+	b, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.users[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	user := clone(*stored)
+	if errs := s.applyUser(&user, b); len(errs) > 0 {
+		writeValidationErrors(w, errs)
+		return
+	}
+
+	user.UpdatedAt = now()
+	s.users[id] = &user
+	writeSuccess(w, "User updated successfully.", user)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "User")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[id]; !ok {
+		writeError(w, http.StatusNotFound, "User not found")
+		return
+	}
+	for _, asset := range s.assets {
+		if assignedToUser(asset, id) {
+			writeError(w, http.StatusOK, "This user still has assets checked out and cannot be deleted.")
+			return
+		}
+	}
+	delete(s.users, id)
+	writeSuccess(w, "User deleted successfully.", nil)
+}
+
+func (s *Server) listUserAssets(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "User")
+	if !ok {
+		return
+	}
+	p := parsePage(r)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[id]; !ok {
+		writeError(w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	rows := []snipeit.Asset{}
+	for _, assetID := range sortedIDs(s.assets) {
+		if asset := s.assets[assetID]; assignedToUser(asset, id) {
+			rows = append(rows, *asset)
+		}
+	}
+	writeList(w, p, rows)
+}
+
+// assignedToUser reports whether asset is checked out to the user with
+// the given ID.
+func assignedToUser(asset *snipeit.Asset, id int) bool {
+	return asset.AssignedTo != nil && asset.AssignedTo.Kind() == snipeit.AssignedTypeUser && asset.AssignedTo.ID() == id
+}
+
+// applyUser sets the fields of user from the body of a create or update
+// request, and returns the validation errors of the body. The caller must
+// hold s.mu.
+func (s *Server) applyUser(user *snipeit.User, b body) map[string][]string {
+	errs := b.decode(user, userFields...)
+	_, first := b["first_name"]
+	_, last := b["last_name"]
+	if first || last {
+		user.Name = strings.TrimSpace(user.FirstName + " " + user.LastName)
+	}
+
+	if _, ok := b["manager_id"]; ok {
+		user.Manager = nil
+		if manager, ok := s.users[user.ManagerID]; ok {
+			user.Manager = &snipeit.User{CommonFields: snipeit.CommonFields{ID: manager.ID, Name: manager.Name}, Username: manager.Username}
+		}
+	}
+	if _, ok := b["department_id"]; ok {
+		user.Department = nil
+		if user.DepartmentID != 0 {
+			user.Department = &snipeit.Department{CommonFields: snipeit.CommonFields{ID: user.DepartmentID}}
+		}
+	}
+	if _, ok := b["company_id"]; ok {
+		user.Company = nil
+		if user.CompanyID != 0 {
+			user.Company = &snipeit.Company{CommonFields: snipeit.CommonFields{ID: user.CompanyID}}
+		}
+	}
+	if _, ok := b["location_id"]; ok {
+		user.Location = nil
+		if user.LocationID != 0 {
+			user.Location = &snipeit.Location{CommonFields: snipeit.CommonFields{ID: user.LocationID}}
+		}
+	}
+
+	if user.Username != "" {
+		for _, other := range s.users {
+			if other.ID != user.ID && strings.EqualFold(other.Username, user.Username) {
+				errs["username"] = append(errs["username"], "The username has already been taken.")
+				break
+			}
+		}
+	}
+	return errs
+}
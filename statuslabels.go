@@ -0,0 +1,290 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// StatusLabelsService handles communication with the status label endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/status-labels
+type StatusLabelsService struct {
+	client *Client
+}
+
+// StatusLabelResponse represents the API response for a single status label.
+// The single status label endpoint returns the status label data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded StatusLabel.
+type StatusLabelResponse struct {
+	Response
+	// Payload contains the status label as returned in the payload field, if any
+	Payload *StatusLabel `json:"payload,omitempty"`
+	StatusLabel
+}
+
+// UnmarshalJSON implements json.Unmarshaler for StatusLabelResponse.
+func (r *StatusLabelResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.StatusLabel)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.StatusLabel
+	}
+	return nil
+}
+
+// StatusLabelsResponse represents the API response for multiple status labels.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of StatusLabels.
+type StatusLabelsResponse struct {
+	Response
+	// Rows contains the list of StatusLabel objects
+	Rows []StatusLabel `json:"rows"`
+}
+
+// List returns a list of status labels with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/status-labels
+func (s *StatusLabelsService) List(opts *ListOptions) (*StatusLabelsResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of status labels with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/status-labels
+func (s *StatusLabelsService) ListContext(ctx context.Context, opts *ListOptions) (*StatusLabelsResponse, *http.Response, error) {
+	u := "api/v1/statuslabels"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statusLabels StatusLabelsResponse
+	resp, err := s.client.Do(req, &statusLabels)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &statusLabels, resp, nil
+}
+
+// Iterate returns an iterator over every status label, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero StatusLabel.
+func (s *StatusLabelsService) Iterate(opts *ListOptions) iter.Seq2[StatusLabel, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every status label with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero StatusLabel.
+func (s *StatusLabelsService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[StatusLabel, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]StatusLabel, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single status label by its ID.
+//
+// id is the unique identifier of the status label to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/statuslabelsid
+func (s *StatusLabelsService) Get(id int) (*StatusLabelResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single status label by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the status label to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/statuslabelsid
+func (s *StatusLabelsService) GetContext(ctx context.Context, id int) (*StatusLabelResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/statuslabels/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statusLabel StatusLabelResponse
+	resp, err := s.client.Do(req, &statusLabel)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &statusLabel, resp, nil
+}
+
+// Create creates a new status label in Snipe-IT.
+//
+// status label must contain the required fields:
+// - Name: The name of the status label
+// - Type: One of the StatusType constants, such as StatusTypeDeployable
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/statuslabels-1
+func (s *StatusLabelsService) Create(statusLabel StatusLabel) (*StatusLabelResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), statusLabel)
+}
+
+// CreateContext creates a new status label in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// status label must contain the required fields:
+// - Name: The name of the status label
+// - Type: One of the StatusType constants, such as StatusTypeDeployable
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/statuslabels-1
+func (s *StatusLabelsService) CreateContext(ctx context.Context, statusLabel StatusLabel) (*StatusLabelResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/statuslabels", statusLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response StatusLabelResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing status label in Snipe-IT.
+//
+// id is the unique identifier of the status label to update.
+// status label contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/statuslabelsid-2
+func (s *StatusLabelsService) Update(id int, statusLabel StatusLabel) (*StatusLabelResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, statusLabel)
+}
+
+// UpdateContext updates an existing status label in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the status label to update.
+// status label contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/statuslabelsid-2
+func (s *StatusLabelsService) UpdateContext(ctx context.Context, id int, statusLabel StatusLabel) (*StatusLabelResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/statuslabels/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, statusLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response StatusLabelResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a status label from Snipe-IT.
+//
+// id is the unique identifier of the status label to delete.
+// Snipe-IT refuses to delete status labels that are still in use by assets.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/statuslabelsid-1
+func (s *StatusLabelsService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a status label from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the status label to delete.
+// Snipe-IT refuses to delete status labels that are still in use by assets.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/statuslabelsid-1
+func (s *StatusLabelsService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/statuslabels/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Assets returns the assets that have a status label.
+//
+// id is the unique identifier of the status label.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/statuslabelsidassetlist
+func (s *StatusLabelsService) Assets(id int, opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	return s.AssetsContext(context.Background(), id, opts)
+}
+
+// AssetsContext returns the assets that have a status label with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the status label.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/statuslabelsidassetlist
+func (s *StatusLabelsService) AssetsContext(ctx context.Context, id int, opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/statuslabels/%d/assetlist", id)
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var assets AssetsResponse
+	resp, err := s.client.Do(req, &assets)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &assets, resp, nil
+}
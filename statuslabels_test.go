@@ -0,0 +1,115 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestStatusLabelsList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/statuslabels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 1, "name": "Ready to Deploy", "type": "deployable", "color": "#00ff00", "assets_count": 12, "default_label": true},
+				{"id": 2, "name": "Broken", "type": "undeployable", "assets_count": 3}
+			]
+		}`)
+	})
+
+	labels, _, err := client.StatusLabels.List(nil)
+	if err != nil {
+		t.Fatalf("StatusLabels.List returned error: %v", err)
+	}
+
+	if len(labels.Rows) != 2 {
+		t.Fatalf("StatusLabels.List returned %d labels, expected %d", len(labels.Rows), 2)
+	}
+
+	ready := labels.Rows[0]
+	if ready.Type != "deployable" || ready.Color != "#00ff00" || ready.AssetsCount != 12 || !ready.DefaultLabel {
+		t.Errorf("StatusLabels.List returned %+v, expected the default deployable label with 12 assets", ready)
+	}
+}
+
+func TestStatusLabelsCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/statuslabels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["type"] != "pending" {
+			t.Errorf("Request body type = %v, expected %v", requestBody["type"], "pending")
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 3, "name": "In Transit", "type": "pending"}}`)
+	})
+
+	label, _, err := client.StatusLabels.Create(StatusLabel{
+		CommonFields: CommonFields{Name: "In Transit"},
+		Type:         "pending",
+	})
+	if err != nil {
+		t.Fatalf("StatusLabels.Create returned error: %v", err)
+	}
+
+	if label.ID != 3 {
+		t.Errorf("StatusLabels.Create returned ID = %d, expected %d", label.ID, 3)
+	}
+}
+
+func TestStatusLabelsDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/statuslabels/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success"}`)
+	})
+
+	if _, err := client.StatusLabels.Delete(3); err != nil {
+		t.Fatalf("StatusLabels.Delete returned error: %v", err)
+	}
+}
+
+func TestStatusLabelsAssets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/statuslabels/2/assetlist", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if r.URL.Query().Get("offset") != "50" {
+			t.Errorf("Request URL query parameter 'offset' = %v, expected %v", r.URL.Query().Get("offset"), "50")
+		}
+
+		fmt.Fprint(w, `{
+			"total": 53,
+			"rows": [
+				{"id": 10, "asset_tag": "AT-10", "status_label": {"id": 2, "name": "Broken"}}
+			]
+		}`)
+	})
+
+	assets, _, err := client.StatusLabels.Assets(2, &ListOptions{Offset: 50})
+	if err != nil {
+		t.Fatalf("StatusLabels.Assets returned error: %v", err)
+	}
+
+	if assets.Total != 53 {
+		t.Errorf("StatusLabels.Assets returned Total = %d, expected %d", assets.Total, 53)
+	}
+
+	if len(assets.Rows) != 1 || assets.Rows[0].StatusLabel.ID != 2 {
+		t.Errorf("StatusLabels.Assets returned %+v, expected one asset with status label 2", assets.Rows)
+	}
+}
@@ -0,0 +1,195 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// streamDecoder is implemented by values that decode a response body
+// incrementally instead of from a buffered copy. doOnce hands them the
+// body as it arrives.
+//
+// If after is not nil, it is called with the raw JSON of each item
+// decoded, its destination and its path in the response, to apply strict
+// decoding and raw JSON capture.
+type streamDecoder interface {
+	decodeStream(r io.Reader, after func(data []byte, v interface{}, path string) error) error
+}
+
+// rowStream decodes a list response, calling fn with each element of its
+// rows array as it is decoded, so that a page is never held in memory at once.
+type rowStream[T any] struct {
+	fn func(row T) error
+
+	// rows and total are the number of rows decoded and the total
+	// reported by the API
+	rows  int
+	total int
+}
+
+// decodeStream decodes a list response from r. Fields other than rows and
+// total are skipped, except for a status of "error", which is returned as
+// an ErrorResponse like any other 2xx response reporting a failure.
+func (s *rowStream[T]) decodeStream(r io.Reader, after func(data []byte, v interface{}, path string) error) error {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	others := map[string]json.RawMessage{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+
+		switch key {
+		case "rows":
+			if err := s.decodeRows(dec, after); err != nil {
+				return err
+			}
+		case "total":
+			var total json.RawMessage
+			if err := dec.Decode(&total); err != nil {
+				return err
+			}
+			if err := retryLenient(total, &s.total, json.Unmarshal(total, &s.total)); err != nil {
+				return err
+			}
+		default:
+			var value json.RawMessage
+			if err := dec.Decode(&value); err != nil {
+				return err
+			}
+			others[key] = value
+		}
+	}
+
+	if status, ok := others["status"]; ok && string(status) == `"error"` {
+		data, _ := json.Marshal(others)
+		errorResponse := &ErrorResponse{Body: data}
+		json.Unmarshal(data, errorResponse)
+		return errorResponse
+	}
+
+	return expectDelim(dec, '}')
+}
+
+// decodeRows decodes the rows array, calling fn with each element.
+// A null rows field is treated as empty.
+func (s *rowStream[T]) decodeRows(dec *json.Decoder, after func(data []byte, v interface{}, path string) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("snipeit: expected rows to be an array, got %v", tok)
+	}
+
+	for dec.More() {
+		var row T
+		var data json.RawMessage
+		if err := dec.Decode(&data); err != nil {
+			return err
+		}
+		if err := retryLenient(data, &row, json.Unmarshal(data, &row)); err != nil {
+			return err
+		}
+		if after != nil {
+			if err := after(data, &row, fmt.Sprintf("rows[%d]", s.rows)); err != nil {
+				return err
+			}
+		}
+		s.rows++
+		if err := s.fn(row); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, ']')
+}
+
+// expectDelim reads the next token from dec and checks that it is delim.
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("snipeit: expected %v in response, got %v", delim, tok)
+	}
+	return nil
+}
+
+// streamList walks the pages of the list endpoint at path, decoding each
+// page with a rowStream so that fn is called with every row as it arrives.
+//
+// Pages are fetched one at a time and rows are delivered in order;
+// opts.Prefetch is ignored. Streaming stops at the first error returned by fn,
+// and that error is returned.
+func streamList[T any, O any, PO interface {
+	*O
+	pageable
+}](ctx context.Context, c *Client, path string, opts *O, fn func(row T) error) error {
+	var streamOpts O
+	if opts != nil {
+		streamOpts = *opts
+	}
+	PO(&streamOpts).listOptions().Prefetch = 0
+
+	return paginate[struct{}, O, PO](ctx, &streamOpts, func(ctx context.Context, pageOpts *O) (struct{}, int, int, error) {
+		u, err := c.AddOptions(path, pageOpts)
+		if err != nil {
+			return struct{}{}, 0, 0, err
+		}
+
+		req, err := c.newRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return struct{}{}, 0, 0, err
+		}
+
+		stream := &rowStream[T]{fn: fn}
+		if _, err := c.Do(req, stream); err != nil {
+			return struct{}{}, 0, 0, err
+		}
+
+		return struct{}{}, stream.rows, stream.total, nil
+	}, func(struct{}) error { return nil })
+}
+
+// Stream calls fn with every asset, following pagination. Each page is
+// decoded incrementally as it is received, so only one asset is held in
+// memory at a time regardless of the page size; use it for full-inventory
+// exports.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// Streaming stops at the first error returned by fn, and that error is returned.
+// A failure part way through a page is not retried, since fn has already
+// seen some of its assets.
+func (s *AssetsService) Stream(opts *ListOptions, fn func(asset Asset) error) error {
+	return s.StreamContext(context.Background(), opts, fn)
+}
+
+// StreamContext calls fn with every asset with the provided context,
+// following pagination and decoding each page incrementally.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// Streaming stops at the first error returned by fn, and that error is returned.
+func (s *AssetsService) StreamContext(ctx context.Context, opts *ListOptions, fn func(asset Asset) error) error {
+	return streamList(ctx, s.client, "api/v1/hardware", opts, fn)
+}
@@ -0,0 +1,108 @@
+package snipeit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAssetsStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	offsets := handleAssetPages(t, mux, 5)
+
+	var ids []int
+	err := client.Assets.Stream(&ListOptions{Limit: 2, Prefetch: 3}, func(asset Asset) error {
+		ids = append(ids, asset.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Assets.Stream returned error: %v", err)
+	}
+
+	if expected := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Assets.Stream visited %v, expected %v", ids, expected)
+	}
+	if expected := []string{"", "2", "4"}; !reflect.DeepEqual(*offsets, expected) {
+		t.Errorf("Assets.Stream requested offsets %v, expected %v", *offsets, expected)
+	}
+}
+
+func TestAssetsStreamStop(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	offsets := handleAssetPages(t, mux, 5)
+
+	stop := errors.New("stop")
+	visited := 0
+	err := client.Assets.Stream(&ListOptions{Limit: 2}, func(asset Asset) error {
+		visited++
+		if asset.ID == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("Assets.Stream returned error %v, expected %v", err, stop)
+	}
+
+	if visited != 3 {
+		t.Errorf("Assets.Stream visited %d assets, expected %d", visited, 3)
+	}
+	if len(*offsets) != 2 {
+		t.Errorf("Assets.Stream made %d requests, expected %d", len(*offsets), 2)
+	}
+}
+
+func TestAssetsStreamStatusError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": "error", "messages": "Search failed", "payload": null}`)
+	})
+
+	err := client.Assets.Stream(nil, func(asset Asset) error {
+		t.Error("Assets.Stream called fn for an error response")
+		return nil
+	})
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("Assets.Stream returned error %v, expected an *ErrorResponse", err)
+	}
+	if errorResponse.Message != "Search failed" {
+		t.Errorf("ErrorResponse.Message = %q, expected %q", errorResponse.Message, "Search failed")
+	}
+}
+
+func TestRowStreamInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"Not an object", `[{"id": 1}]`},
+		{"Rows not an array", `{"total": 1, "rows": {"id": 1}}`},
+		{"Truncated", `{"total": 2, "rows": [{"id": 1},`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, tt.body)
+			})
+
+			err := client.Assets.Stream(nil, func(asset Asset) error { return nil })
+			if err == nil {
+				t.Error("Assets.Stream returned no error")
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// UnknownFieldsError is returned in strict decoding mode when a response
+// contains fields that the type it is decoded into does not model, which
+// usually means a new Snipe-IT release added them.
+type UnknownFieldsError struct {
+	// Method and Endpoint identify the request (e.g., "GET", "/api/v1/hardware")
+	Method   string
+	Endpoint string
+
+	// Type is the Go type the response was decoded into
+	Type string
+
+	// Fields are the paths of the unknown fields (e.g., "rows[0].byod"),
+	// sorted
+	Fields []string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("snipeit: %s %s: response has fields not modeled by %s: %s",
+		e.Method, e.Endpoint, e.Type, strings.Join(e.Fields, ", "))
+}
+
+// checkUnknownFields returns an *UnknownFieldsError if data, the response
+// to req, has fields that v does not model, and nil otherwise.
+//
+// It applies the rule of json.Decoder.DisallowUnknownFields throughout the
+// response, including the parts decoded by the custom decoders of response
+// envelopes such as AssetResponse. Values of other types with custom
+// decoders, such as SnipeTime and CustomFields, define their own shape and
+// are not checked.
+func checkUnknownFields(req *http.Request, data []byte, v interface{}) error {
+	return checkUnknownFieldsAt(req, data, v, "")
+}
+
+// checkUnknownFieldsAt is checkUnknownFields for data found at path in the
+// response.
+func checkUnknownFieldsAt(req *http.Request, data []byte, v interface{}, path string) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+
+	t := reflect.TypeOf(v)
+	var fields []string
+	unknownFields(value, t, path, &fields)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	sort.Strings(fields)
+	return &UnknownFieldsError{
+		Method:   req.Method,
+		Endpoint: req.URL.Path,
+		Type:     t.String(),
+		Fields:   fields,
+	}
+}
+
+// unmarshalerType is the type of json.Unmarshaler.
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// responseType is the type of Response, embedded by response envelopes.
+var responseType = reflect.TypeOf(Response{})
+
+// unknownFields appends to fields the paths of the fields of value, a
+// decoded JSON value at path, that t does not model.
+func unknownFields(value interface{}, t reflect.Type, path string, fields *[]string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if reflect.PointerTo(t).Implements(unmarshalerType) && !isEnvelope(t) {
+		return
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Struct:
+			known := jsonFields(t)
+			for key, item := range value {
+				field, ok := known[strings.ToLower(key)]
+				if !ok {
+					*fields = append(*fields, joinPath(path, key))
+					continue
+				}
+				unknownFields(item, field.Type, joinPath(path, key), fields)
+			}
+		case reflect.Map:
+			for key, item := range value {
+				unknownFields(item, t.Elem(), joinPath(path, key), fields)
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for i, item := range value {
+				unknownFields(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i), fields)
+			}
+		}
+	}
+}
+
+// isEnvelope reports whether t is a response envelope, a struct embedding
+// Response whose custom decoder fills its fields from the response.
+func isEnvelope(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.Anonymous && f.Type == responseType {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonFields returns the fields of the struct type t by lowercased JSON
+// name, including those promoted from embedded structs, as encoding/json
+// matches them. The Index of each field is its index sequence in t.
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			for key, field := range jsonFields(ft) {
+				if _, ok := fields[key]; !ok {
+					field.Index = append([]int{i}, field.Index...)
+					fields[key] = field
+				}
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = f
+	}
+	return fields
+}
+
+// joinPath appends key to the JSON path.
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,94 @@
+package snipeit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// newStrictClient returns a client in strict decoding mode for serverURL.
+func newStrictClient(t *testing.T, serverURL string) *Client {
+	t.Helper()
+
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{StrictDecoding: true, DisableRetries: true})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	return client
+}
+
+func TestStrictDecoding(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [{
+				"id": 1,
+				"asset_tag": "AT-1",
+				"future_field": false,
+				"model": {"id": 1, "name": "Model 1", "eol_months": 36},
+				"created_at": {"datetime": "2023-01-01 12:00:00", "formatted": "Sun Jan 01, 2023 12:00PM"},
+				"custom_fields": {"RAM": {"field": "_snipeit_ram_2", "value": "16", "field_format": "NUMERIC"}}
+			}]
+		}`)
+	})
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": "success", "messages": "ok", "payload": {"id": 1, "Asset_Tag": "AT-1", "age": "2 years"}}`)
+	})
+
+	client := newStrictClient(t, serverURL)
+
+	_, _, err := client.Assets.List(nil)
+	var unknown *UnknownFieldsError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("Assets.List returned error %v, expected an *UnknownFieldsError", err)
+	}
+	if expected := []string{"rows[0].future_field", "rows[0].model.eol_months"}; !reflect.DeepEqual(unknown.Fields, expected) {
+		t.Errorf("UnknownFieldsError.Fields = %v, expected %v", unknown.Fields, expected)
+	}
+	if unknown.Method != http.MethodGet || unknown.Endpoint != "/api/v1/hardware" {
+		t.Errorf("UnknownFieldsError names %s %s, expected GET /api/v1/hardware", unknown.Method, unknown.Endpoint)
+	}
+
+	// Fields decoded by response envelopes are checked too
+	_, _, err = client.Assets.PatchContext(t.Context(), 1, map[string]interface{}{"name": "x"})
+	if !errors.As(err, &unknown) {
+		t.Fatalf("Assets.Patch returned error %v, expected an *UnknownFieldsError", err)
+	}
+	if expected := []string{"payload.age"}; !reflect.DeepEqual(unknown.Fields, expected) {
+		t.Errorf("UnknownFieldsError.Fields = %v, expected %v", unknown.Fields, expected)
+	}
+
+	// Streamed rows are checked as they are decoded
+	err = client.Assets.Stream(nil, func(asset Asset) error { return nil })
+	if !errors.As(err, &unknown) || unknown.Fields[0] != "rows[0].future_field" {
+		t.Errorf("Assets.Stream returned error %v, expected an *UnknownFieldsError for rows[0].future_field", err)
+	}
+}
+
+func TestStrictDecodingKnownFields(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "asset_tag": "AT-1", "supplier": null, "purchase_cost": "1,234.56"}`)
+	})
+
+	client := newStrictClient(t, serverURL)
+	if _, _, err := client.Assets.Get(1); err != nil {
+		t.Errorf("Assets.Get returned error: %v", err)
+	}
+
+	// Unknown fields are ignored by default
+	mux.HandleFunc("/api/v1/hardware/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 2, "future_field": true}`)
+	})
+	client.strictDecoding = false
+	if _, _, err := client.Assets.Get(2); err != nil {
+		t.Errorf("Assets.Get returned error: %v", err)
+	}
+}
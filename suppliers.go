@@ -0,0 +1,245 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// SuppliersService handles communication with the supplier-related endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/suppliers
+type SuppliersService struct {
+	client *Client
+}
+
+// SupplierResponse represents the API response for a single supplier.
+// The single supplier endpoint returns the supplier data directly, while
+// mutating endpoints wrap it in a payload field alongside a status.
+// Both shapes are decoded into the embedded Supplier.
+type SupplierResponse struct {
+	Response
+	// Payload contains the supplier as returned in the payload field, if any
+	Payload *Supplier `json:"payload,omitempty"`
+	Supplier
+}
+
+// UnmarshalJSON implements json.Unmarshaler for SupplierResponse.
+func (r *SupplierResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.Supplier)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.Supplier
+	}
+	return nil
+}
+
+// SuppliersResponse represents the API response for multiple suppliers.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Suppliers.
+type SuppliersResponse struct {
+	Response
+	// Rows contains the list of Supplier objects
+	Rows []Supplier `json:"rows"`
+}
+
+// List returns a list of suppliers with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/suppliers
+func (s *SuppliersService) List(opts *ListOptions) (*SuppliersResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of suppliers with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/suppliers
+func (s *SuppliersService) ListContext(ctx context.Context, opts *ListOptions) (*SuppliersResponse, *http.Response, error) {
+	u := "api/v1/suppliers"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var suppliers SuppliersResponse
+	resp, err := s.client.Do(req, &suppliers)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &suppliers, resp, nil
+}
+
+// Iterate returns an iterator over every supplier, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Supplier.
+func (s *SuppliersService) Iterate(opts *ListOptions) iter.Seq2[Supplier, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every supplier with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero Supplier.
+func (s *SuppliersService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[Supplier, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]Supplier, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single supplier by its ID.
+//
+// id is the unique identifier of the supplier to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/suppliersid
+func (s *SuppliersService) Get(id int) (*SupplierResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single supplier by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the supplier to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/suppliersid
+func (s *SuppliersService) GetContext(ctx context.Context, id int) (*SupplierResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/suppliers/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var supplier SupplierResponse
+	resp, err := s.client.Do(req, &supplier)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &supplier, resp, nil
+}
+
+// Create creates a new supplier in Snipe-IT.
+//
+// supplier must contain the required fields:
+// - Name: The name of the supplier
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/suppliers-1
+func (s *SuppliersService) Create(supplier Supplier) (*SupplierResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), supplier)
+}
+
+// CreateContext creates a new supplier in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// supplier must contain the required fields:
+// - Name: The name of the supplier
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/suppliers-1
+func (s *SuppliersService) CreateContext(ctx context.Context, supplier Supplier) (*SupplierResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/suppliers", supplier)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response SupplierResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing supplier in Snipe-IT.
+//
+// id is the unique identifier of the supplier to update.
+// supplier contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/suppliersid-1
+func (s *SuppliersService) Update(id int, supplier Supplier) (*SupplierResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, supplier)
+}
+
+// UpdateContext updates an existing supplier in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the supplier to update.
+// supplier contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/suppliersid-1
+func (s *SuppliersService) UpdateContext(ctx context.Context, id int, supplier Supplier) (*SupplierResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/suppliers/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, supplier)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response SupplierResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a supplier from Snipe-IT.
+//
+// id is the unique identifier of the supplier to delete.
+// Snipe-IT refuses to delete suppliers that still have items assigned.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/suppliersid-2
+func (s *SuppliersService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a supplier from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the supplier to delete.
+// Snipe-IT refuses to delete suppliers that still have items assigned.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/suppliersid-2
+func (s *SuppliersService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/suppliers/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,90 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSuppliersList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/suppliers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "Authorization", "Bearer test-token")
+		if search := r.URL.Query().Get("search"); search != "CDW" {
+			t.Errorf("Request search = %q, expected %q", search, "CDW")
+		}
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 1, "name": "CDW", "contact": "Pat Lee", "phone": "555-0100"},
+				{"id": 2, "name": "CDW Canada"}
+			]
+		}`)
+	})
+
+	suppliers, _, err := client.Suppliers.List(&ListOptions{Search: "CDW"})
+	if err != nil {
+		t.Fatalf("Suppliers.List returned error: %v", err)
+	}
+
+	if len(suppliers.Rows) != 2 {
+		t.Fatalf("Suppliers.List returned %d suppliers, expected %d", len(suppliers.Rows), 2)
+	}
+
+	cdw := suppliers.Rows[0]
+	if cdw.ContactName != "Pat Lee" || cdw.Phone != "555-0100" {
+		t.Errorf("Suppliers.List returned %+v, expected CDW with contact Pat Lee", cdw)
+	}
+}
+
+func TestSuppliersCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/suppliers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["name"] != "Insight" || requestBody["phone"] != "555-0199" {
+			t.Errorf("Request body = %v, expected name and phone", requestBody)
+		}
+
+		fmt.Fprint(w, `{
+			"status": "success",
+			"messages": "Supplier created successfully.",
+			"payload": {"id": 3, "name": "Insight"}
+		}`)
+	})
+
+	supplier := Supplier{Phone: "555-0199"}
+	supplier.Name = "Insight"
+
+	created, _, err := client.Suppliers.Create(supplier)
+	if err != nil {
+		t.Fatalf("Suppliers.Create returned error: %v", err)
+	}
+
+	if created.Status != "success" || created.Payload == nil || created.Payload.ID != 3 {
+		t.Errorf("Suppliers.Create returned %+v, expected a success payload with ID 3", created)
+	}
+}
+
+func TestSuppliersDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/suppliers/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success", "messages": "Supplier deleted."}`)
+	})
+
+	if _, err := client.Suppliers.Delete(3); err != nil {
+		t.Errorf("Suppliers.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// TLSOptions configures the TLS connections to a Snipe-IT instance, such as
+// a self-hosted instance using a private certificate authority.
+//
+// PEM data can be given inline or as a file path; when both are set, the
+// inline data is used.
+type TLSOptions struct {
+	// RootCAs is PEM-encoded certificate authorities trusted in addition
+	// to the system roots
+	RootCAs []byte
+
+	// RootCAFile is the path of a PEM file of trusted certificate authorities
+	RootCAFile string
+
+	// ClientCert and ClientKey are the PEM-encoded certificate and private key
+	// presented to servers requiring mutual TLS
+	ClientCert []byte
+	ClientKey  []byte
+
+	// ClientCertFile and ClientKeyFile are the paths of the PEM files of the
+	// certificate and private key presented to servers requiring mutual TLS
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// InsecureSkipVerify disables verification of the server's certificate.
+	// It makes connections vulnerable to interception and should only be
+	// used for testing.
+	InsecureSkipVerify bool
+
+	// MinVersion is the minimum TLS version to accept (e.g., tls.VersionTLS13).
+	// If zero, Go's default is used.
+	MinVersion uint16
+}
+
+// tlsConfig builds the tls.Config described by the options.
+func (o *TLSOptions) tlsConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		InsecureSkipVerify: o.InsecureSkipVerify,
+		MinVersion:         o.MinVersion,
+	}
+
+	rootCAs, err := readPEM(o.RootCAs, o.RootCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("snipeit: reading root CAs: %w", err)
+	}
+	if rootCAs != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(rootCAs) {
+			return nil, errors.New("snipeit: no certificates found in root CAs")
+		}
+		config.RootCAs = pool
+	}
+
+	cert, err := readPEM(o.ClientCert, o.ClientCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("snipeit: reading client certificate: %w", err)
+	}
+	key, err := readPEM(o.ClientKey, o.ClientKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("snipeit: reading client key: %w", err)
+	}
+	if cert != nil || key != nil {
+		pair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("snipeit: loading client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{pair}
+	}
+
+	return config, nil
+}
+
+// readPEM returns data if it is set, or else the contents of the file at path.
+// It returns nil if neither is set.
+func readPEM(data []byte, path string) ([]byte, error) {
+	if len(data) > 0 || path == "" {
+		return data, nil
+	}
+	return os.ReadFile(path)
+}
+
+// tlsHTTPClient returns a copy of httpClient whose transport uses the TLS
+// configuration described by opts. The transport of httpClient, or the
+// default transport if it has none, is cloned and left untouched; it must
+// be an *http.Transport, since other round trippers expose no TLS settings.
+func tlsHTTPClient(httpClient *http.Client, opts *TLSOptions) (*http.Client, error) {
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	baseTransport, ok := base.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("snipeit: ClientOptions.TLS requires an *http.Transport, not %T", base)
+	}
+
+	config, err := opts.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	transport := baseTransport.Clone()
+	transport.TLSClientConfig = config
+
+	client := *httpClient
+	client.Transport = transport
+	return &client, nil
+}
@@ -0,0 +1,155 @@
+package snipeit
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTLSTestServer starts a TLS server answering every request with an asset.
+// If clientCAs is not nil, the server requires a client certificate signed by it.
+func newTLSTestServer(clientCAs *x509.CertPool) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	if clientCAs != nil {
+		server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	}
+	server.StartTLS()
+	return server
+}
+
+// serverCertPEM returns the PEM-encoded certificate of a TLS test server.
+func serverCertPEM(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+// newClientCert generates a self-signed client certificate and key, PEM-encoded.
+func newClientCert(t *testing.T) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "go-snipeit test client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestClientTLSRootCAs(t *testing.T) {
+	server := newTLSTestServer(nil)
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, serverCertPEM(server), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		tls  *TLSOptions
+	}{
+		{"PEM data", &TLSOptions{RootCAs: serverCertPEM(server)}},
+		{"PEM file", &TLSOptions{RootCAFile: caFile}},
+		{"InsecureSkipVerify", &TLSOptions{InsecureSkipVerify: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClientWithOptions(server.URL, "test-token", &ClientOptions{TLS: tt.tls})
+			if err != nil {
+				t.Fatalf("NewClientWithOptions returned error: %v", err)
+			}
+
+			if _, _, err := client.Assets.Get(1); err != nil {
+				t.Errorf("Assets.Get returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestClientTLSClientCertificate(t *testing.T) {
+	certPEM, keyPEM := newClientCert(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(certPEM)
+
+	server := newTLSTestServer(clientCAs)
+	defer server.Close()
+
+	client, err := NewClientWithOptions(server.URL, "test-token", &ClientOptions{
+		TLS: &TLSOptions{RootCAs: serverCertPEM(server), ClientCert: certPEM, ClientKey: keyPEM},
+	})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	if _, _, err := client.Assets.Get(1); err != nil {
+		t.Errorf("Assets.Get with a client certificate returned error: %v", err)
+	}
+
+	client, err = NewClientWithOptions(server.URL, "test-token", &ClientOptions{
+		TLS:            &TLSOptions{RootCAs: serverCertPEM(server)},
+		DisableRetries: true,
+	})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	if _, _, err := client.Assets.Get(1); err == nil {
+		t.Error("Assets.Get without a client certificate returned no error")
+	}
+}
+
+func TestClientTLSErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *ClientOptions
+	}{
+		{"invalid root CAs", &ClientOptions{TLS: &TLSOptions{RootCAs: []byte("not a certificate")}}},
+		{"missing root CA file", &ClientOptions{TLS: &TLSOptions{RootCAFile: filepath.Join(t.TempDir(), "missing.pem")}}},
+		{"certificate without key", &ClientOptions{TLS: &TLSOptions{ClientCert: []byte("not a certificate")}}},
+		{"custom round tripper", &ClientOptions{
+			Transport: roundTripperFunc(http.DefaultTransport.RoundTrip),
+			TLS:       &TLSOptions{InsecureSkipVerify: true},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewClientWithOptions("https://assets.example.com", "test-token", tt.options); err == nil {
+				t.Error("NewClientWithOptions returned no error")
+			}
+		})
+	}
+}
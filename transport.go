@@ -0,0 +1,72 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+// Default settings of the HTTP client built when ClientOptions.HTTPClient
+// is nil.
+const (
+	defaultTimeout               = 60 * time.Second
+	defaultDialTimeout           = 10 * time.Second
+	defaultKeepAlive             = 30 * time.Second
+	defaultTLSHandshakeTimeout   = 10 * time.Second
+	defaultIdleConnTimeout       = 90 * time.Second
+	defaultMaxIdleConns          = 100
+	defaultMaxIdleConnsPerHost   = 10
+	defaultExpectContinueTimeout = 1 * time.Second
+)
+
+// newHTTPClient builds the HTTP client used when options.HTTPClient is nil.
+//
+// Unlike a bare http.Client, it has an overall timeout, so a server that
+// stops responding cannot hang the caller, and its transport keeps enough
+// idle connections per host for concurrent requests to the Snipe-IT server
+// and attempts HTTP/2. Zero-valued options select the defaults above.
+func newHTTPClient(options *ClientOptions) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   durationOption(options.DialTimeout, defaultDialTimeout),
+		KeepAlive: defaultKeepAlive,
+	}
+
+	maxIdleConnsPerHost := options.MaxIdleConnsPerHost
+	if maxIdleConnsPerHost == 0 {
+		maxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	}
+	maxIdleConns := defaultMaxIdleConns
+	if maxIdleConnsPerHost > maxIdleConns {
+		maxIdleConns = maxIdleConnsPerHost
+	}
+
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     !options.DisableHTTP2,
+		MaxIdleConns:          maxIdleConns,
+		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+		IdleConnTimeout:       durationOption(options.IdleConnTimeout, defaultIdleConnTimeout),
+		TLSHandshakeTimeout:   durationOption(options.TLSHandshakeTimeout, defaultTLSHandshakeTimeout),
+		ResponseHeaderTimeout: durationOption(options.ResponseHeaderTimeout, 0),
+		ExpectContinueTimeout: defaultExpectContinueTimeout,
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   durationOption(options.Timeout, defaultTimeout),
+	}
+}
+
+// durationOption returns value, or def if value is zero. A negative value
+// disables the setting and is returned as zero.
+func durationOption(value, def time.Duration) time.Duration {
+	switch {
+	case value == 0:
+		return def
+	case value < 0:
+		return 0
+	}
+	return value
+}
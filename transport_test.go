@@ -0,0 +1,91 @@
+package snipeit
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewClientHTTPDefaults(t *testing.T) {
+	client, err := NewClient("https://snipeit.example.com", "test-token")
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	if client.client.Timeout != defaultTimeout {
+		t.Errorf("HTTP client Timeout = %v, expected %v", client.client.Timeout, defaultTimeout)
+	}
+
+	transport, ok := client.client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("HTTP client Transport is %T, expected *http.Transport", client.client.Transport)
+	}
+	if !transport.ForceAttemptHTTP2 {
+		t.Error("Transport does not attempt HTTP/2")
+	}
+	if transport.MaxIdleConnsPerHost != defaultMaxIdleConnsPerHost {
+		t.Errorf("Transport MaxIdleConnsPerHost = %d, expected %d", transport.MaxIdleConnsPerHost, defaultMaxIdleConnsPerHost)
+	}
+	if transport.IdleConnTimeout != defaultIdleConnTimeout {
+		t.Errorf("Transport IdleConnTimeout = %v, expected %v", transport.IdleConnTimeout, defaultIdleConnTimeout)
+	}
+	if transport.TLSHandshakeTimeout != defaultTLSHandshakeTimeout {
+		t.Errorf("Transport TLSHandshakeTimeout = %v, expected %v", transport.TLSHandshakeTimeout, defaultTLSHandshakeTimeout)
+	}
+	if transport.Proxy == nil {
+		t.Error("Transport ignores proxy environment variables")
+	}
+}
+
+func TestNewClientHTTPOptions(t *testing.T) {
+	client, err := NewClientWithOptions("https://snipeit.example.com", "test-token", &ClientOptions{
+		Timeout:               -1,
+		IdleConnTimeout:       time.Minute,
+		ResponseHeaderTimeout: 5 * time.Second,
+		MaxIdleConnsPerHost:   200,
+		DisableHTTP2:          true,
+	})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if client.client.Timeout != 0 {
+		t.Errorf("HTTP client Timeout = %v, expected no timeout", client.client.Timeout)
+	}
+
+	transport := client.client.Transport.(*http.Transport)
+	if transport.ForceAttemptHTTP2 {
+		t.Error("Transport attempts HTTP/2 with DisableHTTP2 set")
+	}
+	if transport.MaxIdleConnsPerHost != 200 || transport.MaxIdleConns != 200 {
+		t.Errorf("Transport MaxIdleConnsPerHost = %d, MaxIdleConns = %d, expected 200", transport.MaxIdleConnsPerHost, transport.MaxIdleConns)
+	}
+	if transport.IdleConnTimeout != time.Minute {
+		t.Errorf("Transport IdleConnTimeout = %v, expected %v", transport.IdleConnTimeout, time.Minute)
+	}
+	if transport.ResponseHeaderTimeout != 5*time.Second {
+		t.Errorf("Transport ResponseHeaderTimeout = %v, expected %v", transport.ResponseHeaderTimeout, 5*time.Second)
+	}
+}
+
+func TestNewClientTimeout(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/1", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	})
+
+	client, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{Timeout: 20 * time.Millisecond, DisableRetries: true})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	_, _, err = client.Assets.Get(1)
+	var netErr net.Error
+	if !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Errorf("Assets.Get returned error %v, expected a timeout", err)
+	}
+}
@@ -0,0 +1,236 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UpsertAction is what an upsert did to an asset.
+type UpsertAction string
+
+const (
+	// UpsertCreated means that no asset matched, so one was created
+	UpsertCreated UpsertAction = "created"
+
+	// UpsertUpdated means that the matching asset was patched
+	UpsertUpdated UpsertAction = "updated"
+
+	// UpsertUnchanged means that the matching asset already had the
+	// requested values, so no update was sent
+	UpsertUnchanged UpsertAction = "unchanged"
+)
+
+// UpsertResult is the outcome of an upsert.
+type UpsertResult struct {
+	// Action is what the upsert did
+	Action UpsertAction
+
+	// ID is the unique identifier of the created or matching asset
+	ID int
+
+	// Fields are the API names of the fields that were patched, sorted,
+	// if Action is UpsertUpdated
+	Fields []string
+
+	// Asset is the response of the create or patch request, or nil if
+	// Action is UpsertUnchanged
+	Asset *AssetResponse
+}
+
+// UpsertBySerial creates an asset, or updates the asset with the same
+// serial number.
+//
+// asset holds the values of the asset; its Serial is required. If no
+// asset has that serial, asset is created. Otherwise, the fields of asset
+// that are set and differ from those of the existing asset are patched,
+// and unset fields are left unchanged. It is an error for several assets
+// to have the serial.
+func (s *AssetsService) UpsertBySerial(asset AssetCreateRequest) (*UpsertResult, error) {
+	return s.UpsertBySerialContext(context.Background(), asset)
+}
+
+// UpsertBySerialContext creates an asset, or updates the asset with the
+// same serial number, with the provided context.
+//
+// ctx is the context for the requests.
+// asset holds the values of the asset; its Serial is required. If no
+// asset has that serial, asset is created. Otherwise, the fields of asset
+// that are set and differ from those of the existing asset are patched,
+// and unset fields are left unchanged. It is an error for several assets
+// to have the serial.
+func (s *AssetsService) UpsertBySerialContext(ctx context.Context, asset AssetCreateRequest) (*UpsertResult, error) {
+	if asset.Serial == "" {
+		return nil, errors.New("snipeit: upsert by serial requires a serial")
+	}
+
+	assets, _, err := s.GetAssetBySerialContext(ctx, asset.Serial)
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+
+	var existing *Asset
+	if err == nil {
+		switch len(assets.Rows) {
+		case 0:
+		case 1:
+			existing = &assets.Rows[0]
+		default:
+			return nil, fmt.Errorf("snipeit: %d assets have serial %q", len(assets.Rows), asset.Serial)
+		}
+	}
+
+	return s.upsert(ctx, existing, asset)
+}
+
+// UpsertByTag creates an asset, or updates the asset with the same asset
+// tag.
+//
+// asset holds the values of the asset; its AssetTag is required. If no
+// asset has that tag, asset is created. Otherwise, the fields of asset
+// that are set and differ from those of the existing asset are patched,
+// and unset fields are left unchanged.
+func (s *AssetsService) UpsertByTag(asset AssetCreateRequest) (*UpsertResult, error) {
+	return s.UpsertByTagContext(context.Background(), asset)
+}
+
+// UpsertByTagContext creates an asset, or updates the asset with the same
+// asset tag, with the provided context.
+//
+// ctx is the context for the requests.
+// asset holds the values of the asset; its AssetTag is required. If no
+// asset has that tag, asset is created. Otherwise, the fields of asset
+// that are set and differ from those of the existing asset are patched,
+// and unset fields are left unchanged.
+func (s *AssetsService) UpsertByTagContext(ctx context.Context, asset AssetCreateRequest) (*UpsertResult, error) {
+	if asset.AssetTag == "" {
+		return nil, errors.New("snipeit: upsert by tag requires an asset tag")
+	}
+
+	response, _, err := s.GetAssetByTagContext(ctx, asset.AssetTag)
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+
+	var existing *Asset
+	if err == nil {
+		existing = &response.Asset
+	}
+
+	return s.upsert(ctx, existing, asset)
+}
+
+// upsert creates asset if existing is nil, and otherwise patches the
+// fields of existing that differ from asset.
+func (s *AssetsService) upsert(ctx context.Context, existing *Asset, asset AssetCreateRequest) (*UpsertResult, error) {
+	if existing == nil {
+		created, _, err := s.CreateContext(ctx, asset)
+		if err != nil {
+			return nil, err
+		}
+		return &UpsertResult{Action: UpsertCreated, ID: created.ID, Asset: created}, nil
+	}
+
+	fields := assetChanges(*existing, asset)
+	if len(fields) == 0 {
+		return &UpsertResult{Action: UpsertUnchanged, ID: existing.ID}, nil
+	}
+
+	updated, _, err := s.PatchContext(ctx, existing.ID, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return &UpsertResult{Action: UpsertUpdated, ID: existing.ID, Fields: names, Asset: updated}, nil
+}
+
+// assetNotFoundMessage is the message with which Snipe-IT reports, with a
+// 200 status, that no asset has the tag or serial looked up.
+const assetNotFoundMessage = "Asset does not exist."
+
+// isNotFound reports whether err is the error of a lookup that found
+// nothing: a 404 response, or a response with the message Snipe-IT sends
+// for unknown tags and serials. Other errors reported with a 200 status,
+// such as a denied permission, are not.
+func isNotFound(err error) bool {
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
+
+	var errorResponse *ErrorResponse
+	return errors.As(err, &errorResponse) &&
+		strings.EqualFold(strings.TrimSpace(errorResponse.Message), assetNotFoundMessage)
+}
+
+// assetChanges returns the fields of asset that are set and differ from
+// those of existing, as the API names and values of a patch.
+func assetChanges(existing Asset, asset AssetCreateRequest) map[string]interface{} {
+	fields := make(map[string]interface{})
+	setID := func(name string, id, current int) {
+		if id != 0 && id != current {
+			fields[name] = id
+		}
+	}
+	setString := func(name, value, current string) {
+		if value != "" && value != current {
+			fields[name] = value
+		}
+	}
+	setDate := func(name string, date *Date, current *SnipeTime) {
+		if date != nil && (current == nil || *date != DateOf(current.Time)) {
+			fields[name] = *date
+		}
+	}
+
+	var companyID, locationID, rtdLocationID, supplierID int
+	if existing.Company != nil {
+		companyID = existing.Company.ID
+	}
+	if existing.Location != nil {
+		locationID = existing.Location.ID
+	}
+	if existing.RTDLocation != nil {
+		rtdLocationID = existing.RTDLocation.ID
+	}
+	if existing.Supplier != nil {
+		supplierID = existing.Supplier.ID
+	}
+
+	setID("model_id", asset.ModelID, existing.Model.ID)
+	setID("status_id", asset.StatusID, existing.StatusLabel.ID)
+	setString("asset_tag", asset.AssetTag, existing.AssetTag)
+	setString("name", asset.Name, existing.Name)
+	setString("serial", asset.Serial, existing.Serial)
+	setID("company_id", asset.CompanyID, companyID)
+	setID("location_id", asset.LocationID, locationID)
+	setID("rtd_location_id", asset.RTDLocationID, rtdLocationID)
+	setID("supplier_id", asset.SupplierID, supplierID)
+	setString("order_number", asset.OrderNumber, existing.OrderNumber)
+	setDate("purchase_date", asset.PurchaseDate, existing.PurchaseDate)
+	if asset.PurchaseCost != nil && (existing.PurchaseCost == nil || asset.PurchaseCost.Cents != existing.PurchaseCost.Cents) {
+		fields["purchase_cost"] = *asset.PurchaseCost
+	}
+	setID("warranty_months", asset.WarrantyMonths, existing.WarrantyMonths)
+	setDate("next_audit_date", asset.NextAuditDate, existing.NextAuditDate)
+	if asset.Requestable && !bool(existing.Requestable) {
+		fields["requestable"] = true
+	}
+	setString("notes", asset.Notes, existing.Notes)
+
+	for column, value := range asset.CustomFieldValues {
+		column = customFieldColumn(column)
+		if current, ok := existing.CustomFields.Column(column); !ok || current.Value != value {
+			fields[column] = value
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,169 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAssetsUpsertBySerialCreates(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/byserial/SN1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"total": 0, "rows": []}`)
+	})
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["serial"] != "SN1" || body["model_id"] != float64(7) {
+			t.Errorf("Request body = %v, expected serial SN1 and model 7", body)
+		}
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 12, "serial": "SN1"}}`)
+	})
+
+	result, err := client.Assets.UpsertBySerial(AssetCreateRequest{ModelID: 7, StatusID: 1, Serial: "SN1"})
+	if err != nil {
+		t.Fatalf("Assets.UpsertBySerial returned error: %v", err)
+	}
+
+	if result.Action != UpsertCreated || result.ID != 12 || result.Asset == nil {
+		t.Errorf("Assets.UpsertBySerial returned %+v, expected asset 12 created", result)
+	}
+}
+
+func TestAssetsUpsertBySerialUpdates(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/byserial/SN1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 1, "rows": [{
+			"id": 12, "asset_tag": "AT-12", "name": "Laptop", "serial": "SN1",
+			"model": {"id": 7}, "status_label": {"id": 1}, "location": {"id": 4},
+			"purchase_date": {"date": "2024-03-01", "formatted": "2024-03-01"},
+			"custom_fields": {
+				"RAM": {"field": "_snipeit_ram_2", "value": "16"},
+				"OS": {"field": "_snipeit_os_3", "value": "macOS 14"}
+			}
+		}]}`)
+	})
+	mux.HandleFunc("/api/v1/hardware/12", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		expected := map[string]interface{}{"name": "Alice's laptop", "location_id": float64(5), "_snipeit_ram_2": "32"}
+		if !reflect.DeepEqual(body, expected) {
+			t.Errorf("Request body = %v, expected %v", body, expected)
+		}
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 12}}`)
+	})
+
+	purchased := NewDate(2024, 3, 1)
+	result, err := client.Assets.UpsertBySerial(AssetCreateRequest{
+		ModelID:           7,
+		StatusID:          1,
+		Serial:            "SN1",
+		Name:              "Alice's laptop",
+		LocationID:        5,
+		PurchaseDate:      &purchased,
+		CustomFieldValues: map[string]string{"ram_2": "32", "_snipeit_os_3": "macOS 14"},
+	})
+	if err != nil {
+		t.Fatalf("Assets.UpsertBySerial returned error: %v", err)
+	}
+
+	expected := []string{"_snipeit_ram_2", "location_id", "name"}
+	if result.Action != UpsertUpdated || result.ID != 12 || !reflect.DeepEqual(result.Fields, expected) {
+		t.Errorf("Assets.UpsertBySerial returned %+v, expected asset 12 updated with %v", result, expected)
+	}
+}
+
+func TestAssetsUpsertBySerialAmbiguous(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/byserial/SN1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 2, "rows": [{"id": 12, "serial": "SN1"}, {"id": 13, "serial": "SN1"}]}`)
+	})
+
+	if _, err := client.Assets.UpsertBySerial(AssetCreateRequest{ModelID: 7, StatusID: 1, Serial: "SN1"}); err == nil {
+		t.Error("Assets.UpsertBySerial returned no error for a serial shared by two assets")
+	}
+}
+
+func TestAssetsUpsertByTag(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/bytag/AT-12", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 12, "asset_tag": "AT-12", "model": {"id": 7}, "status_label": {"id": 1}, "purchase_cost": "1,299.50"}`)
+	})
+	mux.HandleFunc("/api/v1/hardware/bytag/AT-13", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": "error", "messages": "Asset does not exist."}`)
+	})
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 13, "asset_tag": "AT-13"}}`)
+	})
+	mux.HandleFunc("/api/v1/hardware/12", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Assets.UpsertByTag sent %s %s, expected no update", r.Method, r.URL.Path)
+	})
+
+	tests := []struct {
+		tag      string
+		expected UpsertAction
+		id       int
+	}{
+		{"AT-12", UpsertUnchanged, 12},
+		{"AT-13", UpsertCreated, 13},
+	}
+
+	for _, tt := range tests {
+		result, err := client.Assets.UpsertByTag(AssetCreateRequest{ModelID: 7, StatusID: 1, AssetTag: tt.tag, PurchaseCost: &Money{Cents: 129950}})
+		if err != nil {
+			t.Fatalf("Assets.UpsertByTag(%q) returned error: %v", tt.tag, err)
+		}
+
+		if result.Action != tt.expected || result.ID != tt.id {
+			t.Errorf("Assets.UpsertByTag(%q) returned %+v, expected asset %d %s", tt.tag, result, tt.id, tt.expected)
+		}
+	}
+}
+
+func TestAssetsUpsertLookupError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/bytag/AT-12", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"status": "error", "messages": "Unauthenticated."}`)
+	})
+
+	_, err := client.Assets.UpsertByTag(AssetCreateRequest{ModelID: 7, StatusID: 1, AssetTag: "AT-12"})
+	if err == nil {
+		t.Error("Assets.UpsertByTag returned no error, expected the lookup error")
+	}
+}
+
+func TestAssetsUpsertLookupStatusError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/hardware/bytag/AT-12", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status": "error", "messages": "You do not have permission to view assets."}`)
+	})
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Assets.UpsertByTag sent %s %s, expected no create", r.Method, r.URL.Path)
+	})
+
+	_, err := client.Assets.UpsertByTag(AssetCreateRequest{ModelID: 7, StatusID: 1, AssetTag: "AT-12"})
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || errorResponse.Message != "You do not have permission to view assets." {
+		t.Errorf("Assets.UpsertByTag returned error %v, expected the lookup error", err)
+	}
+}
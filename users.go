@@ -0,0 +1,412 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// UsersService handles communication with the user-related endpoints
+// of the Snipe-IT API.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/users
+type UsersService struct {
+	client *Client
+}
+
+// UserResponse represents the API response for a single user.
+// The single user endpoint returns the user data directly, while mutating
+// endpoints wrap it in a payload field alongside a status. Both shapes are
+// decoded into the embedded User.
+type UserResponse struct {
+	Response
+	// Payload contains the user as returned in the payload field, if any
+	Payload *User `json:"payload,omitempty"`
+	User
+}
+
+// UnmarshalJSON implements json.Unmarshaler for UserResponse.
+func (r *UserResponse) UnmarshalJSON(data []byte) error {
+	hasPayload, err := decodePayload(data, &r.Response, &r.User)
+	if err != nil {
+		return err
+	}
+	if hasPayload {
+		r.Payload = &r.User
+	}
+	return nil
+}
+
+// UsersResponse represents the API response for multiple users.
+// It embeds the standard Response struct and adds a Rows field
+// that contains a slice of Users.
+type UsersResponse struct {
+	Response
+	// Rows contains the list of User objects
+	Rows []User `json:"rows"`
+}
+
+// List returns a list of users with pagination options.
+//
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/users
+func (s *UsersService) List(opts *ListOptions) (*UsersResponse, *http.Response, error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext returns a list of users with the provided context and pagination options.
+//
+// ctx is the context for the request.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/users
+func (s *UsersService) ListContext(ctx context.Context, opts *ListOptions) (*UsersResponse, *http.Response, error) {
+	u := "api/v1/users"
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users UsersResponse
+	resp, err := s.client.Do(req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &users, resp, nil
+}
+
+// Iterate returns an iterator over every user, fetching pages lazily
+// as the caller ranges over it.
+//
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero User.
+func (s *UsersService) Iterate(opts *ListOptions) iter.Seq2[User, error] {
+	return s.IterateContext(context.Background(), opts)
+}
+
+// IterateContext returns an iterator over every user with the provided context,
+// fetching pages lazily as the caller ranges over it.
+//
+// ctx is the context for the requests.
+// opts can be used to customize the results with search and sorting. opts.Limit
+// sets the page size (default 500) and opts.Offset the starting position.
+// If opts is nil, default values will be used.
+//
+// An error ends the iteration and is yielded alongside a zero User.
+func (s *UsersService) IterateContext(ctx context.Context, opts *ListOptions) iter.Seq2[User, error] {
+	return iterate(ctx, opts, func(ctx context.Context, pageOpts *ListOptions) ([]User, int, error) {
+		page, _, err := s.ListContext(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Rows, page.Total, nil
+	})
+}
+
+// Get fetches a single user by its ID.
+//
+// id is the unique identifier of the user to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersid
+func (s *UsersService) Get(id int) (*UserResponse, *http.Response, error) {
+	return s.GetContext(context.Background(), id)
+}
+
+// GetContext fetches a single user by its ID with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the user to retrieve.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersid
+func (s *UsersService) GetContext(ctx context.Context, id int) (*UserResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/users/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user UserResponse
+	resp, err := s.client.Do(req, &user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &user, resp, nil
+}
+
+// Create creates a new user in Snipe-IT.
+//
+// user must contain the required fields:
+// - FirstName: The first name of the user
+// - Username: The login name of the user
+// - Password and PasswordConfirmation: The initial password of the user
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/users-1
+func (s *UsersService) Create(user User) (*UserResponse, *http.Response, error) {
+	return s.CreateContext(context.Background(), user)
+}
+
+// CreateContext creates a new user in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// user must contain the required fields:
+// - FirstName: The first name of the user
+// - Username: The login name of the user
+// - Password and PasswordConfirmation: The initial password of the user
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/users-1
+func (s *UsersService) CreateContext(ctx context.Context, user User) (*UserResponse, *http.Response, error) {
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/users", user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response UserResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Update updates an existing user in Snipe-IT.
+//
+// id is the unique identifier of the user to update.
+// user contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersid-1
+func (s *UsersService) Update(id int, user User) (*UserResponse, *http.Response, error) {
+	return s.UpdateContext(context.Background(), id, user)
+}
+
+// UpdateContext updates an existing user in Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the user to update.
+// user contains the fields to update.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersid-1
+func (s *UsersService) UpdateContext(ctx context.Context, id int, user User) (*UserResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/users/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPut, u, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response UserResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response, resp, nil
+}
+
+// Delete deletes a user from Snipe-IT.
+//
+// id is the unique identifier of the user to delete.
+// Snipe-IT refuses to delete users that still have items checked out to them.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersid-2
+func (s *UsersService) Delete(id int) (*http.Response, error) {
+	return s.DeleteContext(context.Background(), id)
+}
+
+// DeleteContext deletes a user from Snipe-IT with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the user to delete.
+// Snipe-IT refuses to delete users that still have items checked out to them.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersid-2
+func (s *UsersService) DeleteContext(ctx context.Context, id int) (*http.Response, error) {
+	u := fmt.Sprintf("api/v1/users/%d", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Assets returns the assets checked out to a user.
+//
+// id is the unique identifier of the user.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersidassets
+func (s *UsersService) Assets(id int, opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	return s.AssetsContext(context.Background(), id, opts)
+}
+
+// AssetsContext returns the assets checked out to a user with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the user.
+// opts can be used to customize the response with pagination, search, and sorting.
+// If opts is nil, default pagination values will be used.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersidassets
+func (s *UsersService) AssetsContext(ctx context.Context, id int, opts *ListOptions) (*AssetsResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/users/%d/assets", id)
+	if opts != nil {
+		var err error
+		u, err = s.client.AddOptions(u, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var assets AssetsResponse
+	resp, err := s.client.Do(req, &assets)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &assets, resp, nil
+}
+
+// Accessories returns the accessories checked out to a user.
+//
+// id is the unique identifier of the user.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersidaccessories
+func (s *UsersService) Accessories(id int) (*AccessoriesResponse, *http.Response, error) {
+	return s.AccessoriesContext(context.Background(), id)
+}
+
+// AccessoriesContext returns the accessories checked out to a user with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the user.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersidaccessories
+func (s *UsersService) AccessoriesContext(ctx context.Context, id int) (*AccessoriesResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/users/%d/accessories", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var accessories AccessoriesResponse
+	resp, err := s.client.Do(req, &accessories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &accessories, resp, nil
+}
+
+// Licenses returns the licenses checked out to a user.
+//
+// id is the unique identifier of the user.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersidlicenses
+func (s *UsersService) Licenses(id int) (*LicensesResponse, *http.Response, error) {
+	return s.LicensesContext(context.Background(), id)
+}
+
+// LicensesContext returns the licenses checked out to a user with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the user.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersidlicenses
+func (s *UsersService) LicensesContext(ctx context.Context, id int) (*LicensesResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/users/%d/licenses", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var licenses LicensesResponse
+	resp, err := s.client.Do(req, &licenses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &licenses, resp, nil
+}
+
+// Restore restores a soft-deleted user.
+//
+// id is the unique identifier of the user to restore.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersidrestore
+func (s *UsersService) Restore(id int) (*UserResponse, *http.Response, error) {
+	return s.RestoreContext(context.Background(), id)
+}
+
+// RestoreContext restores a soft-deleted user with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the user to restore.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/usersidrestore
+func (s *UsersService) RestoreContext(ctx context.Context, id int) (*UserResponse, *http.Response, error) {
+	u := fmt.Sprintf("api/v1/users/%d/restore", id)
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user UserResponse
+	resp, err := s.client.Do(req, &user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &user, resp, nil
+}
+
+// ResetTwoFactor resets the two-factor authentication enrollment of a user,
+// so that they are asked to enroll again on their next login.
+//
+// id is the unique identifier of the user.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/userstwo_factor_reset
+func (s *UsersService) ResetTwoFactor(id int) (*http.Response, error) {
+	return s.ResetTwoFactorContext(context.Background(), id)
+}
+
+// ResetTwoFactorContext resets the two-factor authentication enrollment of a user
+// with the provided context.
+//
+// ctx is the context for the request.
+// id is the unique identifier of the user.
+//
+// Snipe-IT API docs: https://snipe-it.readme.io/reference/userstwo_factor_reset
+func (s *UsersService) ResetTwoFactorContext(ctx context.Context, id int) (*http.Response, error) {
+	body := map[string]interface{}{"id": id}
+	req, err := s.client.newRequestWithContext(ctx, http.MethodPost, "api/v1/users/two_factor_reset", body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
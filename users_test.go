@@ -0,0 +1,291 @@
+package snipeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestUsersList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("search") != "doe" {
+			t.Errorf("Request URL query parameter 'search' = %v, expected %v", r.URL.Query().Get("search"), "doe")
+		}
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 1, "username": "jdoe", "first_name": "John", "last_name": "Doe"},
+				{"id": 2, "username": "adoe", "first_name": "Anna", "last_name": "Doe"}
+			]
+		}`)
+	})
+
+	users, _, err := client.Users.List(&ListOptions{Search: "doe"})
+	if err != nil {
+		t.Fatalf("Users.List returned error: %v", err)
+	}
+
+	if len(users.Rows) != 2 || users.Rows[1].Username != "adoe" {
+		t.Errorf("Users.List returned %+v, expected jdoe and adoe", users.Rows)
+	}
+}
+
+func TestUsersGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id": 1, "username": "jdoe", "email": "jdoe@example.com"}`)
+	})
+
+	user, _, err := client.Users.Get(1)
+	if err != nil {
+		t.Fatalf("Users.Get returned error: %v", err)
+	}
+
+	if user.ID != 1 || user.Email != "jdoe@example.com" {
+		t.Errorf("Users.Get returned %+v, expected ID 1 with email jdoe@example.com", user.User)
+	}
+}
+
+func TestUsersCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["username"] != "jdoe" || requestBody["password"] != "s3cret!pass" || requestBody["password_confirmation"] != "s3cret!pass" {
+			t.Errorf("Request body = %v, expected username jdoe with a confirmed password", requestBody)
+		}
+		if requestBody["department_id"] != 4.0 {
+			t.Errorf("Request body department_id = %v, expected %v", requestBody["department_id"], 4)
+		}
+
+		fmt.Fprint(w, `{
+			"status": "success",
+			"messages": "User created successfully.",
+			"payload": {"id": 9, "username": "jdoe"}
+		}`)
+	})
+
+	user, _, err := client.Users.Create(User{
+		Username:             "jdoe",
+		FirstName:            "John",
+		DepartmentID:         4,
+		Password:             "s3cret!pass",
+		PasswordConfirmation: "s3cret!pass",
+	})
+	if err != nil {
+		t.Fatalf("Users.Create returned error: %v", err)
+	}
+
+	if user.Payload == nil || user.Payload.ID != 9 {
+		t.Errorf("Users.Create returned Payload = %+v, expected ID %d", user.Payload, 9)
+	}
+}
+
+func TestUsersUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/users/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if _, ok := requestBody["password"]; ok {
+			t.Errorf("Request body = %v, expected no password", requestBody)
+		}
+
+		fmt.Fprint(w, `{"status": "success", "payload": {"id": 9, "username": "jdoe", "jobtitle": "Engineer"}}`)
+	})
+
+	user, _, err := client.Users.Update(9, User{Username: "jdoe", JobTitle: "Engineer"})
+	if err != nil {
+		t.Fatalf("Users.Update returned error: %v", err)
+	}
+
+	if user.JobTitle != "Engineer" {
+		t.Errorf("Users.Update returned JobTitle = %q, expected %q", user.JobTitle, "Engineer")
+	}
+}
+
+func TestUsersDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/users/9", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"status": "success", "messages": "User deleted"}`)
+	})
+
+	if _, err := client.Users.Delete(9); err != nil {
+		t.Fatalf("Users.Delete returned error: %v", err)
+	}
+}
+
+func TestUsersAssets(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/users/2/assets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "Authorization", "Bearer test-token")
+
+		if r.URL.Query().Get("limit") != "50" {
+			t.Errorf("Request URL query parameter 'limit' = %v, expected %v", r.URL.Query().Get("limit"), "50")
+		}
+
+		fmt.Fprint(w, `{
+			"total": 2,
+			"rows": [
+				{"id": 1, "name": "Laptop", "asset_tag": "AT-1", "assigned_to": {"id": 2, "name": "Jane Doe"}},
+				{"id": 5, "name": "Phone", "asset_tag": "AT-5", "assigned_to": {"id": 2, "name": "Jane Doe"}}
+			]
+		}`)
+	})
+
+	assets, _, err := client.Users.Assets(2, &ListOptions{Limit: 50})
+	if err != nil {
+		t.Fatalf("Users.Assets returned error: %v", err)
+	}
+
+	if assets.Total != 2 {
+		t.Errorf("Users.Assets returned Total = %d, expected %d", assets.Total, 2)
+	}
+
+	if len(assets.Rows) != 2 {
+		t.Fatalf("Users.Assets returned %d assets, expected %d", len(assets.Rows), 2)
+	}
+
+	if assets.Rows[1].AssetTag != "AT-5" {
+		t.Errorf("Users.Assets returned AssetTag = %q, expected %q", assets.Rows[1].AssetTag, "AT-5")
+	}
+
+	if assets.Rows[0].AssignedTo == nil || assets.Rows[0].AssignedTo.User() == nil || assets.Rows[0].AssignedTo.ID() != 2 {
+		t.Errorf("Users.Assets returned AssignedTo = %+v, expected user ID %d", assets.Rows[0].AssignedTo, 2)
+	}
+}
+
+func TestUsersAccessories(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/users/2/accessories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{"id": 3, "name": "USB-C Dock", "qty": 10, "remaining_qty": 4, "category": {"id": 8, "name": "Docks"}}
+			]
+		}`)
+	})
+
+	accessories, _, err := client.Users.Accessories(2)
+	if err != nil {
+		t.Fatalf("Users.Accessories returned error: %v", err)
+	}
+
+	if len(accessories.Rows) != 1 {
+		t.Fatalf("Users.Accessories returned %d accessories, expected %d", len(accessories.Rows), 1)
+	}
+
+	accessory := accessories.Rows[0]
+	if accessory.Name != "USB-C Dock" || accessory.Qty != 10 || accessory.RemainingQty != 4 {
+		t.Errorf("Users.Accessories returned %+v, expected USB-C Dock with qty 10 and 4 remaining", accessory)
+	}
+}
+
+func TestUsersLicenses(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/users/2/licenses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{
+			"total": 1,
+			"rows": [
+				{"id": 9, "name": "Office 365", "product_key": "XXXX-YYYY", "seats": 100, "free_seats_count": 12}
+			]
+		}`)
+	})
+
+	licenses, _, err := client.Users.Licenses(2)
+	if err != nil {
+		t.Fatalf("Users.Licenses returned error: %v", err)
+	}
+
+	if len(licenses.Rows) != 1 {
+		t.Fatalf("Users.Licenses returned %d licenses, expected %d", len(licenses.Rows), 1)
+	}
+
+	license := licenses.Rows[0]
+	if license.ProductKey != "XXXX-YYYY" || license.Seats != 100 || license.FreeSeatsCount != 12 {
+		t.Errorf("Users.Licenses returned %+v, expected product key XXXX-YYYY with 100 seats and 12 free", license)
+	}
+}
+
+func TestUsersRestore(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/users/4/restore", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{
+			"status": "success",
+			"messages": "User restored",
+			"payload": {"id": 4, "name": "Jane Doe", "username": "jdoe"}
+		}`)
+	})
+
+	user, _, err := client.Users.Restore(4)
+	if err != nil {
+		t.Fatalf("Users.Restore returned error: %v", err)
+	}
+
+	if user.Status != "success" {
+		t.Errorf("Users.Restore returned Status = %s, expected %s", user.Status, "success")
+	}
+
+	if user.ID != 4 || user.Username != "jdoe" {
+		t.Errorf("Users.Restore returned ID = %d, Username = %q, expected %d, %q", user.ID, user.Username, 4, "jdoe")
+	}
+}
+
+func TestUsersResetTwoFactor(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/users/two_factor_reset", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var requestBody map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&requestBody)
+
+		if requestBody["id"] != float64(4) {
+			t.Errorf("Request body id = %v, expected %v", requestBody["id"], float64(4))
+		}
+
+		fmt.Fprint(w, `{"status": "success", "message": "Two factor reset"}`)
+	})
+
+	resp, err := client.Users.ResetTwoFactor(4)
+	if err != nil {
+		t.Fatalf("Users.ResetTwoFactor returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Users.ResetTwoFactor returned status code = %d, expected %d", resp.StatusCode, http.StatusOK)
+	}
+}
@@ -0,0 +1,98 @@
+// Package snipeit provides a client for the Snipe-IT Asset Management API.
+package snipeit
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError reports a field of a request that Snipe-IT would reject.
+type ValidationError struct {
+	// Field is the API name of the invalid field (e.g., "model_id")
+	Field string
+
+	// Message describes what is wrong with the field
+	Message string
+}
+
+// Error implements the error interface for ValidationError.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("snipeit: %s %s", e.Field, e.Message)
+}
+
+// ValidationErrors is the list of problems found when validating a
+// request, in the order of the request's fields. Use errors.As to get it,
+// or a single *ValidationError, from an error.
+type ValidationErrors []*ValidationError
+
+// Error implements the error interface for ValidationErrors.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = fmt.Sprintf("%s %s", err.Field, err.Message)
+	}
+	return "snipeit: invalid request: " + strings.Join(messages, "; ")
+}
+
+// Unwrap returns the individual validation errors.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// validator is implemented by request types that can check their fields
+// before they are sent.
+type validator interface {
+	Validate() error
+}
+
+// validation collects the errors found while validating a request.
+type validation struct {
+	errs ValidationErrors
+}
+
+// add records that field is invalid.
+func (v *validation) add(field, message string) {
+	v.errs = append(v.errs, &ValidationError{Field: field, Message: message})
+}
+
+// requireID records an error if id, the value of field, is not positive.
+func (v *validation) requireID(field string, id int) {
+	if id <= 0 {
+		v.add(field, "is required")
+	}
+}
+
+// optionalID records an error if id, the value of field, is negative.
+func (v *validation) optionalID(field string, id int) {
+	if id < 0 {
+		v.add(field, "must be a positive ID")
+	}
+}
+
+// nullableID records an error if id, the value of field, is set to a
+// value other than a positive ID. If required is true, clearing it is
+// also an error.
+func (v *validation) nullableID(field string, id Nullable[int], required bool) {
+	value, ok := id.Get()
+	switch {
+	case !id.IsSet():
+	case !ok || value == 0:
+		if required {
+			v.add(field, "cannot be cleared")
+		}
+	case value < 0:
+		v.add(field, "must be a positive ID")
+	}
+}
+
+// err returns the errors collected, or nil if there are none.
+func (v *validation) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
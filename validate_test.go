@@ -0,0 +1,125 @@
+package snipeit
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func validationFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("error %v is not a ValidationErrors", err)
+	}
+	fields := make([]string, len(errs))
+	for i, e := range errs {
+		fields[i] = e.Field
+	}
+	return fields
+}
+
+func TestAssetCreateRequestValidate(t *testing.T) {
+	err := AssetCreateRequest{AssetTag: " ", WarrantyMonths: -1, LocationID: -2}.Validate()
+
+	fields := validationFields(t, err)
+	expected := []string{"model_id", "status_id", "asset_tag", "location_id", "warranty_months"}
+	if len(fields) != len(expected) {
+		t.Fatalf("Validate reported %v, expected %v", fields, expected)
+	}
+	for i := range expected {
+		if fields[i] != expected[i] {
+			t.Errorf("Validate reported %v, expected %v", fields, expected)
+			break
+		}
+	}
+
+	var single *ValidationError
+	if !errors.As(err, &single) || single.Field != "model_id" {
+		t.Errorf("errors.As found %v, expected the model_id error", single)
+	}
+
+	valid := AssetCreateRequest{ModelID: 1, StatusID: 2, AssetTag: "AT-1"}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate returned error for a valid request: %v", err)
+	}
+
+	// Snipe-IT can generate the asset tag
+	untagged := AssetCreateRequest{ModelID: 1, StatusID: 2}
+	if err := untagged.Validate(); err != nil {
+		t.Errorf("Validate returned error for a request without an asset tag: %v", err)
+	}
+}
+
+func TestAssetUpdateRequestValidate(t *testing.T) {
+	update := AssetUpdateRequest{
+		StatusID:   Null[int](),
+		AssetTag:   NewNullable(""),
+		LocationID: Null[int](),
+		SupplierID: NewNullable(-1),
+	}
+	fields := validationFields(t, update.Validate())
+	if len(fields) != 3 || fields[0] != "status_id" || fields[1] != "asset_tag" || fields[2] != "supplier_id" {
+		t.Errorf("Validate reported %v, expected [status_id asset_tag supplier_id]", fields)
+	}
+
+	if err := (AssetUpdateRequest{}).Validate(); err != nil {
+		t.Errorf("Validate returned error for an empty update: %v", err)
+	}
+}
+
+func TestCheckoutOptionsValidate(t *testing.T) {
+	today := Today(nil)
+	tests := []struct {
+		name     string
+		opts     CheckoutOptions
+		expected []string
+	}{
+		{"Valid", CheckoutOptions{CheckoutToUser: 1}, nil},
+		{"No target", CheckoutOptions{}, []string{"checkout_to_type"}},
+		{"Two targets", CheckoutOptions{CheckoutToUser: 1, CheckoutToLocation: 2}, []string{"checkout_to_type"}},
+		{"Negative target", CheckoutOptions{CheckoutToAsset: -3}, []string{"assigned_asset"}},
+		{"Checkin before checkout", CheckoutOptions{CheckoutToUser: 1, CheckoutAt: today, ExpectedCheckin: today.AddDays(-1)}, []string{"expected_checkin"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := validationFields(t, tt.opts.Validate())
+			if len(fields) != len(tt.expected) || (len(fields) > 0 && fields[0] != tt.expected[0]) {
+				t.Errorf("Validate reported %v, expected %v", fields, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClientValidateRequests(t *testing.T) {
+	client, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/api/v1/hardware", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"status": "success", "payload": {"id": 1}}`))
+	})
+
+	// Validation is opt-in
+	if _, _, err := client.Assets.Create(AssetCreateRequest{}); err != nil {
+		t.Fatalf("Assets.Create returned error: %v", err)
+	}
+
+	validating, err := NewClientWithOptions(serverURL, "test-token", &ClientOptions{ValidateRequests: true})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	_, _, err = validating.Assets.Create(AssetCreateRequest{ModelID: 1})
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Assets.Create returned error %v, expected ValidationErrors", err)
+	}
+	if requests != 1 {
+		t.Errorf("Server received %d requests, expected %d", requests, 1)
+	}
+}
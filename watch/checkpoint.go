@@ -0,0 +1,85 @@
+package watch
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// Position is the position of a Watcher in the activity log: the last
+// event it delivered or filtered out.
+type Position struct {
+	// ID is the ID of the event, or 0 before the first event
+	ID int `json:"id"`
+
+	// Time is when the event was recorded, if known
+	Time time.Time `json:"time,omitempty"`
+}
+
+// positionOf returns the position of activity. The time of prev is kept if
+// activity has none.
+func positionOf(activity snipeit.ActivityEvent, prev Position) Position {
+	pos := Position{ID: activity.ID, Time: prev.Time}
+	if activity.CreatedAt != nil {
+		pos.Time = activity.CreatedAt.Time
+	}
+	return pos
+}
+
+// Checkpoint stores the position of a Watcher.
+type Checkpoint interface {
+	// Load returns the saved position, or the zero Position if none was
+	// saved.
+	Load() (Position, error)
+
+	// Save saves pos, replacing the saved position.
+	Save(pos Position) error
+}
+
+// FileCheckpoint is a Checkpoint storing the position as JSON in the file
+// at the given path.
+type FileCheckpoint string
+
+// Load implements Checkpoint for FileCheckpoint. A missing file is the
+// zero Position.
+func (f FileCheckpoint) Load() (Position, error) {
+	var pos Position
+	data, err := os.ReadFile(string(f))
+	if errors.Is(err, os.ErrNotExist) {
+		return pos, nil
+	}
+	if err != nil {
+		return pos, err
+	}
+	err = json.Unmarshal(data, &pos)
+	return pos, err
+}
+
+// Save implements Checkpoint for FileCheckpoint. The file is replaced
+// atomically, so that it is never left partly written.
+func (f FileCheckpoint) Save(pos Position) error {
+	data, err := json.Marshal(pos)
+	if err != nil {
+		return err
+	}
+
+	path := string(f)
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,422 @@
+// Package watch polls the Snipe-IT activity log and delivers new events as
+// they are recorded, so that other systems can react to checkouts, checkins
+// and edits in near real time.
+//
+// A Watcher polls the activity report at a fixed interval and sends each
+// event it has not seen before on a channel, oldest first:
+//
+//	w := watch.New(client, watch.Options{
+//	    Interval:   30 * time.Second,
+//	    Filters:    []watch.Filter{watch.Types(watch.Checkout, watch.Checkin)},
+//	    Checkpoint: watch.FileCheckpoint("snipeit-watch.json"),
+//	})
+//	defer w.Stop()
+//
+//	for event := range w.Events() {
+//	    log.Printf("%s %s", event.Type, event.Item.Name)
+//	}
+//
+// The position of the last delivered event is saved to the Checkpoint after
+// each poll, so that a restarted watcher resumes where it stopped. Events
+// are delivered at least once: those delivered after the last save are
+// delivered again after a crash.
+package watch
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// DefaultInterval is the time between polls when Options.Interval is 0.
+const DefaultInterval = time.Minute
+
+// defaultPageSize is the number of events requested per page when
+// Options.PageSize is 0.
+const defaultPageSize = 100
+
+// EventType is the normalized action of an event.
+type EventType string
+
+const (
+	// Checkout is the checkout of an item
+	Checkout EventType = "checkout"
+
+	// Checkin is the checkin of an item
+	Checkin EventType = "checkin"
+
+	// Create is the creation of an item
+	Create EventType = "create"
+
+	// Update is an edit of an item; LogMeta holds the changed fields
+	Update EventType = "update"
+
+	// Delete is the deletion of an item
+	Delete EventType = "delete"
+
+	// Audit is the audit of an asset
+	Audit EventType = "audit"
+)
+
+// actionTypes maps the action types Snipe-IT records to event types.
+// Other action types are kept as they are.
+var actionTypes = map[string]EventType{
+	"checkout":     Checkout,
+	"checkin from": Checkin,
+	"checkin":      Checkin,
+	"create":       Create,
+	"create new":   Create,
+	"update":       Update,
+	"delete":       Delete,
+	"audit":        Audit,
+}
+
+// Event is an entry of the activity log.
+type Event struct {
+	// Type is the action of the event. It is one of the EventType
+	// constants, or the action type Snipe-IT recorded for other actions
+	// (e.g., "requested").
+	Type EventType
+
+	snipeit.ActivityEvent
+}
+
+// newEvent returns the Event of an activity log entry.
+func newEvent(activity snipeit.ActivityEvent) Event {
+	eventType, ok := actionTypes[activity.ActionType]
+	if !ok {
+		eventType = EventType(activity.ActionType)
+	}
+	return Event{Type: eventType, ActivityEvent: activity}
+}
+
+// Filter reports whether an event should be delivered.
+type Filter func(event Event) bool
+
+// Types returns a Filter accepting the events of the given types.
+func Types(types ...EventType) Filter {
+	return func(event Event) bool {
+		for _, t := range types {
+			if event.Type == t {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ItemTypes returns a Filter accepting the events whose item is of one of
+// the given types (e.g., "asset", "license").
+func ItemTypes(types ...string) Filter {
+	return func(event Event) bool {
+		if event.Item == nil {
+			return false
+		}
+		for _, t := range types {
+			if event.Item.Type == t {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Options configures a Watcher.
+type Options struct {
+	// Interval is the time between polls. Default: DefaultInterval.
+	Interval time.Duration
+
+	// Filters select the events to deliver: an event is delivered only if
+	// every filter accepts it. Events that are filtered out still advance
+	// the position of the watcher.
+	Filters []Filter
+
+	// Checkpoint stores the position of the watcher across restarts. If
+	// nil, the position is kept in memory only.
+	Checkpoint Checkpoint
+
+	// FromBeginning, if true, makes a watcher without a saved position
+	// deliver every event in the activity log. By default, it only
+	// delivers the events recorded after it starts.
+	FromBeginning bool
+
+	// PageSize is the number of events requested per page. Default: 100.
+	PageSize int
+
+	// OnError, if set, is called with the errors of polls and of saving the
+	// position. The watcher keeps polling after such errors.
+	OnError func(err error)
+}
+
+// Watcher polls the activity log and delivers new events. It must be
+// created with New.
+type Watcher struct {
+	client *snipeit.Client
+	opts   Options
+
+	events chan Event
+	done   chan struct{}
+
+	mu       sync.Mutex
+	started  bool
+	stopping bool
+	cancel   context.CancelFunc
+	pos      Position
+	loaded   bool
+	err      error
+}
+
+// New returns a Watcher of the activity log of client. It starts polling
+// when Events or EventsContext is first called.
+func New(client *snipeit.Client, opts Options) *Watcher {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = defaultPageSize
+	}
+	return &Watcher{
+		client: client,
+		opts:   opts,
+		events: make(chan Event),
+		done:   make(chan struct{}),
+		cancel: func() {},
+	}
+}
+
+// Events starts the watcher if needed and returns the channel on which
+// events are delivered. The channel is closed when the watcher stops.
+func (w *Watcher) Events() <-chan Event {
+	return w.EventsContext(context.Background())
+}
+
+// EventsContext starts the watcher with the provided context if needed and
+// returns the channel on which events are delivered. The channel is closed
+// when the watcher stops, which it does when ctx is done or Stop is called.
+// Only the context of the first call is used.
+func (w *Watcher) EventsContext(ctx context.Context) <-chan Event {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.started {
+		w.started = true
+		ctx, w.cancel = context.WithCancel(ctx)
+		go w.run(ctx)
+	}
+	return w.events
+}
+
+// Stop stops the watcher and waits for it to save its position. The
+// events channel is closed. A stopped watcher cannot be restarted.
+func (w *Watcher) Stop() {
+	w.mu.Lock()
+	if !w.started {
+		w.started = true
+		close(w.events)
+		close(w.done)
+	}
+	w.stopping = true
+	w.cancel()
+	w.mu.Unlock()
+
+	<-w.done
+}
+
+// Err returns the error that stopped the watcher: the error of loading its
+// position from the Checkpoint, or the error of the context passed to
+// EventsContext. It returns nil while the watcher runs, and after Stop.
+func (w *Watcher) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+// Position returns the position of the last event the watcher delivered or
+// filtered out.
+func (w *Watcher) Position() Position {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.pos
+}
+
+// run polls the activity log until ctx is done.
+func (w *Watcher) run(ctx context.Context) {
+	defer close(w.done)
+	defer close(w.events)
+
+	var pos Position
+	if w.opts.Checkpoint != nil {
+		var err error
+		if pos, err = w.opts.Checkpoint.Load(); err != nil {
+			w.setErr(err)
+			return
+		}
+	}
+	w.mu.Lock()
+	w.pos = pos
+	w.loaded = pos.ID > 0 || w.opts.FromBeginning
+	w.mu.Unlock()
+
+	for {
+		if err := w.poll(ctx); err != nil && ctx.Err() == nil {
+			w.report(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			w.mu.Lock()
+			if !w.stopping {
+				w.err = ctx.Err()
+			}
+			w.mu.Unlock()
+			return
+		case <-time.After(w.opts.Interval):
+		}
+	}
+}
+
+// poll delivers the events recorded since the position of the watcher,
+// and saves the new position.
+func (w *Watcher) poll(ctx context.Context) error {
+	w.mu.Lock()
+	pos, loaded := w.pos, w.loaded
+	w.mu.Unlock()
+
+	if !loaded {
+		latest, err := w.latest(ctx)
+		if err != nil {
+			return err
+		}
+		w.mu.Lock()
+		w.pos, w.loaded = latest, true
+		w.mu.Unlock()
+		return w.save(latest)
+	}
+
+	activities, err := w.fetch(ctx, pos)
+	if err != nil {
+		return err
+	}
+	if len(activities) == 0 {
+		return nil
+	}
+
+	delivered := pos
+	defer func() {
+		if delivered != pos {
+			if err := w.save(delivered); err != nil {
+				w.report(err)
+			}
+		}
+	}()
+
+	for _, activity := range activities {
+		if activity.ID <= delivered.ID {
+			continue
+		}
+		event := newEvent(activity)
+		if w.accept(event) {
+			select {
+			case w.events <- event:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		delivered = positionOf(activity, delivered)
+		w.mu.Lock()
+		w.pos = delivered
+		w.mu.Unlock()
+	}
+	return nil
+}
+
+// fetch returns the events recorded after pos, sorted by ID. It pages
+// through the activity log from the newest event until it reaches pos.
+func (w *Watcher) fetch(ctx context.Context, pos Position) ([]snipeit.ActivityEvent, error) {
+	opts := &snipeit.ActivityListOptions{
+		ListOptions: snipeit.ListOptions{Sort: "id", SortDir: "desc", Limit: w.opts.PageSize},
+	}
+	if !pos.Time.IsZero() {
+		// Start a day early, as the server filters by its local date
+		opts.StartDate = pos.Time.AddDate(0, 0, -1)
+	}
+
+	var activities []snipeit.ActivityEvent
+	for {
+		page, _, err := w.client.Reports.ActivityContext(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		done := len(page.Rows) < opts.Limit
+		for _, activity := range page.Rows {
+			if activity.ID <= pos.ID {
+				done = true
+				break
+			}
+			activities = append(activities, activity)
+		}
+		if done {
+			break
+		}
+		opts.Offset += len(page.Rows)
+	}
+
+	// Events recorded while paging shift the pages, so some may have been
+	// fetched twice; they are skipped when delivered.
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].ID < activities[j].ID
+	})
+	return activities, nil
+}
+
+// latest returns the position of the newest event in the activity log.
+func (w *Watcher) latest(ctx context.Context) (Position, error) {
+	opts := &snipeit.ActivityListOptions{
+		ListOptions: snipeit.ListOptions{Sort: "id", SortDir: "desc", Limit: 1},
+	}
+	page, _, err := w.client.Reports.ActivityContext(ctx, opts)
+	if err != nil {
+		return Position{}, err
+	}
+	if len(page.Rows) == 0 {
+		return Position{}, nil
+	}
+	return positionOf(page.Rows[0], Position{}), nil
+}
+
+// accept reports whether every filter accepts event.
+func (w *Watcher) accept(event Event) bool {
+	for _, filter := range w.opts.Filters {
+		if !filter(event) {
+			return false
+		}
+	}
+	return true
+}
+
+// save saves pos to the checkpoint, if any.
+func (w *Watcher) save(pos Position) error {
+	if w.opts.Checkpoint == nil {
+		return nil
+	}
+	return w.opts.Checkpoint.Save(pos)
+}
+
+// report passes err to the OnError function, if any.
+func (w *Watcher) report(err error) {
+	if w.opts.OnError != nil {
+		w.opts.OnError(err)
+	}
+}
+
+// setErr records the error that stopped the watcher.
+func (w *Watcher) setErr(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.err = err
+}
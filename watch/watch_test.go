@@ -0,0 +1,237 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// activityLog is an activity report served by a test server.
+type activityLog struct {
+	mu     sync.Mutex
+	events []map[string]interface{}
+	fail   bool
+}
+
+// add records an event with the next ID.
+func (l *activityLog) add(actionType, itemType string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	id := len(l.events) + 1
+	l.events = append(l.events, map[string]interface{}{
+		"id":          id,
+		"action_type": actionType,
+		"item":        map[string]interface{}{"id": id * 10, "name": "Item " + strconv.Itoa(id), "type": itemType},
+		"created_at":  map[string]interface{}{"datetime": "2024-03-01 09:30:00"},
+	})
+}
+
+// ServeHTTP serves the events newest first, paginated.
+func (l *activityLog) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.fail {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"status": "error", "messages": "Server Error"}`))
+		return
+	}
+
+	query := r.URL.Query()
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	rows := []map[string]interface{}{}
+	for i := len(l.events) - 1 - offset; i >= 0 && len(rows) < limit; i-- {
+		rows = append(rows, l.events[i])
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"total": len(l.events), "rows": rows})
+}
+
+func setup(t *testing.T) (*snipeit.Client, *activityLog) {
+	t.Helper()
+	log := &activityLog{}
+	mux := http.NewServeMux()
+	mux.Handle("/api/v1/reports/activity", log)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := snipeit.NewClientWithOptions(server.URL, "test-token", &snipeit.ClientOptions{DisableRetries: true})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	return client, log
+}
+
+// receive returns the next n events of w, failing the test if they do not
+// arrive in time.
+func receive(t *testing.T, w *Watcher, n int) []Event {
+	t.Helper()
+	var events []Event
+	timeout := time.After(5 * time.Second)
+	for len(events) < n {
+		select {
+		case event, ok := <-w.Events():
+			if !ok {
+				t.Fatalf("Events closed after %d events, expected %d", len(events), n)
+			}
+			events = append(events, event)
+		case <-timeout:
+			t.Fatalf("Received %d events, expected %d", len(events), n)
+		}
+	}
+	return events
+}
+
+func ids(events []Event) []int {
+	var ids []int
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
+
+func TestWatcherNewEvents(t *testing.T) {
+	client, log := setup(t)
+	log.add("checkout", "asset")
+	log.add("update", "asset")
+
+	w := New(client, Options{Interval: 10 * time.Millisecond})
+	defer w.Stop()
+	w.Events()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for w.Position().ID != 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	log.add("checkin from", "asset")
+	log.add("requested", "asset")
+
+	events := receive(t, w, 2)
+	if got := ids(events); got[0] != 3 || got[1] != 4 {
+		t.Errorf("Events delivered %v, expected [3 4]", got)
+	}
+	if events[0].Type != Checkin || events[1].Type != "requested" {
+		t.Errorf("Events have types %q and %q, expected %q and %q", events[0].Type, events[1].Type, Checkin, "requested")
+	}
+	if events[0].Item == nil || events[0].Item.ID != 30 {
+		t.Errorf("Event item = %+v, expected item 30", events[0].Item)
+	}
+}
+
+func TestWatcherFromBeginningFilters(t *testing.T) {
+	client, log := setup(t)
+	for _, action := range []string{"checkout", "update", "checkout", "audit", "checkout"} {
+		log.add(action, "asset")
+	}
+	log.add("checkout", "license")
+
+	w := New(client, Options{
+		Interval:      10 * time.Millisecond,
+		Filters:       []Filter{Types(Checkout), ItemTypes("asset")},
+		FromBeginning: true,
+		PageSize:      2,
+	})
+	defer w.Stop()
+
+	events := receive(t, w, 3)
+	if got := ids(events); got[0] != 1 || got[1] != 3 || got[2] != 5 {
+		t.Errorf("Events delivered %v, expected [1 3 5]", got)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for w.Position().ID != 6 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if pos := w.Position(); pos.ID != 6 {
+		t.Errorf("Position = %+v, expected the filtered out event 6", pos)
+	}
+}
+
+func TestWatcherCheckpoint(t *testing.T) {
+	client, log := setup(t)
+	for i := 0; i < 5; i++ {
+		log.add("checkout", "asset")
+	}
+
+	checkpoint := FileCheckpoint(filepath.Join(t.TempDir(), "position.json"))
+	if err := checkpoint.Save(Position{ID: 3}); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	w := New(client, Options{Interval: 10 * time.Millisecond, Checkpoint: checkpoint})
+	events := receive(t, w, 2)
+	if got := ids(events); got[0] != 4 || got[1] != 5 {
+		t.Errorf("Events delivered %v, expected [4 5]", got)
+	}
+	w.Stop()
+
+	pos, err := checkpoint.Load()
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if pos.ID != 5 || pos.Time.IsZero() {
+		t.Errorf("Saved position = %+v, expected event 5 with its time", pos)
+	}
+}
+
+func TestWatcherErrors(t *testing.T) {
+	client, log := setup(t)
+	log.fail = true
+
+	errs := make(chan error, 10)
+	w := New(client, Options{
+		Interval: 10 * time.Millisecond,
+		OnError: func(err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		},
+	})
+	defer w.Stop()
+	w.Events()
+
+	select {
+	case err := <-errs:
+		var errResp *snipeit.ErrorResponse
+		if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusInternalServerError {
+			t.Errorf("OnError called with %v, expected a 500 error response", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnError was not called")
+	}
+	if err := w.Err(); err != nil {
+		t.Errorf("Err returned %v while running, expected nil", err)
+	}
+}
+
+func TestWatcherStop(t *testing.T) {
+	client, _ := setup(t)
+
+	w := New(client, Options{Interval: 10 * time.Millisecond})
+	w.Stop()
+	if _, ok := <-w.Events(); ok {
+		t.Error("Events of a stopped watcher is open")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w = New(client, Options{Interval: 10 * time.Millisecond})
+	events := w.EventsContext(ctx)
+	cancel()
+	for range events {
+	}
+	if err := w.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("Err returned %v, expected context.Canceled", err)
+	}
+	w.Stop()
+}
@@ -0,0 +1,390 @@
+// Package webhook receives Snipe-IT webhook notifications of checkouts,
+// checkins and audits.
+//
+// A Handler is an http.Handler that validates incoming notifications,
+// decodes them into the models of the snipeit package and calls the
+// functions registered for their event:
+//
+//	handler := webhook.NewHandler(os.Getenv("WEBHOOK_SECRET"))
+//	handler.OnCheckout(func(ctx context.Context, event *webhook.CheckoutEvent) error {
+//	    if event.Asset != nil {
+//	        log.Printf("%s checked out to %s", event.Asset.AssetTag, event.Target.Name())
+//	    }
+//	    return nil
+//	})
+//	http.Handle("/snipeit/webhook", handler)
+//
+// Notifications are JSON objects whose "event" field is "checkout",
+// "checkin" or "audit":
+//
+//	{
+//	    "event": "checkout",
+//	    "item_type": "asset",
+//	    "item": {"id": 12, "asset_tag": "AT-12", ...},
+//	    "target": {"id": 3, "name": "Alice Smith", "type": "user"},
+//	    "admin": {"id": 1, "username": "admin"},
+//	    "note": "New starter",
+//	    "expected_checkin": "2025-03-01",
+//	    "created_at": "2024-03-01 09:30:00"
+//	}
+//
+// Items, targets and users have the shape the API returns them in. Audit
+// notifications carry the audited asset as their item, along with
+// "location" and "next_audit_date".
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+// maxBodySize is the size of the largest notification a Handler accepts.
+const maxBodySize = 1 << 20
+
+// TokenHeader is the header carrying the secret of a Handler, if it is not
+// in the "token" query parameter.
+const TokenHeader = "X-Webhook-Token"
+
+// EventType is the type of a notification.
+type EventType string
+
+const (
+	// EventCheckout is sent when an item is checked out
+	EventCheckout EventType = "checkout"
+
+	// EventCheckin is sent when an item is checked in
+	EventCheckin EventType = "checkin"
+
+	// EventAudit is sent when an asset is audited
+	EventAudit EventType = "audit"
+)
+
+// Item is the item a checkout or checkin notification is about. The field
+// matching Type is set; items of other types, such as components, are only
+// available as Raw.
+type Item struct {
+	// Type is the type of the item
+	Type snipeit.CategoryType
+
+	// Asset is the item if it is an asset
+	Asset *snipeit.Asset
+
+	// Accessory is the item if it is an accessory
+	Accessory *snipeit.Accessory
+
+	// Consumable is the item if it is a consumable
+	Consumable *snipeit.Consumable
+
+	// License is the item if it is a license
+	License *snipeit.License
+
+	// Raw is the JSON object of the item
+	Raw json.RawMessage
+}
+
+// CheckoutEvent is a notification that an item was checked out.
+type CheckoutEvent struct {
+	Item
+
+	// Target is who or what the item was checked out to
+	Target *snipeit.AssignedTo
+
+	// Admin is the user who checked the item out, if known
+	Admin *snipeit.User
+
+	// Note is the note entered with the checkout
+	Note string
+
+	// ExpectedCheckin is when the item is expected back, if set
+	ExpectedCheckin *snipeit.SnipeTime
+
+	// Time is when the item was checked out
+	Time *snipeit.SnipeTime
+}
+
+// CheckinEvent is a notification that an item was checked in.
+type CheckinEvent struct {
+	Item
+
+	// Target is who or what the item was checked in from
+	Target *snipeit.AssignedTo
+
+	// Admin is the user who checked the item in, if known
+	Admin *snipeit.User
+
+	// Note is the note entered with the checkin
+	Note string
+
+	// Time is when the item was checked in
+	Time *snipeit.SnipeTime
+}
+
+// AuditEvent is a notification that an asset was audited.
+type AuditEvent struct {
+	// Asset is the audited asset
+	Asset snipeit.Asset
+
+	// Location is where the asset was found, if set
+	Location *snipeit.Location
+
+	// Admin is the user who audited the asset, if known
+	Admin *snipeit.User
+
+	// Note is the note entered with the audit
+	Note string
+
+	// NextAuditDate is when the asset is next due for audit, if set
+	NextAuditDate *snipeit.SnipeTime
+
+	// Time is when the asset was audited
+	Time *snipeit.SnipeTime
+}
+
+// Handler is an http.Handler receiving webhook notifications. Register
+// functions for the events to handle before serving requests.
+//
+// A Handler answers 204 No Content to notifications it handled, including
+// those of events with no registered function; 400 Bad Request to
+// malformed ones; 401 Unauthorized to requests without its secret; and
+// 500 Internal Server Error if a registered function returns an error, so
+// that the sender may retry.
+type Handler struct {
+	secret string
+
+	mu       sync.RWMutex
+	checkout []func(ctx context.Context, event *CheckoutEvent) error
+	checkin  []func(ctx context.Context, event *CheckinEvent) error
+	audit    []func(ctx context.Context, event *AuditEvent) error
+}
+
+// NewHandler returns a Handler accepting the notifications that carry
+// secret, in the "token" query parameter of the webhook URL or in the
+// X-Webhook-Token header. If secret is empty, every notification is
+// accepted.
+func NewHandler(secret string) *Handler {
+	return &Handler{secret: secret}
+}
+
+// OnCheckout registers fn to be called with checkout notifications.
+// Functions are called in the order they were registered, until one
+// returns an error.
+func (h *Handler) OnCheckout(fn func(ctx context.Context, event *CheckoutEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checkout = append(h.checkout, fn)
+}
+
+// OnCheckin registers fn to be called with checkin notifications.
+// Functions are called in the order they were registered, until one
+// returns an error.
+func (h *Handler) OnCheckin(fn func(ctx context.Context, event *CheckinEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checkin = append(h.checkin, fn)
+}
+
+// OnAudit registers fn to be called with audit notifications.
+// Functions are called in the order they were registered, until one
+// returns an error.
+func (h *Handler) OnAudit(fn func(ctx context.Context, event *AuditEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.audit = append(h.audit, fn)
+}
+
+// ServeHTTP implements http.Handler for Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.authorized(r) {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "cannot read notification: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	event, err := Parse(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.dispatch(r.Context(), event); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// authorized reports whether r carries the secret of the handler.
+func (h *Handler) authorized(r *http.Request) bool {
+	if h.secret == "" {
+		return true
+	}
+	token := r.Header.Get(TokenHeader)
+	if token == "" {
+		token = r.URL.Query().Get("token")
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.secret)) == 1
+}
+
+// dispatch calls the functions registered for the event of a parsed
+// notification.
+func (h *Handler) dispatch(ctx context.Context, event interface{}) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	switch event := event.(type) {
+	case *CheckoutEvent:
+		for _, fn := range h.checkout {
+			if err := fn(ctx, event); err != nil {
+				return err
+			}
+		}
+	case *CheckinEvent:
+		for _, fn := range h.checkin {
+			if err := fn(ctx, event); err != nil {
+				return err
+			}
+		}
+	case *AuditEvent:
+		for _, fn := range h.audit {
+			if err := fn(ctx, event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ParseError is returned by Parse for malformed notifications.
+type ParseError struct {
+	// Err is the cause of the error
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return "webhook: invalid notification: " + e.Err.Error()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// payload is the JSON object of a notification.
+type payload struct {
+	Event           EventType            `json:"event"`
+	ItemType        snipeit.CategoryType `json:"item_type"`
+	Item            json.RawMessage      `json:"item"`
+	Target          *snipeit.AssignedTo  `json:"target"`
+	Admin           *snipeit.User        `json:"admin"`
+	Note            string               `json:"note"`
+	ExpectedCheckin *snipeit.SnipeTime   `json:"expected_checkin"`
+	Location        *snipeit.Location    `json:"location"`
+	NextAuditDate   *snipeit.SnipeTime   `json:"next_audit_date"`
+	CreatedAt       *snipeit.SnipeTime   `json:"created_at"`
+}
+
+// Parse decodes a notification into a *CheckoutEvent, *CheckinEvent or
+// *AuditEvent. It returns nil and no error for notifications of other
+// events, and a *ParseError for malformed notifications.
+func Parse(data []byte) (interface{}, error) {
+	var p payload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, &ParseError{err}
+	}
+
+	switch p.Event {
+	case EventCheckout:
+		item, err := parseItem(p.ItemType, p.Item)
+		if err != nil {
+			return nil, err
+		}
+		return &CheckoutEvent{
+			Item:            item,
+			Target:          p.Target,
+			Admin:           p.Admin,
+			Note:            p.Note,
+			ExpectedCheckin: p.ExpectedCheckin,
+			Time:            p.CreatedAt,
+		}, nil
+	case EventCheckin:
+		item, err := parseItem(p.ItemType, p.Item)
+		if err != nil {
+			return nil, err
+		}
+		return &CheckinEvent{
+			Item:   item,
+			Target: p.Target,
+			Admin:  p.Admin,
+			Note:   p.Note,
+			Time:   p.CreatedAt,
+		}, nil
+	case EventAudit:
+		item, err := parseItem(snipeit.CategoryTypeAsset, p.Item)
+		if err != nil {
+			return nil, err
+		}
+		return &AuditEvent{
+			Asset:         *item.Asset,
+			Location:      p.Location,
+			Admin:         p.Admin,
+			Note:          p.Note,
+			NextAuditDate: p.NextAuditDate,
+			Time:          p.CreatedAt,
+		}, nil
+	case "":
+		return nil, &ParseError{errors.New("missing event")}
+	}
+	return nil, nil
+}
+
+// parseItem decodes the item of a notification, of type itemType.
+func parseItem(itemType snipeit.CategoryType, data json.RawMessage) (Item, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return Item{}, &ParseError{errors.New("missing item")}
+	}
+
+	item := Item{Type: snipeit.CategoryType(strings.ToLower(string(itemType))), Raw: data}
+	if item.Type == "" {
+		item.Type = snipeit.CategoryTypeAsset
+	}
+
+	var v interface{}
+	switch item.Type {
+	case snipeit.CategoryTypeAsset:
+		item.Asset = new(snipeit.Asset)
+		v = item.Asset
+	case snipeit.CategoryTypeAccessory:
+		item.Accessory = new(snipeit.Accessory)
+		v = item.Accessory
+	case snipeit.CategoryTypeConsumable:
+		item.Consumable = new(snipeit.Consumable)
+		v = item.Consumable
+	case snipeit.CategoryTypeLicense:
+		item.License = new(snipeit.License)
+		v = item.License
+	default:
+		return item, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return Item{}, &ParseError{fmt.Errorf("item: %w", err)}
+	}
+	return item, nil
+}
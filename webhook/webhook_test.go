@@ -0,0 +1,187 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/michellepellon/go-snipeit"
+)
+
+const checkoutNotification = `{
+	"event": "checkout",
+	"item_type": "asset",
+	"item": {"id": 12, "asset_tag": "AT-12", "model": {"id": 7, "name": "Latitude 5520"}},
+	"target": {"id": 3, "username": "alice", "name": "Alice Smith", "type": "user"},
+	"admin": {"id": 1, "username": "admin"},
+	"note": "New starter",
+	"expected_checkin": "2025-03-01",
+	"created_at": "2024-03-01 09:30:00"
+}`
+
+// post sends body to handler and returns the response status.
+func post(handler http.Handler, target, body string) int {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestHandlerCheckout(t *testing.T) {
+	handler := NewHandler("")
+
+	var received *CheckoutEvent
+	handler.OnCheckout(func(ctx context.Context, event *CheckoutEvent) error {
+		received = event
+		return nil
+	})
+	handler.OnCheckin(func(ctx context.Context, event *CheckinEvent) error {
+		t.Error("OnCheckin function called for a checkout")
+		return nil
+	})
+
+	if code := post(handler, "/", checkoutNotification); code != http.StatusNoContent {
+		t.Fatalf("Handler answered %d, expected %d", code, http.StatusNoContent)
+	}
+
+	if received == nil || received.Asset == nil {
+		t.Fatalf("Handler passed %+v, expected a checkout of an asset", received)
+	}
+
+	if received.Type != snipeit.CategoryTypeAsset || received.Asset.AssetTag != "AT-12" || received.Asset.Model.Name != "Latitude 5520" {
+		t.Errorf("CheckoutEvent.Asset = %+v, expected AT-12, a Latitude 5520", received.Asset)
+	}
+
+	if received.Target.Kind() != snipeit.AssignedTypeUser || received.Target.Name() != "Alice Smith" {
+		t.Errorf("CheckoutEvent.Target = %s %q, expected user %q", received.Target.Kind(), received.Target.Name(), "Alice Smith")
+	}
+
+	if received.Admin.Username != "admin" || received.Note != "New starter" {
+		t.Errorf("CheckoutEvent = %+v, expected admin and note", received)
+	}
+
+	if snipeit.DateOf(received.ExpectedCheckin.Time) != snipeit.NewDate(2025, 3, 1) {
+		t.Errorf("CheckoutEvent.ExpectedCheckin = %v, expected 2025-03-01", received.ExpectedCheckin)
+	}
+}
+
+func TestHandlerCheckinAccessory(t *testing.T) {
+	handler := NewHandler("")
+
+	var received *CheckinEvent
+	handler.OnCheckin(func(ctx context.Context, event *CheckinEvent) error {
+		received = event
+		return nil
+	})
+
+	code := post(handler, "/", `{
+		"event": "checkin",
+		"item_type": "accessory",
+		"item": {"id": 5, "name": "USB-C Dock"},
+		"target": {"id": 3, "name": "Alice Smith", "type": "user"}
+	}`)
+	if code != http.StatusNoContent {
+		t.Fatalf("Handler answered %d, expected %d", code, http.StatusNoContent)
+	}
+
+	if received == nil || received.Accessory == nil || received.Asset != nil {
+		t.Fatalf("Handler passed %+v, expected a checkin of an accessory", received)
+	}
+
+	if received.Accessory.Name != "USB-C Dock" {
+		t.Errorf("CheckinEvent.Accessory.Name = %q, expected %q", received.Accessory.Name, "USB-C Dock")
+	}
+}
+
+func TestHandlerAudit(t *testing.T) {
+	handler := NewHandler("")
+
+	var received *AuditEvent
+	handler.OnAudit(func(ctx context.Context, event *AuditEvent) error {
+		received = event
+		return nil
+	})
+
+	code := post(handler, "/", `{
+		"event": "audit",
+		"item": {"id": 12, "asset_tag": "AT-12"},
+		"location": {"id": 4, "name": "HQ"},
+		"next_audit_date": "2025-03-01"
+	}`)
+	if code != http.StatusNoContent {
+		t.Fatalf("Handler answered %d, expected %d", code, http.StatusNoContent)
+	}
+
+	if received == nil || received.Asset.AssetTag != "AT-12" || received.Location.Name != "HQ" {
+		t.Errorf("Handler passed %+v, expected an audit of AT-12 at HQ", received)
+	}
+}
+
+func TestHandlerResponses(t *testing.T) {
+	handler := NewHandler("s3cret")
+	handler.OnCheckout(func(ctx context.Context, event *CheckoutEvent) error {
+		if event.Note == "fail" {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		header   string
+		body     string
+		expected int
+	}{
+		{"Token in query", http.MethodPost, "/?token=s3cret", "", checkoutNotification, http.StatusNoContent},
+		{"Token in header", http.MethodPost, "/", "s3cret", checkoutNotification, http.StatusNoContent},
+		{"Missing token", http.MethodPost, "/", "", checkoutNotification, http.StatusUnauthorized},
+		{"Wrong token", http.MethodPost, "/?token=guess", "", checkoutNotification, http.StatusUnauthorized},
+		{"GET", http.MethodGet, "/?token=s3cret", "", "", http.StatusMethodNotAllowed},
+		{"Invalid JSON", http.MethodPost, "/?token=s3cret", "", `{"event":`, http.StatusBadRequest},
+		{"Missing event", http.MethodPost, "/?token=s3cret", "", `{"item": {"id": 1}}`, http.StatusBadRequest},
+		{"Missing item", http.MethodPost, "/?token=s3cret", "", `{"event": "checkout"}`, http.StatusBadRequest},
+		{"Unknown event", http.MethodPost, "/?token=s3cret", "", `{"event": "request"}`, http.StatusNoContent},
+		{"Function error", http.MethodPost, "/?token=s3cret", "", `{"event": "checkout", "item": {"id": 1}, "note": "fail"}`, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.header != "" {
+				req.Header.Set(TokenHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expected {
+				t.Errorf("Handler answered %d, expected %d: %s", rec.Code, tt.expected, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	event, err := Parse([]byte(`{"event": "checkout", "item_type": "component", "item": {"id": 9, "name": "RAM"}}`))
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	checkout, ok := event.(*CheckoutEvent)
+	if !ok {
+		t.Fatalf("Parse returned %T, expected *CheckoutEvent", event)
+	}
+
+	if checkout.Type != snipeit.CategoryTypeComponent || checkout.Asset != nil || string(checkout.Raw) != `{"id": 9, "name": "RAM"}` {
+		t.Errorf("Parse returned %+v, expected a component with its raw JSON", checkout)
+	}
+
+	var parseErr *ParseError
+	if _, err := Parse([]byte(`[]`)); !errors.As(err, &parseErr) {
+		t.Errorf("Parse returned error %v, expected a *ParseError", err)
+	}
+}